@@ -0,0 +1,295 @@
+package backtest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/fachebot/evm-grid-bot/internal/cache"
+	"github.com/fachebot/evm-grid-bot/internal/charts"
+	"github.com/fachebot/evm-grid-bot/internal/config"
+	"github.com/fachebot/evm-grid-bot/internal/engine"
+	"github.com/fachebot/evm-grid-bot/internal/ent"
+	"github.com/fachebot/evm-grid-bot/internal/ent/grid"
+	"github.com/fachebot/evm-grid-bot/internal/ent/order"
+	entstrategy "github.com/fachebot/evm-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/evm-grid-bot/internal/logger"
+	"github.com/fachebot/evm-grid-bot/internal/model"
+	"github.com/fachebot/evm-grid-bot/internal/strategy"
+	"github.com/fachebot/evm-grid-bot/internal/svc"
+	"github.com/fachebot/evm-grid-bot/internal/utils"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	account            = "0x0000000000000000000000000000000000000B4c"
+	stablecoinCA       = "0x0000000000000000000000000000000000005553"
+	stablecoinSymbol   = "USDT"
+	stablecoinDecimals = 18
+	// 默认与实盘K线数量一致, 保证回测与实盘的指标计算结果相同
+	defaultCandles = engine.DefaultCandles
+)
+
+type Options struct {
+	SlippageBps   int
+	FeeBps        int
+	TokenDecimals uint8
+	Candles       int
+}
+
+type Report struct {
+	Ticks            int
+	Buys             int
+	Sells            int
+	RoundTrips       int
+	RealizedProfit   decimal.Decimal
+	UnrealizedProfit decimal.Decimal
+	TotalFees        decimal.Decimal
+	MaxDrawdown      decimal.Decimal
+	MaxCapitalUsed   decimal.Decimal
+	Holding          decimal.Decimal
+	LastPrice        decimal.Decimal
+	ExitRule         *strategy.ExitRule
+	ExitTime         *time.Time
+}
+
+func (r *Report) TotalProfit() decimal.Decimal {
+	return r.RealizedProfit.Add(r.UnrealizedProfit)
+}
+
+type backtester struct {
+	svcCtx *svc.ServiceContext
+	env    *environment
+	report *Report
+	cash   decimal.Decimal
+}
+
+func Run(ctx context.Context, record ent.Strategy, ohlcs []charts.Ohlc, opts Options) (*Report, error) {
+	if len(ohlcs) == 0 {
+		return nil, errors.New("ohlcs is empty")
+	}
+	if opts.Candles <= 0 {
+		opts.Candles = defaultCandles
+	}
+
+	// 创建内存数据库
+	drv, err := entsql.Open("sqlite3", fmt.Sprintf("file:backtest-%s?mode=memory&cache=shared&_fk=1", uuid.NewString()))
+	if err != nil {
+		return nil, err
+	}
+	drv.DB().SetMaxOpenConns(1)
+
+	client := ent.NewClient(ent.Driver(drv))
+	defer client.Close()
+	if err = client.Schema.Create(ctx); err != nil {
+		return nil, err
+	}
+
+	c := &config.Config{}
+	c.Chain.StablecoinCA = stablecoinCA
+	c.Chain.StablecoinSymbol = stablecoinSymbol
+	c.Chain.StablecoinDecimals = stablecoinDecimals
+
+	svcCtx := &svc.ServiceContext{
+		Config:         c,
+		DbClient:       client,
+		GridModel:      model.NewGridModel(client.Grid),
+		OrderModel:     model.NewOrderModel(client.Order),
+		SettingsModel:  model.NewSettingsModel(client.Settings),
+		StrategyModel:  model.NewStrategyModel(client.Strategy),
+		WalletModel:    model.NewWalletModel(client.Wallet),
+		StrategyCache:  cache.NewStrategyCache(client),
+		StrategyLocker: utils.NewKeyedLocker(),
+	}
+
+	// 初始化策略数据
+	_, err = svcCtx.WalletModel.Save(ctx, ent.Wallet{UserId: record.UserId, Account: account})
+	if err != nil {
+		return nil, err
+	}
+
+	record.Status = entstrategy.StatusActive
+	record.InventorySeeded = false
+	if record.GridMode == "" {
+		record.GridMode = entstrategy.DefaultGridMode
+	}
+	if record.GridType == "" {
+		record.GridType = entstrategy.DefaultGridType
+	}
+	if record.EmaFilter == "" {
+		record.EmaFilter = entstrategy.DefaultEmaFilter
+	}
+	record.FirstOrderId = nil
+	record.GridTrend = nil
+	record.BuyFilterReason = nil
+	record.AdaptiveVolatility = nil
+	record.AdaptiveUpdateTime = nil
+	record.LastLowerThresholdAlertTime = nil
+	record.LastUpperThresholdAlertTime = nil
+	record.OutOfRangeTime = nil
+	strategyRecord, err := svcCtx.StrategyModel.Save(ctx, record)
+	if err != nil {
+		return nil, err
+	}
+
+	bt := &backtester{
+		svcCtx: svcCtx,
+		env:    newEnvironment(opts),
+		report: new(Report),
+	}
+	gridStrategy := strategy.NewGridStrategyWithEnvironment(svcCtx, strategyRecord, bt.env)
+
+	// 回放K线数据
+	peak := decimal.Zero
+	for idx, ohlc := range ohlcs {
+		bt.env.price = ohlc.Close
+		bt.report.Ticks++
+		bt.report.LastPrice = ohlc.Close
+
+		err = gridStrategy.OnTick(ctx, ohlcs[max(0, idx+1-opts.Candles):idx+1])
+		if err != nil {
+			logger.Debugf("[Backtest] 策略执行失败, time: %v, %v", ohlc.Time, err)
+		}
+
+		if err = bt.settleOrders(ctx); err != nil {
+			return nil, err
+		}
+
+		// 计算最大回撤
+		equity := bt.cash.Add(bt.env.tokenBalance.Mul(ohlc.Close))
+		if equity.GreaterThan(peak) {
+			peak = equity
+		}
+		if drawdown := peak.Sub(equity); drawdown.GreaterThan(bt.report.MaxDrawdown) {
+			bt.report.MaxDrawdown = drawdown
+		}
+		if bt.cash.Neg().GreaterThan(bt.report.MaxCapitalUsed) {
+			bt.report.MaxCapitalUsed = bt.cash.Neg()
+		}
+
+		if bt.env.exitRule != nil {
+			exitTime := ohlc.Time
+			bt.report.ExitRule = bt.env.exitRule
+			bt.report.ExitTime = &exitTime
+			break
+		}
+	}
+
+	// 计算未实现盈利
+	gridRecords, err := svcCtx.GridModel.FindByStrategyId(ctx, strategyRecord.GUID)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range gridRecords {
+		if item.Status != grid.StatusBought {
+			continue
+		}
+		bt.report.UnrealizedProfit = bt.report.UnrealizedProfit.Add(item.Quantity.Mul(bt.report.LastPrice).Sub(item.Amount))
+	}
+	bt.report.Holding = bt.env.tokenBalance
+
+	return bt.report, nil
+}
+
+func (bt *backtester) settleOrders(ctx context.Context) error {
+	orders, err := bt.svcCtx.OrderModel.FindPendingOrders(ctx, 100)
+	if err != nil {
+		return err
+	}
+
+	for _, ord := range orders {
+		if err = bt.settleOrder(ctx, ord); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (bt *backtester) settleOrder(ctx context.Context, ord *ent.Order) error {
+	cost := decimal.Zero
+	var finalPrice decimal.Decimal
+	switch ord.Type {
+	case order.TypeBuy:
+		finalPrice = ord.InAmount.Div(ord.OutAmount)
+		bt.cash = bt.cash.Sub(ord.InAmount)
+		bt.env.tokenBalance = bt.env.tokenBalance.Add(ord.OutAmount)
+		bt.report.Buys++
+	case order.TypeSell:
+		finalPrice = ord.OutAmount.Div(ord.InAmount)
+		bt.cash = bt.cash.Add(ord.OutAmount)
+		bt.env.tokenBalance = bt.env.tokenBalance.Sub(ord.InAmount)
+		bt.report.Sells++
+
+		if ord.GridBuyCost != nil {
+			cost = *ord.GridBuyCost
+		} else if ord.GridId != nil {
+			g, err := bt.svcCtx.GridModel.FindByGuid(ctx, *ord.GridId)
+			if err != nil {
+				return err
+			}
+			cost = g.Amount
+		}
+		if ord.GridId != nil {
+			bt.report.RoundTrips++
+		}
+	}
+	bt.report.TotalFees = bt.report.TotalFees.Add(bt.env.fees[ord.TxHash])
+
+	s, err := bt.svcCtx.StrategyModel.FindByGUID(ctx, ord.StrategyId)
+	if err != nil {
+		return err
+	}
+
+	// 更新订单状态
+	var profit *decimal.Decimal
+	err = utils.Tx(ctx, bt.svcCtx.DbClient, func(tx *ent.Tx) error {
+		if ord.GridId != nil {
+			switch ord.Type {
+			case order.TypeBuy:
+				err := model.NewGridModel(tx.Grid).SetBoughtStatus(ctx, *ord.GridId, finalPrice, ord.OutAmount)
+				if err != nil {
+					return err
+				}
+			case order.TypeSell:
+				err := model.NewGridModel(tx.Grid).SettleSell(ctx, *ord.GridId, ord.InAmount, cost)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		if !cost.IsZero() {
+			profit = lo.ToPtr(ord.OutAmount.Sub(cost))
+			err = model.NewOrderModel(tx.Order).UpdateProfit(ctx, ord.ID, *profit)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = model.NewOrderModel(tx.Order).SetOrderClosedStatus(ctx, ord.ID, finalPrice, ord.OutAmount)
+		if err != nil {
+			return err
+		}
+
+		if ord.GridId != nil && s.FirstOrderId == nil {
+			return model.NewStrategyModel(tx.Strategy).UpdateFirstOrderId(ctx, s.ID, &ord.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// 累加已实现盈利
+	if profit != nil {
+		bt.report.RealizedProfit = bt.report.RealizedProfit.Add(*profit)
+		bt.svcCtx.StrategyCache.AddRealizedProfit(ord.StrategyId, ord.ID, *profit)
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package backtest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fachebot/evm-grid-bot/internal/charts"
+	"github.com/fachebot/evm-grid-bot/internal/ent"
+	"github.com/fachebot/evm-grid-bot/internal/strategy"
+
+	"github.com/shopspring/decimal"
+)
+
+func newOhlcs(prices []float64) []charts.Ohlc {
+	now := time.Now()
+	ohlcs := make([]charts.Ohlc, 0, len(prices))
+	for idx, price := range prices {
+		p := decimal.NewFromFloat(price)
+		ohlcs = append(ohlcs, charts.Ohlc{
+			Open:   p,
+			Close:  p,
+			High:   p,
+			Low:    p,
+			Time:   now.Add(time.Duration(idx) * time.Minute),
+			Volume: decimal.NewFromInt(1000),
+		})
+	}
+	return ohlcs
+}
+
+func newStrategy() ent.Strategy {
+	maxGridLimit := 5
+	return ent.Strategy{
+		GUID:             "backtest",
+		UserId:           1,
+		Token:            "0x0000000000000000000000000000000000001234",
+		Symbol:           "TEST",
+		MartinFactor:     1,
+		MaxGridLimit:     &maxGridLimit,
+		TakeProfitRatio:  decimal.NewFromInt(5),
+		LowerPriceBound:  decimal.NewFromFloat(0.6),
+		UpperPriceBound:  decimal.NewFromFloat(1.1),
+		InitialOrderSize: decimal.NewFromInt(10),
+		EnableAutoBuy:    true,
+		EnableAutoSell:   true,
+		EnableAutoExit:   true,
+	}
+}
+
+func TestRun(t *testing.T) {
+	prices := []float64{1.0, 0.95, 0.9, 0.85, 0.9, 0.95, 1.0, 1.05, 0.9, 0.8, 0.7, 0.5, 0.4}
+	report, err := Run(context.Background(), newStrategy(), newOhlcs(prices), Options{SlippageBps: 10, FeeBps: 10, TokenDecimals: 18})
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	if report.ExitRule == nil || *report.ExitRule != strategy.ExitRulePriceRangeStopLoss {
+		t.Fatalf("ExitRule = %v, want %v", report.ExitRule, strategy.ExitRulePriceRangeStopLoss)
+	}
+	if report.RoundTrips == 0 {
+		t.Errorf("RoundTrips = 0, want > 0")
+	}
+	if report.Ticks != len(prices)-1 {
+		t.Errorf("Ticks = %d, want %d", report.Ticks, len(prices)-1)
+	}
+	if !report.Holding.IsZero() || !report.UnrealizedProfit.IsZero() {
+		t.Errorf("Holding = %v, UnrealizedProfit = %v, want 0", report.Holding, report.UnrealizedProfit)
+	}
+	if report.MaxDrawdown.LessThanOrEqual(decimal.Zero) {
+		t.Errorf("MaxDrawdown = %v, want > 0", report.MaxDrawdown)
+	}
+}
+
+func TestRunPartialTakeProfit(t *testing.T) {
+	prices := []float64{1.0, 0.95, 0.9, 0.85, 0.9, 0.95, 1.0, 1.05}
+	portion := decimal.NewFromInt(80)
+	record := newStrategy()
+	record.TakeProfitPortion = &portion
+
+	report, err := Run(context.Background(), record, newOhlcs(prices), Options{TokenDecimals: 18})
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	if report.RoundTrips == 0 {
+		t.Fatalf("RoundTrips = 0, want > 0")
+	}
+	if report.Holding.LessThanOrEqual(decimal.Zero) {
+		t.Errorf("Holding = %v, want runner quantity > 0", report.Holding)
+	}
+	if report.RealizedProfit.LessThanOrEqual(decimal.Zero) {
+		t.Errorf("RealizedProfit = %v, want > 0", report.RealizedProfit)
+	}
+}
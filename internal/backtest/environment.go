@@ -0,0 +1,137 @@
+package backtest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/fachebot/evm-grid-bot/internal/cache"
+	"github.com/fachebot/evm-grid-bot/internal/dexagg"
+	"github.com/fachebot/evm-grid-bot/internal/strategy"
+	"github.com/fachebot/evm-grid-bot/internal/swap"
+	"github.com/fachebot/evm-grid-bot/internal/utils/evm"
+
+	"github.com/shopspring/decimal"
+)
+
+type simulatedSwapTransaction struct {
+	env         *environment
+	signer      string
+	outAmount   *big.Int
+	slippageBps int
+	fee         decimal.Decimal
+}
+
+// 回测不经过聚合器, 不记录路由
+func (tx *simulatedSwapTransaction) Route() swap.RouteInfo {
+	return swap.RouteInfo{}
+}
+
+func (tx *simulatedSwapTransaction) Signer() string {
+	return tx.signer
+}
+
+func (tx *simulatedSwapTransaction) OutAmount() *big.Int {
+	return tx.outAmount
+}
+
+func (tx *simulatedSwapTransaction) SlippageBps() int {
+	return tx.slippageBps
+}
+
+// 回测按当前K线成交, 报价不会过期
+func (tx *simulatedSwapTransaction) QuoteTime() time.Time {
+	return time.Time{}
+}
+
+func (tx *simulatedSwapTransaction) QuoteTTL() time.Duration {
+	return 0
+}
+
+func (tx *simulatedSwapTransaction) SetPriceGuard(guard swap.PriceGuard) {
+}
+
+func (tx *simulatedSwapTransaction) Swap(ctx context.Context, submit dexagg.SubmitFunc) (string, uint64, error) {
+	hash := fmt.Sprintf("0x%064x", tx.env.nonce+1)
+	if submit != nil {
+		if err := submit(ctx, hash, tx.env.nonce+1, ""); err != nil {
+			return "", 0, err
+		}
+	}
+
+	tx.env.nonce++
+	tx.env.fees[hash] = tx.fee
+	return hash, tx.env.nonce, nil
+}
+
+type environment struct {
+	opts         Options
+	price        decimal.Decimal
+	nonce        uint64
+	tokenBalance decimal.Decimal
+	fees         map[string]decimal.Decimal
+	exitRule     *strategy.ExitRule
+}
+
+func newEnvironment(opts Options) *environment {
+	return &environment{
+		opts: opts,
+		fees: make(map[string]decimal.Decimal),
+	}
+}
+
+func (env *environment) GetTokenMeta(ctx context.Context, token string) (cache.TokenMeta, error) {
+	return cache.TokenMeta{Symbol: token, Decimals: env.opts.TokenDecimals}, nil
+}
+
+func (env *environment) GetTokenBalance(ctx context.Context, token, account string) (*big.Int, error) {
+	return evm.FormatUnits(env.tokenBalance, env.opts.TokenDecimals), nil
+}
+
+func (env *environment) Quote(ctx context.Context, userId int64, inputToken, outputToken string, amount *big.Int, exit bool) (swap.SwapTransaction, error) {
+	if env.price.LessThanOrEqual(decimal.Zero) {
+		return nil, errors.New("invalid price")
+	}
+
+	one := decimal.NewFromInt(1)
+	slippage := decimal.NewFromInt(int64(env.opts.SlippageBps)).Div(decimal.NewFromInt(10000))
+	feeRate := decimal.NewFromInt(int64(env.opts.FeeBps)).Div(decimal.NewFromInt(10000))
+
+	// 按当前K线收盘价成交
+	var fee decimal.Decimal
+	var outAmount *big.Int
+	if strings.EqualFold(inputToken, stablecoinCA) {
+		uiAmount := evm.ParseUnits(amount, stablecoinDecimals)
+		fee = uiAmount.Mul(feeRate)
+		price := env.price.Mul(one.Add(slippage))
+		outAmount = evm.FormatUnits(uiAmount.Sub(fee).Div(price).Truncate(int32(env.opts.TokenDecimals)), env.opts.TokenDecimals)
+	} else {
+		uiAmount := evm.ParseUnits(amount, env.opts.TokenDecimals)
+		value := uiAmount.Mul(env.price).Mul(one.Sub(slippage))
+		fee = value.Mul(feeRate)
+		outAmount = evm.FormatUnits(value.Sub(fee).Truncate(stablecoinDecimals), stablecoinDecimals)
+	}
+	if outAmount.Sign() <= 0 {
+		return nil, errors.New("insufficient output amount")
+	}
+
+	tx := &simulatedSwapTransaction{
+		env:         env,
+		signer:      account,
+		outAmount:   outAmount,
+		slippageBps: env.opts.SlippageBps,
+		fee:         fee,
+	}
+	return tx, nil
+}
+
+func (env *environment) SendMessage(ctx context.Context, userId int64, text string) error {
+	return nil
+}
+
+func (env *environment) StopStrategy(ctx context.Context, strategyId string, rule strategy.ExitRule) {
+	env.exitRule = &rule
+}
@@ -0,0 +1,291 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"github.com/fachebot/evm-grid-bot/internal/ent"
+	"github.com/fachebot/evm-grid-bot/internal/ent/grid"
+	"github.com/fachebot/evm-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/evm-grid-bot/internal/logger"
+	"github.com/fachebot/evm-grid-bot/internal/model"
+
+	"github.com/shopspring/decimal"
+)
+
+type cachedStrategy struct {
+	version uint64
+	record  *ent.Strategy
+}
+
+type cachedGrids struct {
+	version uint64
+	records []*ent.Grid
+}
+
+type cachedProfit struct {
+	firstOrderId int
+	profit       decimal.Decimal
+}
+
+// 策略状态缓存, 缓存策略配置、网格列表和已实现盈利
+// 数据库写入后按策略递增版本号并刷新该策略的缓存, 已实现盈利在订单完成时累加
+type StrategyCache struct {
+	strategyModel *model.StrategyModel
+	gridModel     *model.GridModel
+	orderModel    *model.OrderModel
+
+	// 无法确定写入影响的策略时, 递增全局版本使所有缓存失效
+	epoch atomic.Uint64
+
+	mutex          sync.Mutex
+	versions       map[string]uint64
+	profitVersions map[string]uint64
+	strategies     map[string]cachedStrategy
+	grids          map[string]cachedGrids
+	profits        map[string]cachedProfit
+}
+
+func NewStrategyCache(client *ent.Client) *StrategyCache {
+	c := &StrategyCache{
+		strategyModel:  model.NewStrategyModel(client.Strategy),
+		gridModel:      model.NewGridModel(client.Grid),
+		orderModel:     model.NewOrderModel(client.Order),
+		versions:       make(map[string]uint64),
+		profitVersions: make(map[string]uint64),
+		strategies:     make(map[string]cachedStrategy),
+		grids:          make(map[string]cachedGrids),
+		profits:        make(map[string]cachedProfit),
+	}
+	client.Use(c.hook)
+	return c
+}
+
+// 缓存版本由全局版本和策略版本组成
+func (c *StrategyCache) versionOf(guid string) uint64 {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.epoch.Load()<<32 + c.versions[guid]
+}
+
+func (c *StrategyCache) GetStrategy(ctx context.Context, guid string) (*ent.Strategy, error) {
+	version := c.versionOf(guid)
+	c.mutex.Lock()
+	item, ok := c.strategies[guid]
+	c.mutex.Unlock()
+
+	if !ok || item.version != version {
+		var err error
+		if item, err = c.loadStrategy(ctx, guid, version); err != nil {
+			return nil, err
+		}
+	}
+
+	record := *item.record
+	return &record, nil
+}
+
+func (c *StrategyCache) GetGrids(ctx context.Context, strategyId string) ([]*ent.Grid, error) {
+	version := c.versionOf(strategyId)
+	c.mutex.Lock()
+	item, ok := c.grids[strategyId]
+	c.mutex.Unlock()
+
+	if !ok || item.version != version {
+		var err error
+		if item, err = c.loadGrids(ctx, strategyId, version); err != nil {
+			return nil, err
+		}
+	}
+
+	records := make([]*ent.Grid, 0, len(item.records))
+	for _, record := range item.records {
+		g := *record
+		records = append(records, &g)
+	}
+	return records, nil
+}
+
+func (c *StrategyCache) GetRealizedProfit(ctx context.Context, strategyId string, firstOrderId int) (decimal.Decimal, error) {
+	c.mutex.Lock()
+	item, ok := c.profits[strategyId]
+	version := c.profitVersions[strategyId]
+	c.mutex.Unlock()
+
+	if ok && item.firstOrderId == firstOrderId {
+		return item.profit, nil
+	}
+
+	profit, err := c.orderModel.TotalProfit(ctx, strategyId, firstOrderId)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	// 读取期间有新的盈利累加时不写入缓存, 避免覆盖
+	c.mutex.Lock()
+	if c.profitVersions[strategyId] == version {
+		c.profits[strategyId] = cachedProfit{firstOrderId: firstOrderId, profit: profit}
+	}
+	c.mutex.Unlock()
+
+	return profit, nil
+}
+
+// 订单完成后累加已实现盈利, 需在事务提交后调用
+func (c *StrategyCache) AddRealizedProfit(strategyId string, orderId int, profit decimal.Decimal) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.profitVersions[strategyId]++
+	item, ok := c.profits[strategyId]
+	if ok && orderId >= item.firstOrderId {
+		item.profit = item.profit.Add(profit)
+		c.profits[strategyId] = item
+	}
+}
+
+func (c *StrategyCache) Remove(guid string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.versions[guid]++
+	c.profitVersions[guid]++
+	delete(c.strategies, guid)
+	delete(c.grids, guid)
+	delete(c.profits, guid)
+}
+
+func (c *StrategyCache) loadStrategy(ctx context.Context, guid string, version uint64) (cachedStrategy, error) {
+	record, err := c.strategyModel.FindByGUID(ctx, guid)
+	if err != nil {
+		return cachedStrategy{}, err
+	}
+
+	// 读取期间版本已变化时不写入缓存
+	item := cachedStrategy{version: version, record: record}
+	if c.versionOf(guid) == version {
+		c.mutex.Lock()
+		c.strategies[guid] = item
+		c.mutex.Unlock()
+	}
+	return item, nil
+}
+
+func (c *StrategyCache) loadGrids(ctx context.Context, strategyId string, version uint64) (cachedGrids, error) {
+	records, err := c.gridModel.FindByStrategyId(ctx, strategyId)
+	if err != nil {
+		return cachedGrids{}, err
+	}
+
+	// 读取期间版本已变化时不写入缓存
+	item := cachedGrids{version: version, records: records}
+	if c.versionOf(strategyId) == version {
+		c.mutex.Lock()
+		c.grids[strategyId] = item
+		c.mutex.Unlock()
+	}
+	return item, nil
+}
+
+// 写入生效后递增策略版本, 并刷新已缓存的策略数据
+func (c *StrategyCache) refresh(ctx context.Context, typ string, guids []string) {
+	for _, guid := range guids {
+		c.mutex.Lock()
+		c.versions[guid]++
+		_, strategyCached := c.strategies[guid]
+		_, gridsCached := c.grids[guid]
+		c.mutex.Unlock()
+
+		version := c.versionOf(guid)
+		var err error
+		switch {
+		case typ == ent.TypeStrategy && strategyCached:
+			_, err = c.loadStrategy(ctx, guid, version)
+		case typ == ent.TypeGrid && gridsCached:
+			_, err = c.loadGrids(ctx, guid, version)
+		}
+		if err != nil && !ent.IsNotFound(err) {
+			logger.Warnf("[StrategyCache] 刷新策略缓存失败, strategy: %s, %v", guid, err)
+		}
+	}
+}
+
+// 写入影响的策略, 更新和删除操作在执行前查询
+func affectedStrategies(ctx context.Context, m ent.Mutation) ([]string, error) {
+	switch m := m.(type) {
+	case *ent.StrategyMutation:
+		if guid, ok := m.GUID(); ok && m.Op().Is(ent.OpCreate) {
+			return []string{guid}, nil
+		}
+		ids, err := m.IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return m.Client().Strategy.Query().Where(strategy.IDIn(ids...)).Select(strategy.FieldGUID).Strings(ctx)
+	case *ent.GridMutation:
+		if strategyId, ok := m.StrategyId(); ok && m.Op().Is(ent.OpCreate) {
+			return []string{strategyId}, nil
+		}
+		ids, err := m.IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return m.Client().Grid.Query().Where(grid.IDIn(ids...)).Select(grid.FieldStrategyId).Strings(ctx)
+	}
+	return nil, nil
+}
+
+func (c *StrategyCache) hook(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		typ := m.Type()
+		if typ != ent.TypeStrategy && typ != ent.TypeGrid {
+			return next.Mutate(ctx, m)
+		}
+
+		guids, resolveErr := affectedStrategies(ctx, m)
+		if resolveErr != nil {
+			logger.Warnf("[StrategyCache] 查询写入影响的策略失败, type: %s, %v", typ, resolveErr)
+		}
+		invalidate := func(ctx context.Context) {
+			if resolveErr != nil {
+				c.epoch.Add(1)
+				return
+			}
+			c.refresh(ctx, typ, guids)
+		}
+
+		value, err := next.Mutate(ctx, m)
+
+		// 事务中的写入先使缓存失效, 提交后再刷新, 避免缓存读取到未提交或提交前的数据
+		if txm, ok := m.(interface{ Tx() (*ent.Tx, error) }); ok {
+			if tx, e := txm.Tx(); e == nil {
+				c.markStale(guids, resolveErr != nil)
+				tx.OnCommit(func(next ent.Committer) ent.Committer {
+					return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+						commitErr := next.Commit(ctx, tx)
+						invalidate(ctx)
+						return commitErr
+					})
+				})
+				return value, err
+			}
+		}
+
+		invalidate(ctx)
+		return value, err
+	})
+}
+
+func (c *StrategyCache) markStale(guids []string, all bool) {
+	if all {
+		c.epoch.Add(1)
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for _, guid := range guids {
+		c.versions[guid]++
+	}
+}
@@ -24,11 +24,29 @@ type TokenOhlcs struct {
 	Ohlcs []Ohlc
 }
 
-func CalculateRSI(ohlcs []Ohlc) []float64 {
-	prices := lo.Map(ohlcs, func(ohlc Ohlc, idx int) float64 {
+func closePrices(ohlcs []Ohlc) []float64 {
+	return lo.Map(ohlcs, func(ohlc Ohlc, idx int) float64 {
 		return ohlc.Close.InexactFloat64()
 	})
-	return talib.Rsi(prices, 14)
+}
+
+func CalculateRSI(ohlcs []Ohlc) []float64 {
+	return talib.Rsi(closePrices(ohlcs), 14)
+}
+
+func CalculateATR(ohlcs []Ohlc, period int) []float64 {
+	high := lo.Map(ohlcs, func(ohlc Ohlc, idx int) float64 { return ohlc.High.InexactFloat64() })
+	low := lo.Map(ohlcs, func(ohlc Ohlc, idx int) float64 { return ohlc.Low.InexactFloat64() })
+	return talib.Atr(high, low, closePrices(ohlcs), period)
+}
+
+func CalculateEMA(ohlcs []Ohlc, period int) []float64 {
+	return talib.Ema(closePrices(ohlcs), period)
+}
+
+func CalculateMACDHist(ohlcs []Ohlc) []float64 {
+	_, _, hist := talib.Macd(closePrices(ohlcs), 12, 26, 9)
+	return hist
 }
 
 func FillMissingOhlc(tokenOhlcs []Ohlc, to time.Time, interval time.Duration) []Ohlc {
@@ -17,11 +17,28 @@ type Chain struct {
 		Symbol   string `yaml:"Symbol"`
 		Decimals uint8  `yaml:"Decimals"`
 	} `yaml:"NativeCurrency"`
-	StablecoinCA       string `yaml:"StablecoinCA"`
-	StablecoinSymbol   string `yaml:"-"`
-	StablecoinDecimals uint8  `yaml:"-"`
-	SlippageBps        int    `yaml:"SlippageBps"`
-	DexAggregator      string `yaml:"DexAggregator"`
+	StablecoinCA       string     `yaml:"StablecoinCA"`
+	StablecoinSymbol   string     `yaml:"-"`
+	StablecoinDecimals uint8      `yaml:"-"`
+	SlippageBps        int        `yaml:"SlippageBps"`
+	DexAggregator      string     `yaml:"DexAggregator"`
+	QuoteTTLSeconds    int        `yaml:"QuoteTTLSeconds"`
+	OnchainDex         OnchainDex `yaml:"OnchainDex"`
+}
+
+// 链上DEX路由配置, V3 路由需兼容 SwapRouter02 的 exactInput
+type OnchainDex struct {
+	WrappedNative string `yaml:"WrappedNative"`
+	V2            struct {
+		Router  string `yaml:"Router"`
+		Factory string `yaml:"Factory"`
+	} `yaml:"V2"`
+	V3 struct {
+		Router   string `yaml:"Router"`
+		Quoter   string `yaml:"Quoter"`
+		Factory  string `yaml:"Factory"`
+		FeeTiers []int  `yaml:"FeeTiers"`
+	} `yaml:"V3"`
 }
 
 type OkxWeb3 struct {
@@ -131,6 +148,27 @@ type QuickStartSettings struct {
 	DropThreshold         decimal.Decimal `yaml:"DropThreshold"`
 }
 
+type TxReplacement struct {
+	Enable              bool `yaml:"Enable"`
+	SpeedUpDelaySeconds int  `yaml:"SpeedUpDelaySeconds"`
+	FeeBumpPercent      int  `yaml:"FeeBumpPercent"`
+	MaxSpeedUps         int  `yaml:"MaxSpeedUps"`
+}
+
+func (c *TxReplacement) Validate() error {
+	if c.SpeedUpDelaySeconds <= 0 {
+		c.SpeedUpDelaySeconds = 60
+	}
+	// 节点要求替换交易的手续费至少提高10%
+	if c.FeeBumpPercent < 10 {
+		c.FeeBumpPercent = 20
+	}
+	if c.MaxSpeedUps < 0 {
+		c.MaxSpeedUps = 0
+	}
+	return nil
+}
+
 type TokenRequirements struct {
 	MinMarketCap       decimal.Decimal `yaml:"MinMarketCap"`
 	MinHolderCount     int             `yaml:"MinHolderCount"`
@@ -150,6 +188,7 @@ type Config struct {
 	DefaultGridSettings DefaultGridSettings `yaml:"DefaultGridSettings"`
 	QuickStartSettings  QuickStartSettings  `yaml:"QuickStartSettings"`
 	TokenRequirements   TokenRequirements   `yaml:"TokenRequirements"`
+	TxReplacement       TxReplacement       `yaml:"TxReplacement"`
 }
 
 func LoadFromFile(filename string) (*Config, error) {
@@ -168,6 +207,10 @@ func LoadFromFile(filename string) (*Config, error) {
 		return nil, fmt.Errorf("DefaultGridSettings配置错误: %w", err)
 	}
 
+	if err = c.TxReplacement.Validate(); err != nil {
+		return nil, fmt.Errorf("TxReplacement配置错误: %w", err)
+	}
+
 	if c.Datapi != "gmgn" && c.Datapi != "okx" {
 		return nil, errors.New("Datapi配置枚举值范围: gmgn/okx")
 	}
@@ -2,6 +2,7 @@ package okxweb3
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"github.com/fachebot/evm-grid-bot/internal/charts"
@@ -111,7 +112,8 @@ func (m *KlineManager) run() {
 
 			if m.tokenOhlcsChan != nil {
 				select {
-				case m.tokenOhlcsChan <- charts.TokenOhlcs{Token: data.Token, Ohlcs: ohlcs}:
+				// 发送副本, 避免与后续更新共享底层数组
+				case m.tokenOhlcsChan <- charts.TokenOhlcs{Token: data.Token, Ohlcs: slices.Clone(ohlcs)}:
 				default:
 					logger.Warnf("[KlineManager] 分发 Ohlcs 数据, channel 已满. token: %+v", data.Token)
 				}
@@ -1,7 +1,21 @@
 package dexagg
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	ErrInsufficientBalance = errors.New("insufficient balance")
+	ErrQuoteExpired        = errors.New("quote expired")
+	ErrTxNotMined          = errors.New("transaction not mined")
 )
+
+// 交易模拟执行失败
+type SimulationError struct {
+	Reason string
+}
+
+func (e *SimulationError) Error() string {
+	return fmt.Sprintf("simulation reverted: %s", e.Reason)
+}
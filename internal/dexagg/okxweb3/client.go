@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math/big"
 	"net/http"
 	"strings"
 	"time"
@@ -106,6 +107,76 @@ func (client *Client) GetAllTokenBalancesByAddress(ctx context.Context, chainInd
 	return tokenAssets[0].TokenAssets, nil
 }
 
+// 获取兑换报价, amount 为最小单位数量
+func (client *Client) Quote(ctx context.Context, chainIndex, fromToken, toToken string, amount *big.Int) (*RouterResult, error) {
+	params := map[string]string{
+		"chainIndex":       chainIndex,
+		"amount":           amount.String(),
+		"fromTokenAddress": fromToken,
+		"toTokenAddress":   toToken,
+	}
+	res, err := client.request(ctx, http.MethodGet, "/api/v5/dex/aggregator/quote", true, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []RouterResult
+	if err = client.toJSON(res, &results); err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, errors.New("no route found")
+	}
+	return &results[0], nil
+}
+
+// 获取授权交易数据, 授权对象为 DexContractAddress
+func (client *Client) ApproveTransaction(ctx context.Context, chainIndex, token string, amount *big.Int) (*ApproveTransaction, error) {
+	params := map[string]string{
+		"chainIndex":           chainIndex,
+		"tokenContractAddress": token,
+		"approveAmount":        amount.String(),
+	}
+	res, err := client.request(ctx, http.MethodGet, "/api/v5/dex/aggregator/approve-transaction", true, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []ApproveTransaction
+	if err = client.toJSON(res, &results); err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, errors.New("empty approve transaction")
+	}
+	return &results[0], nil
+}
+
+// 获取兑换交易数据, 滑点单位为Bps
+func (client *Client) Swap(ctx context.Context, chainIndex, fromToken, toToken string, amount *big.Int, slippageBps int, userWalletAddress string) (*SwapResult, error) {
+	params := map[string]string{
+		"chainIndex":        chainIndex,
+		"amount":            amount.String(),
+		"fromTokenAddress":  fromToken,
+		"toTokenAddress":    toToken,
+		"slippage":          decimal.NewFromInt(int64(slippageBps)).Div(decimal.NewFromInt(10000)).String(),
+		"userWalletAddress": userWalletAddress,
+	}
+	res, err := client.request(ctx, http.MethodGet, "/api/v5/dex/aggregator/swap", true, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []SwapResult
+	if err = client.toJSON(res, &results); err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, errors.New("no route found")
+	}
+	return &results[0], nil
+}
+
 func (client *Client) sign(method, path, body string) (string, string) {
 	format := "2006-01-02T15:04:05.999Z07:00"
 	t := time.Now().UTC().Format(format)
@@ -114,3 +114,27 @@ type SwapInstruction struct {
 	RouterResult              RouterResult  `json:"routerResult"`
 	Tx                        Transaction   `json:"tx"`
 }
+
+type ApproveTransaction struct {
+	Data               string          `json:"data"`
+	DexContractAddress string          `json:"dexContractAddress"`
+	GasLimit           decimal.Decimal `json:"gasLimit"`
+	GasPrice           decimal.Decimal `json:"gasPrice"`
+}
+
+type EvmTransaction struct {
+	Data                 string          `json:"data"`
+	From                 string          `json:"from"`
+	Gas                  decimal.Decimal `json:"gas"`
+	GasPrice             decimal.Decimal `json:"gasPrice"`
+	MaxPriorityFeePerGas decimal.Decimal `json:"maxPriorityFeePerGas"`
+	MinReceiveAmount     decimal.Decimal `json:"minReceiveAmount"`
+	Slippage             decimal.Decimal `json:"slippage"`
+	To                   string          `json:"to"`
+	Value                decimal.Decimal `json:"value"`
+}
+
+type SwapResult struct {
+	RouterResult RouterResult   `json:"routerResult"`
+	Tx           EvmTransaction `json:"tx"`
+}
@@ -0,0 +1,118 @@
+package onchain
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+const v2FactoryABI = `[
+	{
+		"inputs": [{"name": "tokenA", "type": "address"}, {"name": "tokenB", "type": "address"}],
+		"name": "getPair",
+		"outputs": [{"name": "pair", "type": "address"}],
+		"stateMutability": "view",
+		"type": "function"
+	}
+]`
+
+const v2RouterABI = `[
+	{
+		"inputs": [{"name": "amountIn", "type": "uint256"}, {"name": "path", "type": "address[]"}],
+		"name": "getAmountsOut",
+		"outputs": [{"name": "amounts", "type": "uint256[]"}],
+		"stateMutability": "view",
+		"type": "function"
+	},
+	{
+		"inputs": [
+			{"name": "amountIn", "type": "uint256"},
+			{"name": "amountOutMin", "type": "uint256"},
+			{"name": "path", "type": "address[]"},
+			{"name": "to", "type": "address"},
+			{"name": "deadline", "type": "uint256"}
+		],
+		"name": "swapExactTokensForTokensSupportingFeeOnTransferTokens",
+		"outputs": [],
+		"stateMutability": "nonpayable",
+		"type": "function"
+	}
+]`
+
+const v3FactoryABI = `[
+	{
+		"inputs": [
+			{"name": "tokenA", "type": "address"},
+			{"name": "tokenB", "type": "address"},
+			{"name": "fee", "type": "uint24"}
+		],
+		"name": "getPool",
+		"outputs": [{"name": "pool", "type": "address"}],
+		"stateMutability": "view",
+		"type": "function"
+	}
+]`
+
+const v3QuoterABI = `[
+	{
+		"inputs": [{"name": "path", "type": "bytes"}, {"name": "amountIn", "type": "uint256"}],
+		"name": "quoteExactInput",
+		"outputs": [
+			{"name": "amountOut", "type": "uint256"},
+			{"name": "sqrtPriceX96AfterList", "type": "uint160[]"},
+			{"name": "initializedTicksCrossedList", "type": "uint32[]"},
+			{"name": "gasEstimate", "type": "uint256"}
+		],
+		"stateMutability": "nonpayable",
+		"type": "function"
+	}
+]`
+
+const v3RouterABI = `[
+	{
+		"inputs": [
+			{
+				"components": [
+					{"name": "path", "type": "bytes"},
+					{"name": "recipient", "type": "address"},
+					{"name": "amountIn", "type": "uint256"},
+					{"name": "amountOutMinimum", "type": "uint256"}
+				],
+				"name": "params",
+				"type": "tuple"
+			}
+		],
+		"name": "exactInput",
+		"outputs": [{"name": "amountOut", "type": "uint256"}],
+		"stateMutability": "payable",
+		"type": "function"
+	}
+]`
+
+var (
+	V2FactoryABI abi.ABI
+	V2RouterABI  abi.ABI
+	V3FactoryABI abi.ABI
+	V3QuoterABI  abi.ABI
+	V3RouterABI  abi.ABI
+)
+
+func init() {
+	for _, item := range []struct {
+		abi  *abi.ABI
+		json string
+	}{
+		{&V2FactoryABI, v2FactoryABI},
+		{&V2RouterABI, v2RouterABI},
+		{&V3FactoryABI, v3FactoryABI},
+		{&V3QuoterABI, v3QuoterABI},
+		{&V3RouterABI, v3RouterABI},
+	} {
+		parsed, err := abi.JSON(strings.NewReader(item.json))
+		if err != nil {
+			panic(fmt.Errorf("failed to parse ABI: %w", err))
+		}
+		*item.abi = parsed
+	}
+}
@@ -0,0 +1,255 @@
+package onchain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/fachebot/evm-grid-bot/internal/config"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+var ErrNoRoute = errors.New("no route found")
+
+// 单次批量请求的最大调用数量
+const callBatchSize = 100
+
+// 通过链上路由合约报价和构建兑换交易, 不依赖第三方API
+type Client struct {
+	ethClient *ethclient.Client
+	config    config.OnchainDex
+}
+
+func NewClient(ethClient *ethclient.Client, c config.OnchainDex) *Client {
+	return &Client{ethClient: ethClient, config: c}
+}
+
+// 在 V2 和 V3 的候选路径中选择输出最多的路径
+// 先批量查询流动性池, 再批量获取各路径报价, 共两次请求
+func (client *Client) Quote(ctx context.Context, tokenIn, tokenOut string, amountIn *big.Int) (*Route, error) {
+	paths := client.candidatePaths(common.HexToAddress(tokenIn), common.HexToAddress(tokenOut))
+	v2Enabled := client.config.V2.Router != ""
+	v3Enabled := client.config.V3.Quoter != "" && client.config.V3.Router != ""
+
+	// 查询每一跳的流动性池
+	v2Pairs := make(map[[2]common.Address]*contractCall)
+	v3Pools := make(map[[2]common.Address][]*contractCall)
+	lookups := make([]*contractCall, 0)
+	for _, tokens := range paths {
+		for i := 0; i < len(tokens)-1; i++ {
+			hop := [2]common.Address{tokens[i], tokens[i+1]}
+			if v2Enabled && client.config.V2.Factory != "" {
+				call := newContractCall(client.config.V2.Factory, V2FactoryABI, "getPair", hop[0], hop[1])
+				v2Pairs[hop] = call
+				lookups = append(lookups, call)
+			}
+			if v3Enabled && client.config.V3.Factory != "" {
+				for _, fee := range client.config.V3.FeeTiers {
+					call := newContractCall(client.config.V3.Factory, V3FactoryABI, "getPool", hop[0], hop[1], big.NewInt(int64(fee)))
+					v3Pools[hop] = append(v3Pools[hop], call)
+					lookups = append(lookups, call)
+				}
+			}
+		}
+	}
+	if err := client.batchCall(ctx, lookups); err != nil {
+		return nil, err
+	}
+
+	// 获取各路径报价
+	routes := make([]*Route, 0)
+	quotes := make([]*contractCall, 0)
+	for _, tokens := range paths {
+		if v2Enabled && client.v2PairsExist(tokens, v2Pairs) {
+			routes = append(routes, &Route{Version: V2, Tokens: tokens, AmountIn: amountIn})
+			quotes = append(quotes, newContractCall(client.config.V2.Router, V2RouterABI, "getAmountsOut", amountIn, tokens))
+		}
+
+		if v3Enabled {
+			for _, fees := range client.v3FeeCombos(tokens, v3Pools) {
+				path, err := encodeV3Path(tokens, fees)
+				if err != nil {
+					return nil, err
+				}
+				routes = append(routes, &Route{Version: V3, Tokens: tokens, Fees: fees, AmountIn: amountIn})
+				quotes = append(quotes, newContractCall(client.config.V3.Quoter, V3QuoterABI, "quoteExactInput", path, amountIn))
+			}
+		}
+	}
+	if err := client.batchCall(ctx, quotes); err != nil {
+		return nil, err
+	}
+
+	var best *Route
+	for idx, route := range routes {
+		if amountOut, err := parseAmountOut(route, quotes[idx]); err == nil && isBetter(amountOut, best) {
+			route.AmountOut = amountOut
+			best = route
+		}
+	}
+
+	if best == nil {
+		return nil, ErrNoRoute
+	}
+	return best, nil
+}
+
+// 路由合约地址, 即授权对象
+func (client *Client) Router(route *Route) common.Address {
+	if route.Version == V3 {
+		return common.HexToAddress(client.config.V3.Router)
+	}
+	return common.HexToAddress(client.config.V2.Router)
+}
+
+// 构建兑换交易的调用数据
+func (client *Client) BuildSwapCalldata(route *Route, recipient common.Address, amountOutMin, deadline *big.Int) ([]byte, error) {
+	switch route.Version {
+	case V2:
+		return V2RouterABI.Pack("swapExactTokensForTokensSupportingFeeOnTransferTokens",
+			route.AmountIn, amountOutMin, route.Tokens, recipient, deadline)
+	case V3:
+		path, err := encodeV3Path(route.Tokens, route.Fees)
+		if err != nil {
+			return nil, err
+		}
+		return V3RouterABI.Pack("exactInput", v3ExactInputParams{
+			Path:             path,
+			Recipient:        recipient,
+			AmountIn:         route.AmountIn,
+			AmountOutMinimum: amountOutMin,
+		})
+	default:
+		return nil, fmt.Errorf("unsupported version: %d", route.Version)
+	}
+}
+
+// 候选路径: 直连, 以及经过包装原生代币的两跳路径
+func (client *Client) candidatePaths(tokenIn, tokenOut common.Address) [][]common.Address {
+	paths := [][]common.Address{{tokenIn, tokenOut}}
+	if client.config.WrappedNative != "" {
+		wrapped := common.HexToAddress(client.config.WrappedNative)
+		if wrapped != tokenIn && wrapped != tokenOut {
+			paths = append(paths, []common.Address{tokenIn, wrapped, tokenOut})
+		}
+	}
+	return paths
+}
+
+// 每一跳都存在交易对, 未配置工厂合约时不检查
+func (client *Client) v2PairsExist(tokens []common.Address, pairs map[[2]common.Address]*contractCall) bool {
+	if client.config.V2.Factory == "" {
+		return true
+	}
+
+	for i := 0; i < len(tokens)-1; i++ {
+		call := pairs[[2]common.Address{tokens[i], tokens[i+1]}]
+		if pair, err := call.address(); err != nil || pair == (common.Address{}) {
+			return false
+		}
+	}
+	return true
+}
+
+// 每一跳存在流动性池的手续费等级组合, 未配置工厂合约时使用所有手续费等级
+func (client *Client) v3FeeCombos(tokens []common.Address, pools map[[2]common.Address][]*contractCall) [][]uint32 {
+	combos := [][]uint32{{}}
+	for i := 0; i < len(tokens)-1; i++ {
+		var fees []uint32
+		for idx, fee := range client.config.V3.FeeTiers {
+			if client.config.V3.Factory != "" {
+				pool, err := pools[[2]common.Address{tokens[i], tokens[i+1]}][idx].address()
+				if err != nil || pool == (common.Address{}) {
+					continue
+				}
+			}
+			fees = append(fees, uint32(fee))
+		}
+
+		next := make([][]uint32, 0, len(combos)*len(fees))
+		for _, combo := range combos {
+			for _, fee := range fees {
+				next = append(next, append(append([]uint32{}, combo...), fee))
+			}
+		}
+		combos = next
+	}
+	return combos
+}
+
+// 解析报价结果中的输出数量
+func parseAmountOut(route *Route, call *contractCall) (*big.Int, error) {
+	values, err := call.unpack()
+	if err != nil {
+		return nil, err
+	}
+
+	switch route.Version {
+	case V2:
+		amounts, ok := values[0].([]*big.Int)
+		if !ok || len(amounts) != len(route.Tokens) {
+			return nil, ErrNoRoute
+		}
+		return amounts[len(amounts)-1], nil
+	default:
+		amountOut, ok := values[0].(*big.Int)
+		if !ok {
+			return nil, errors.New("failed to parse amountOut")
+		}
+		return amountOut, nil
+	}
+}
+
+// 批量执行合约调用, 单个调用的错误记录在调用结果中
+func (client *Client) batchCall(ctx context.Context, calls []*contractCall) error {
+	for start := 0; start < len(calls); start += callBatchSize {
+		end := min(start+callBatchSize, len(calls))
+		elems := make([]rpc.BatchElem, end-start)
+		for idx, call := range calls[start:end] {
+			data, err := call.abi.Pack(call.method, call.args...)
+			if err != nil {
+				return fmt.Errorf("failed to pack %s call: %w", call.method, err)
+			}
+			elems[idx] = rpc.BatchElem{
+				Method: "eth_call",
+				Args:   []any{map[string]any{"to": call.to, "data": hexutil.Bytes(data)}, "latest"},
+				Result: &call.result,
+			}
+		}
+
+		if err := client.ethClient.Client().BatchCallContext(ctx, elems); err != nil {
+			return err
+		}
+
+		for idx, elem := range elems {
+			calls[start+idx].err = elem.Error
+		}
+	}
+	return nil
+}
+
+func isBetter(amountOut *big.Int, best *Route) bool {
+	return amountOut != nil && amountOut.Sign() > 0 && (best == nil || amountOut.Cmp(best.AmountOut) > 0)
+}
+
+// V3 路径编码: token(20字节) + fee(3字节) + token ...
+func encodeV3Path(tokens []common.Address, fees []uint32) ([]byte, error) {
+	if len(tokens) < 2 || len(fees) != len(tokens)-1 {
+		return nil, errors.New("invalid v3 path")
+	}
+
+	path := make([]byte, 0, len(tokens)*20+len(fees)*3)
+	for i, token := range tokens {
+		path = append(path, token.Bytes()...)
+		if i < len(fees) {
+			fee := fees[i]
+			path = append(path, byte(fee>>16), byte(fee>>8), byte(fee))
+		}
+	}
+	return path, nil
+}
@@ -0,0 +1,281 @@
+package onchain
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/fachebot/evm-grid-bot/internal/config"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/core/vm/program"
+	"github.com/ethereum/go-ethereum/eth/ethconfig"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/node"
+)
+
+var (
+	tokenA  = common.HexToAddress("0x00000000000000000000000000000000000000a1")
+	tokenB  = common.HexToAddress("0x00000000000000000000000000000000000000b1")
+	wrapped = common.HexToAddress("0x00000000000000000000000000000000000000c1")
+
+	v2Factory      = common.HexToAddress("0x0000000000000000000000000000000000001001")
+	v2EmptyFactory = common.HexToAddress("0x0000000000000000000000000000000000001002")
+	v2Router       = common.HexToAddress("0x0000000000000000000000000000000000001003")
+	v3Factory      = common.HexToAddress("0x0000000000000000000000000000000000002001")
+	v3Quoter       = common.HexToAddress("0x0000000000000000000000000000000000002002")
+	v3Router       = common.HexToAddress("0x0000000000000000000000000000000000002003")
+	pairAddress    = common.HexToAddress("0x0000000000000000000000000000000000003001")
+)
+
+// 模拟合约:
+// V2 工厂返回固定交易对, 空工厂返回零地址
+// V2 路由 getAmountsOut 输出为 amountIn * 路径代币数量
+// V3 工厂只有手续费等级 500 的流动性池
+// V3 报价合约 quoteExactInput 输出为 amountIn * 编码路径字节数
+func newSimulatedClient(t *testing.T) *ethclient.Client {
+	v2FactoryCode := program.New().
+		Push(pairAddress).Push(0).Op(vm.MSTORE).
+		Return(0, 32).Bytes()
+	v2EmptyFactoryCode := program.New().Return(0, 32).Bytes()
+	v2RouterCode := program.New().
+		Push(0x20).Push(0).Op(vm.MSTORE).
+		Push(36).Op(vm.CALLDATALOAD).Push(4).Op(vm.ADD).Op(vm.CALLDATALOAD).
+		Op(vm.DUP1).Push(32).Op(vm.MSTORE).
+		Op(vm.DUP1).Push(4).Op(vm.CALLDATALOAD).Op(vm.MUL).
+		Op(vm.DUP2).Push(32).Op(vm.MUL).Push(32).Op(vm.ADD).
+		Op(vm.MSTORE).
+		Push(32).Op(vm.MUL).Push(64).Op(vm.ADD).
+		Push(0).Op(vm.RETURN).Bytes()
+	v3FactoryCode := program.New().
+		Push(68).Op(vm.CALLDATALOAD).Push(500).Op(vm.EQ).Push(pairAddress).Op(vm.MUL).
+		Push(0).Op(vm.MSTORE).
+		Return(0, 32).Bytes()
+	v3QuoterCode := program.New().
+		Push(4).Op(vm.CALLDATALOAD).Push(4).Op(vm.ADD).Op(vm.CALLDATALOAD).
+		Push(36).Op(vm.CALLDATALOAD).Op(vm.MUL).Push(0).Op(vm.MSTORE).
+		Push(0x80).Push(32).Op(vm.MSTORE).
+		Push(0xa0).Push(64).Op(vm.MSTORE).
+		Return(0, 192).Bytes()
+
+	// 通过 IPC 连接模拟链, 以便使用批量请求
+	ipcPath := filepath.Join(t.TempDir(), "geth.ipc")
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		v2Factory:      {Code: v2FactoryCode},
+		v2EmptyFactory: {Code: v2EmptyFactoryCode},
+		v2Router:       {Code: v2RouterCode},
+		v3Factory:      {Code: v3FactoryCode},
+		v3Quoter:       {Code: v3QuoterCode},
+	}, func(nodeConf *node.Config, ethConf *ethconfig.Config) {
+		nodeConf.IPCPath = ipcPath
+	})
+	t.Cleanup(func() { backend.Close() })
+
+	ethClient, err := ethclient.Dial(ipcPath)
+	if err != nil {
+		t.Fatalf("failed to dial simulated backend: %v", err)
+	}
+	t.Cleanup(ethClient.Close)
+	return ethClient
+}
+
+func newOnchainConfig(v2, v3 bool, v2FactoryAddress common.Address, wrappedNative string) config.OnchainDex {
+	var c config.OnchainDex
+	c.WrappedNative = wrappedNative
+	if v2 {
+		c.V2.Router = v2Router.Hex()
+		c.V2.Factory = v2FactoryAddress.Hex()
+	}
+	if v3 {
+		c.V3.Router = v3Router.Hex()
+		c.V3.Quoter = v3Quoter.Hex()
+		c.V3.Factory = v3Factory.Hex()
+		c.V3.FeeTiers = []int{500, 3000}
+	}
+	return c
+}
+
+func TestQuote(t *testing.T) {
+	ethClient := newSimulatedClient(t)
+	amountIn := big.NewInt(1000)
+
+	tests := []struct {
+		name      string
+		config    config.OnchainDex
+		version   Version
+		tokens    []common.Address
+		fees      []uint32
+		amountOut int64
+		err       error
+	}{
+		{
+			name:      "V2直连",
+			config:    newOnchainConfig(true, false, v2Factory, ""),
+			version:   V2,
+			tokens:    []common.Address{tokenA, tokenB},
+			amountOut: 2000,
+		},
+		{
+			name:      "V2经过包装原生代币",
+			config:    newOnchainConfig(true, false, v2Factory, wrapped.Hex()),
+			version:   V2,
+			tokens:    []common.Address{tokenA, wrapped, tokenB},
+			amountOut: 3000,
+		},
+		{
+			name:   "V2交易对不存在",
+			config: newOnchainConfig(true, false, v2EmptyFactory, wrapped.Hex()),
+			err:    ErrNoRoute,
+		},
+		{
+			name:      "V3只使用存在流动性池的手续费等级",
+			config:    newOnchainConfig(false, true, v2Factory, ""),
+			version:   V3,
+			tokens:    []common.Address{tokenA, tokenB},
+			fees:      []uint32{500},
+			amountOut: 43000,
+		},
+		{
+			name:      "选择输出最多的路由",
+			config:    newOnchainConfig(true, true, v2Factory, wrapped.Hex()),
+			version:   V3,
+			tokens:    []common.Address{tokenA, wrapped, tokenB},
+			fees:      []uint32{500, 500},
+			amountOut: 66000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewClient(ethClient, tt.config)
+			route, err := client.Quote(context.Background(), tokenA.Hex(), tokenB.Hex(), amountIn)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("Quote() error = %v, expected %v", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Quote() error = %v", err)
+			}
+
+			if route.Version != tt.version || !slices.Equal(route.Tokens, tt.tokens) || !slices.Equal(route.Fees, tt.fees) {
+				t.Errorf("Quote() = v%d %v %v, expected v%d %v %v", route.Version, route.Tokens, route.Fees, tt.version, tt.tokens, tt.fees)
+			}
+			if route.AmountOut.Cmp(big.NewInt(tt.amountOut)) != 0 {
+				t.Errorf("Quote() amountOut = %v, expected %v", route.AmountOut, tt.amountOut)
+			}
+		})
+	}
+}
+
+func TestBuildSwapCalldata(t *testing.T) {
+	recipient := common.HexToAddress("0x00000000000000000000000000000000000000d1")
+	amountOutMin := big.NewInt(900)
+	deadline := big.NewInt(1700000000)
+
+	tests := []struct {
+		name  string
+		route *Route
+	}{
+		{
+			name:  "V2",
+			route: &Route{Version: V2, Tokens: []common.Address{tokenA, wrapped, tokenB}, AmountIn: big.NewInt(1000)},
+		},
+		{
+			name:  "V3",
+			route: &Route{Version: V3, Tokens: []common.Address{tokenA, wrapped, tokenB}, Fees: []uint32{500, 3000}, AmountIn: big.NewInt(1000)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewClient(nil, newOnchainConfig(true, true, v2Factory, wrapped.Hex()))
+			data, err := client.BuildSwapCalldata(tt.route, recipient, amountOutMin, deadline)
+			if err != nil {
+				t.Fatalf("BuildSwapCalldata() error = %v", err)
+			}
+
+			switch tt.route.Version {
+			case V2:
+				method := V2RouterABI.Methods["swapExactTokensForTokensSupportingFeeOnTransferTokens"]
+				args, err := method.Inputs.Unpack(data[4:])
+				if err != nil {
+					t.Fatalf("unpack calldata error = %v", err)
+				}
+				if !bytes.Equal(data[:4], method.ID) || args[0].(*big.Int).Cmp(tt.route.AmountIn) != 0 ||
+					args[1].(*big.Int).Cmp(amountOutMin) != 0 || !slices.Equal(args[2].([]common.Address), tt.route.Tokens) ||
+					args[3].(common.Address) != recipient || args[4].(*big.Int).Cmp(deadline) != 0 {
+					t.Errorf("BuildSwapCalldata() args = %v", args)
+				}
+			case V3:
+				method := V3RouterABI.Methods["exactInput"]
+				args, err := method.Inputs.Unpack(data[4:])
+				if err != nil {
+					t.Fatalf("unpack calldata error = %v", err)
+				}
+				params := *abi.ConvertType(args[0], new(v3ExactInputParams)).(*v3ExactInputParams)
+				path, _ := encodeV3Path(tt.route.Tokens, tt.route.Fees)
+				if !bytes.Equal(data[:4], method.ID) || !bytes.Equal(params.Path, path) || params.Recipient != recipient ||
+					params.AmountIn.Cmp(tt.route.AmountIn) != 0 || params.AmountOutMinimum.Cmp(amountOutMin) != 0 {
+					t.Errorf("BuildSwapCalldata() params = %+v", params)
+				}
+			}
+		})
+	}
+}
+
+func TestEncodeV3Path(t *testing.T) {
+	tests := []struct {
+		name     string
+		tokens   []common.Address
+		fees     []uint32
+		expected string
+		err      bool
+	}{
+		{
+			name:     "单跳",
+			tokens:   []common.Address{tokenA, tokenB},
+			fees:     []uint32{500},
+			expected: "00000000000000000000000000000000000000a1" + "0001f4" + "00000000000000000000000000000000000000b1",
+		},
+		{
+			name:   "两跳",
+			tokens: []common.Address{tokenA, wrapped, tokenB},
+			fees:   []uint32{3000, 10000},
+			expected: "00000000000000000000000000000000000000a1" + "000bb8" + "00000000000000000000000000000000000000c1" +
+				"002710" + "00000000000000000000000000000000000000b1",
+		},
+		{
+			name:   "代币数量不足",
+			tokens: []common.Address{tokenA},
+			err:    true,
+		},
+		{
+			name:   "手续费数量不匹配",
+			tokens: []common.Address{tokenA, tokenB},
+			fees:   []uint32{500, 3000},
+			err:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, err := encodeV3Path(tt.tokens, tt.fees)
+			if (err != nil) != tt.err {
+				t.Fatalf("encodeV3Path() error = %v, expected error %v", err, tt.err)
+			}
+			if common.Bytes2Hex(path) != tt.expected {
+				t.Errorf("encodeV3Path() = %x, expected %s", path, tt.expected)
+			}
+		})
+	}
+}
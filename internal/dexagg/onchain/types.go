@@ -0,0 +1,76 @@
+package onchain
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+type Version int
+
+const (
+	V2 Version = 2
+	V3 Version = 3
+)
+
+// 兑换路径, V3 路径中相邻代币之间的手续费等级记录在 Fees 中
+type Route struct {
+	Version   Version
+	Tokens    []common.Address
+	Fees      []uint32
+	AmountIn  *big.Int
+	AmountOut *big.Int
+}
+
+// SwapRouter02 exactInput 参数
+type v3ExactInputParams struct {
+	Path             []byte
+	Recipient        common.Address
+	AmountIn         *big.Int
+	AmountOutMinimum *big.Int
+}
+
+// 批量请求中的合约调用
+type contractCall struct {
+	to     common.Address
+	abi    abi.ABI
+	method string
+	args   []any
+	result hexutil.Bytes
+	err    error
+}
+
+func newContractCall(to string, contractABI abi.ABI, method string, args ...any) *contractCall {
+	return &contractCall{to: common.HexToAddress(to), abi: contractABI, method: method, args: args}
+}
+
+func (call *contractCall) unpack() ([]any, error) {
+	if call.err != nil {
+		return nil, fmt.Errorf("failed to call %s: %w", call.method, call.err)
+	}
+
+	values, err := call.abi.Unpack(call.method, call.result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack %s result: %w", call.method, err)
+	}
+	if len(values) == 0 {
+		return nil, fmt.Errorf("empty %s result", call.method)
+	}
+	return values, nil
+}
+
+func (call *contractCall) address() (common.Address, error) {
+	values, err := call.unpack()
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	address, ok := values[0].(common.Address)
+	if !ok {
+		return common.Address{}, fmt.Errorf("failed to parse %s result", call.method)
+	}
+	return address, nil
+}
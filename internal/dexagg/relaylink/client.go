@@ -9,6 +9,7 @@ import (
 	"math/big"
 	"net/http"
 	"sync"
+	"time"
 
 	"github.com/fachebot/evm-grid-bot/internal/dexagg"
 	"github.com/fachebot/evm-grid-bot/internal/svc"
@@ -17,7 +18,6 @@ import (
 	"github.com/carlmjohnson/requests"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
-	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
 var (
@@ -153,7 +153,8 @@ func (client *RelaylinkClient) Quote(
 	return &response, nil
 }
 
-func (client *RelaylinkClient) SendSwapTransaction(ctx context.Context, svcCtx *svc.ServiceContext, prv *ecdsa.PrivateKey, swapResponse *QuoteResponse) (string, uint64, error) {
+// 发送报价中的交易步骤, sentSteps 记录已广播的步骤, 重新报价后从未发送的步骤继续
+func (client *RelaylinkClient) SendSwapTransaction(ctx context.Context, svcCtx *svc.ServiceContext, prv *ecdsa.PrivateKey, swapResponse *QuoteResponse, sentSteps map[string]string, expireAt time.Time, submit dexagg.SubmitFunc) (string, uint64, error) {
 	account, err := evm.GetAddress(prv)
 	if err != nil {
 		return "", 0, err
@@ -179,48 +180,47 @@ func (client *RelaylinkClient) SendSwapTransaction(ctx context.Context, svcCtx *
 	// 发送交易
 	var lastTxHash string
 	var lastTxNonce uint64
-	chainId := uint64(svcCtx.Config.Chain.Id)
-	for _, step := range swapResponse.Steps {
-		for _, item := range step.Items {
+	for stepIdx, step := range swapResponse.Steps {
+		// 已广播的步骤(如授权)不再重复发送
+		if hash, ok := sentSteps[step.ID]; ok {
+			lastTxHash = hash
+			continue
+		}
+
+		for itemIdx, item := range step.Items {
+			lastItem := stepIdx == len(swapResponse.Steps)-1 && itemIdx == len(step.Items)-1
 			data, err := hexutil.Decode(item.Data.EvmData)
 			if err != nil {
 				return "", 0, err
 			}
 
-			err = svcCtx.NonceManager.Request(ctx, account, func(ctx context.Context, nonce uint64) (hash string, err error) {
-
-				dynamicFeeTx := ethtypes.DynamicFeeTx{
-					ChainID:   big.NewInt(0).SetUint64(chainId),
-					Nonce:     nonce,
-					GasTipCap: item.Data.EvmMaxPriorityFeePerGas.BigInt(),
-					GasFeeCap: item.Data.EvmMaxFeePerGas.BigInt(),
-					Gas:       item.Data.EvmGas.BigInt().Uint64(),
-					To:        &item.Data.EvmTo,
-					Value:     item.Data.EvmValue.BigInt(),
-					Data:      data,
-				}
-
-				tx := ethtypes.NewTx(&dynamicFeeTx)
-				signedTx, err := ethtypes.SignTx(tx, ethtypes.NewLondonSigner(big.NewInt(0).SetUint64(chainId)), prv)
-				if err != nil {
-					return "", err
-				}
+			req := dexagg.TxRequest{
+				To:        item.Data.EvmTo,
+				Data:      data,
+				Value:     item.Data.EvmValue.BigInt(),
+				Gas:       item.Data.EvmGas.BigInt().Uint64(),
+				GasTipCap: item.Data.EvmMaxPriorityFeePerGas.BigInt(),
+				GasFeeCap: item.Data.EvmMaxFeePerGas.BigInt(),
+			}
 
-				err = svcCtx.EthClient.SendTransaction(ctx, signedTx)
-				if err != nil {
-					return "", err
+			// 等待前序交易(如授权)打包后再模拟, 保证每一步都经过模拟
+			if lastTxHash != "" {
+				if err = dexagg.WaitMined(ctx, svcCtx, lastTxHash); err != nil {
+					return "", 0, err
 				}
+			}
 
-				lastTxNonce = nonce
-				lastTxHash = signedTx.Hash().Hex()
-
-				return signedTx.Hash().Hex(), nil
-			})
+			opts := dexagg.SendOptions{Simulate: true, ExpireAt: expireAt}
+			if lastItem {
+				opts.Submit = submit
+			}
 
+			lastTxHash, lastTxNonce, err = dexagg.SendTransaction(ctx, svcCtx, prv, req, opts)
 			if err != nil {
 				return "", 0, err
 			}
 		}
+		sentSteps[step.ID] = lastTxHash
 	}
 
 	return lastTxHash, lastTxNonce, nil
@@ -0,0 +1,148 @@
+package dexagg
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/fachebot/evm-grid-bot/internal/svc"
+	"github.com/fachebot/evm-grid-bot/internal/utils/evm"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// 等待授权交易打包的超时时间, 调用方持有策略锁, 超时按失败处理, 下次执行时重新报价
+const waitMinedTimeout = 15 * time.Second
+
+// 聚合器返回的待发送交易
+type TxRequest struct {
+	To        common.Address
+	Data      []byte
+	Value     *big.Int
+	Gas       uint64
+	GasTipCap *big.Int
+	GasFeeCap *big.Int
+}
+
+type SendOptions struct {
+	Simulate bool       // 广播前模拟执行
+	ExpireAt time.Time  // 报价过期时间, 获取nonce后检查
+	Submit   SubmitFunc // 广播前调用
+}
+
+// 签名并发送交易
+func SendTransaction(ctx context.Context, svcCtx *svc.ServiceContext, prv *ecdsa.PrivateKey, req TxRequest, opts SendOptions) (string, uint64, error) {
+	account, err := evm.GetAddress(prv)
+	if err != nil {
+		return "", 0, err
+	}
+
+	// 广播前模拟执行, 避免交易回滚浪费手续费
+	if opts.Simulate {
+		reason, reverted, err := evm.SimulateCall(ctx, svcCtx.EthClient, ethereum.CallMsg{
+			From:  account,
+			To:    &req.To,
+			Gas:   req.Gas,
+			Value: req.Value,
+			Data:  req.Data,
+		})
+		if err != nil {
+			return "", 0, err
+		}
+		if reverted {
+			return "", 0, &SimulationError{Reason: reason}
+		}
+	}
+
+	var txHash string
+	var txNonce uint64
+	chainId := big.NewInt(svcCtx.Config.Chain.Id)
+	err = svcCtx.NonceManager.Request(ctx, account, func(ctx context.Context, nonce uint64) (hash string, err error) {
+		// 等待nonce锁期间报价可能已经过期
+		if !opts.ExpireAt.IsZero() && time.Now().After(opts.ExpireAt) {
+			return "", ErrQuoteExpired
+		}
+
+		dynamicFeeTx := ethtypes.DynamicFeeTx{
+			ChainID:   chainId,
+			Nonce:     nonce,
+			GasTipCap: req.GasTipCap,
+			GasFeeCap: req.GasFeeCap,
+			Gas:       req.Gas,
+			To:        &req.To,
+			Value:     req.Value,
+			Data:      req.Data,
+		}
+
+		signedTx, err := ethtypes.SignTx(ethtypes.NewTx(&dynamicFeeTx), ethtypes.NewLondonSigner(chainId), prv)
+		if err != nil {
+			return "", err
+		}
+
+		// 兑换交易广播前保存订单
+		if opts.Submit != nil {
+			rawTx, err := signedTx.MarshalBinary()
+			if err != nil {
+				return "", err
+			}
+			if err = opts.Submit(ctx, signedTx.Hash().Hex(), nonce, hexutil.Encode(rawTx)); err != nil {
+				return "", err
+			}
+		}
+
+		err = svcCtx.EthClient.SendTransaction(ctx, signedTx)
+		if err != nil {
+			// 订单已保存, 交易可能已进入交易池, 保留 nonce 等待对账
+			if opts.Submit != nil {
+				return signedTx.Hash().Hex(), err
+			}
+			return "", err
+		}
+
+		txNonce = nonce
+		txHash = signedTx.Hash().Hex()
+		return txHash, nil
+	})
+	if err != nil {
+		return "", 0, err
+	}
+
+	return txHash, txNonce, nil
+}
+
+// 等待交易打包, 授权交易打包后才能可靠地模拟后续兑换交易
+func WaitMined(ctx context.Context, svcCtx *svc.ServiceContext, hash string) error {
+	ctx, cancel := context.WithTimeout(ctx, waitMinedTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		receipt, err := svcCtx.EthClient.TransactionReceipt(ctx, common.HexToHash(hash))
+		if err == nil {
+			if receipt.Status != ethtypes.ReceiptStatusSuccessful {
+				return fmt.Errorf("transaction %s reverted", hash)
+			}
+			return nil
+		}
+		if !errors.Is(err, ethereum.NotFound) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return fmt.Errorf("%w: %s", ErrTxNotMined, hash)
+			}
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
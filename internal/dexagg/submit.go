@@ -0,0 +1,6 @@
+package dexagg
+
+import "context"
+
+// 交易签名后、广播前调用, 用于先保存订单记录, 返回错误时取消广播
+type SubmitFunc func(ctx context.Context, hash string, nonce uint64, rawTx string) error
@@ -7,8 +7,13 @@ import (
 
 	"github.com/fachebot/evm-grid-bot/internal/charts"
 	"github.com/fachebot/evm-grid-bot/internal/logger"
+
+	"github.com/samber/lo"
 )
 
+// K线管理器为每个代币维护的K线数量, 策略每次执行都基于这些K线计算指标
+const DefaultCandles = 329
+
 type Strategy interface {
 	ID() string
 	TokenAddress() string
@@ -23,28 +28,63 @@ type KlineManager interface {
 	GetOhlcsChan() <-chan charts.TokenOhlcs
 }
 
+// 单个代币的执行协程, 只保留最新一次K线快照, 未处理的旧数据会被覆盖
+type tokenWorker struct {
+	token      string
+	cancel     context.CancelFunc
+	notify     chan struct{}
+	mutex      sync.Mutex
+	latest     []charts.Ohlc
+	strategies map[string]Strategy
+}
+
+func (w *tokenWorker) push(ohlcs []charts.Ohlc) {
+	w.mutex.Lock()
+	w.latest = ohlcs
+	w.mutex.Unlock()
+
+	select {
+	case w.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (w *tokenWorker) take() []charts.Ohlc {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	ohlcs := w.latest
+	w.latest = nil
+	return ohlcs
+}
+
 type StrategyEngine struct {
 	ctx      context.Context
 	cancel   context.CancelFunc
 	stopChan chan struct{}
 
-	klineManager         KlineManager
-	mutex                sync.RWMutex
-	strategyMap          map[string]Strategy
-	tokenStrategyCounter map[string]int
+	klineManager KlineManager
+	mutex        sync.RWMutex
+	waitGroup    sync.WaitGroup
+	strategyMap  map[string]Strategy
+	workers      map[string]*tokenWorker
 }
 
 func NewStrategyEngine(klineManager KlineManager) *StrategyEngine {
 	ctx, cancel := context.WithCancel(context.Background())
 	return &StrategyEngine{
-		ctx:                  ctx,
-		cancel:               cancel,
-		klineManager:         klineManager,
-		strategyMap:          make(map[string]Strategy),
-		tokenStrategyCounter: make(map[string]int),
+		ctx:          ctx,
+		cancel:       cancel,
+		klineManager: klineManager,
+		strategyMap:  make(map[string]Strategy),
+		workers:      make(map[string]*tokenWorker),
 	}
 }
 
+func tokenKey(token string) string {
+	return strings.ToLower(token)
+}
+
 func (engine *StrategyEngine) Stop() {
 	if engine.stopChan == nil {
 		return
@@ -58,6 +98,9 @@ func (engine *StrategyEngine) Stop() {
 	close(engine.stopChan)
 	engine.stopChan = nil
 
+	// 等待执行中的策略结束
+	engine.waitGroup.Wait()
+
 	logger.Infof("[StrategyEngine] 服务已经停止")
 }
 
@@ -78,20 +121,23 @@ func (engine *StrategyEngine) StopStrategy(id string) {
 		engine.mutex.Unlock()
 		return
 	}
+	delete(engine.strategyMap, id)
 
+	// 代币没有运行中的策略时, 停止执行协程
+	unsubscribe := false
 	token := strategy.TokenAddress()
-	count, ok := engine.tokenStrategyCounter[token]
-	if !ok || count < 0 {
-		count = 0
-	} else if count > 0 {
-		count = count - 1
+	key := tokenKey(token)
+	if w, ok := engine.workers[key]; ok {
+		delete(w.strategies, id)
+		if len(w.strategies) == 0 {
+			w.cancel()
+			delete(engine.workers, key)
+			unsubscribe = true
+		}
 	}
-	engine.tokenStrategyCounter[token] = count
-
-	delete(engine.strategyMap, strategy.ID())
 	engine.mutex.Unlock()
 
-	if count == 0 {
+	if unsubscribe {
 		err := engine.klineManager.Unsubscribe([]string{token})
 		if err != nil {
 			logger.Errorf("[StrategyEngine] 取消订阅失败, token: %s, %s", token, err)
@@ -129,15 +175,23 @@ func (engine *StrategyEngine) StartStrategy(strategyList []Strategy) (err error)
 	engine.mutex.Lock()
 	for _, strategy := range startedStrategyList {
 		engine.strategyMap[strategy.ID()] = strategy
-	}
-	for _, tokenAddress := range tokenAddresses {
-		count, ok := engine.tokenStrategyCounter[tokenAddress]
+
+		key := tokenKey(strategy.TokenAddress())
+		w, ok := engine.workers[key]
 		if !ok {
-			count = 1
-		} else {
-			count = count + 1
+			ctx, cancel := context.WithCancel(engine.ctx)
+			w = &tokenWorker{
+				token:      strategy.TokenAddress(),
+				cancel:     cancel,
+				notify:     make(chan struct{}, 1),
+				strategies: make(map[string]Strategy),
+			}
+			engine.workers[key] = w
+
+			engine.waitGroup.Add(1)
+			go engine.runWorker(ctx, w)
 		}
-		engine.tokenStrategyCounter[tokenAddress] = count
+		w.strategies[strategy.ID()] = strategy
 	}
 	engine.mutex.Unlock()
 
@@ -153,20 +207,39 @@ func (engine *StrategyEngine) run() {
 			engine.stopChan <- struct{}{}
 			return
 		case data := <-ohlcsChan:
-			strategyList := make([]Strategy, 0)
 			engine.mutex.RLock()
-			for _, value := range engine.strategyMap {
-				strategyList = append(strategyList, value)
+			w, ok := engine.workers[tokenKey(data.Token)]
+			engine.mutex.RUnlock()
+
+			if ok {
+				w.push(data.Ohlcs)
 			}
+		}
+	}
+}
+
+func (engine *StrategyEngine) runWorker(ctx context.Context, w *tokenWorker) {
+	defer engine.waitGroup.Done()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-w.notify:
+			ohlcs := w.take()
+			if len(ohlcs) == 0 {
+				continue
+			}
+
+			engine.mutex.RLock()
+			strategyList := lo.Values(w.strategies)
 			engine.mutex.RUnlock()
 
+			// 同一代币的策略串行执行, 使用引擎上下文避免停止策略时中断执行中的交易
 			for _, strategy := range strategyList {
-				if !strings.EqualFold(strategy.TokenAddress(), data.Token) {
-					continue
-				}
-				err := strategy.OnTick(engine.ctx, data.Ohlcs)
+				err := strategy.OnTick(engine.ctx, ohlcs)
 				if err != nil {
-					logger.Errorf("[StrategyEngine] 策略执行失败, token: %s, %s", data.Token, err)
+					logger.Errorf("[StrategyEngine] 策略执行失败, token: %s, %s", w.token, err)
 				}
 			}
 		}
@@ -43,6 +43,14 @@ type Grid struct {
 	Amount decimal.Decimal `json:"amount,omitempty"`
 	// Quantity holds the value of the "quantity" field.
 	Quantity decimal.Decimal `json:"quantity,omitempty"`
+	// SellPrice holds the value of the "sellPrice" field.
+	SellPrice *decimal.Decimal `json:"sellPrice,omitempty"`
+	// TakeProfitPrice holds the value of the "takeProfitPrice" field.
+	TakeProfitPrice *decimal.Decimal `json:"takeProfitPrice,omitempty"`
+	// PeakPrice holds the value of the "peakPrice" field.
+	PeakPrice *decimal.Decimal `json:"peakPrice,omitempty"`
+	// Runner holds the value of the "runner" field.
+	Runner bool `json:"runner,omitempty"`
 	// Status holds the value of the "status" field.
 	Status       grid.Status `json:"status,omitempty"`
 	selectValues sql.SelectValues
@@ -53,8 +61,12 @@ func (*Grid) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case grid.FieldSellPrice, grid.FieldTakeProfitPrice, grid.FieldPeakPrice:
+			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
 		case grid.FieldOrderPrice, grid.FieldFinalPrice, grid.FieldAmount, grid.FieldQuantity:
 			values[i] = new(decimal.Decimal)
+		case grid.FieldRunner:
+			values[i] = new(sql.NullBool)
 		case grid.FieldID, grid.FieldGridNumber:
 			values[i] = new(sql.NullInt64)
 		case grid.FieldGUID, grid.FieldAccount, grid.FieldToken, grid.FieldSymbol, grid.FieldStrategyId, grid.FieldStatus:
@@ -154,6 +166,33 @@ func (_m *Grid) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.Quantity = *value
 			}
+		case grid.FieldSellPrice:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field sellPrice", values[i])
+			} else if value.Valid {
+				_m.SellPrice = new(decimal.Decimal)
+				*_m.SellPrice = *value.S.(*decimal.Decimal)
+			}
+		case grid.FieldTakeProfitPrice:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field takeProfitPrice", values[i])
+			} else if value.Valid {
+				_m.TakeProfitPrice = new(decimal.Decimal)
+				*_m.TakeProfitPrice = *value.S.(*decimal.Decimal)
+			}
+		case grid.FieldPeakPrice:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field peakPrice", values[i])
+			} else if value.Valid {
+				_m.PeakPrice = new(decimal.Decimal)
+				*_m.PeakPrice = *value.S.(*decimal.Decimal)
+			}
+		case grid.FieldRunner:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field runner", values[i])
+			} else if value.Valid {
+				_m.Runner = value.Bool
+			}
 		case grid.FieldStatus:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
@@ -232,6 +271,24 @@ func (_m *Grid) String() string {
 	builder.WriteString("quantity=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Quantity))
 	builder.WriteString(", ")
+	if v := _m.SellPrice; v != nil {
+		builder.WriteString("sellPrice=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.TakeProfitPrice; v != nil {
+		builder.WriteString("takeProfitPrice=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.PeakPrice; v != nil {
+		builder.WriteString("peakPrice=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("runner=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Runner))
+	builder.WriteString(", ")
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteByte(')')
@@ -38,6 +38,14 @@ const (
 	FieldAmount = "amount"
 	// FieldQuantity holds the string denoting the quantity field in the database.
 	FieldQuantity = "quantity"
+	// FieldSellPrice holds the string denoting the sellprice field in the database.
+	FieldSellPrice = "sell_price"
+	// FieldTakeProfitPrice holds the string denoting the takeprofitprice field in the database.
+	FieldTakeProfitPrice = "take_profit_price"
+	// FieldPeakPrice holds the string denoting the peakprice field in the database.
+	FieldPeakPrice = "peak_price"
+	// FieldRunner holds the string denoting the runner field in the database.
+	FieldRunner = "runner"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
 	// Table holds the table name of the grid in the database.
@@ -59,6 +67,10 @@ var Columns = []string{
 	FieldFinalPrice,
 	FieldAmount,
 	FieldQuantity,
+	FieldSellPrice,
+	FieldTakeProfitPrice,
+	FieldPeakPrice,
+	FieldRunner,
 	FieldStatus,
 }
 
@@ -89,8 +101,6 @@ var (
 	SymbolValidator func(string) error
 	// StrategyIdValidator is a validator for the "strategyId" field. It is called by the builders before save.
 	StrategyIdValidator func(string) error
-	// GridNumberValidator is a validator for the "gridNumber" field. It is called by the builders before save.
-	GridNumberValidator func(int) error
 )
 
 // Status defines the type for the "status" enum field.
@@ -185,6 +195,26 @@ func ByQuantity(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldQuantity, opts...).ToFunc()
 }
 
+// BySellPrice orders the results by the sellPrice field.
+func BySellPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSellPrice, opts...).ToFunc()
+}
+
+// ByTakeProfitPrice orders the results by the takeProfitPrice field.
+func ByTakeProfitPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTakeProfitPrice, opts...).ToFunc()
+}
+
+// ByPeakPrice orders the results by the peakPrice field.
+func ByPeakPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeakPrice, opts...).ToFunc()
+}
+
+// ByRunner orders the results by the runner field.
+func ByRunner(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRunner, opts...).ToFunc()
+}
+
 // ByStatus orders the results by the status field.
 func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
@@ -116,6 +116,26 @@ func Quantity(v decimal.Decimal) predicate.Grid {
 	return predicate.Grid(sql.FieldEQ(FieldQuantity, v))
 }
 
+// SellPrice applies equality check predicate on the "sellPrice" field. It's identical to SellPriceEQ.
+func SellPrice(v decimal.Decimal) predicate.Grid {
+	return predicate.Grid(sql.FieldEQ(FieldSellPrice, v))
+}
+
+// TakeProfitPrice applies equality check predicate on the "takeProfitPrice" field. It's identical to TakeProfitPriceEQ.
+func TakeProfitPrice(v decimal.Decimal) predicate.Grid {
+	return predicate.Grid(sql.FieldEQ(FieldTakeProfitPrice, v))
+}
+
+// PeakPrice applies equality check predicate on the "peakPrice" field. It's identical to PeakPriceEQ.
+func PeakPrice(v decimal.Decimal) predicate.Grid {
+	return predicate.Grid(sql.FieldEQ(FieldPeakPrice, v))
+}
+
+// Runner applies equality check predicate on the "runner" field. It's identical to RunnerEQ.
+func Runner(v bool) predicate.Grid {
+	return predicate.Grid(sql.FieldEQ(FieldRunner, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.Grid {
 	return predicate.Grid(sql.FieldEQ(FieldCreateTime, v))
@@ -841,6 +861,266 @@ func QuantityContainsFold(v decimal.Decimal) predicate.Grid {
 	return predicate.Grid(sql.FieldContainsFold(FieldQuantity, vc))
 }
 
+// SellPriceEQ applies the EQ predicate on the "sellPrice" field.
+func SellPriceEQ(v decimal.Decimal) predicate.Grid {
+	return predicate.Grid(sql.FieldEQ(FieldSellPrice, v))
+}
+
+// SellPriceNEQ applies the NEQ predicate on the "sellPrice" field.
+func SellPriceNEQ(v decimal.Decimal) predicate.Grid {
+	return predicate.Grid(sql.FieldNEQ(FieldSellPrice, v))
+}
+
+// SellPriceIn applies the In predicate on the "sellPrice" field.
+func SellPriceIn(vs ...decimal.Decimal) predicate.Grid {
+	return predicate.Grid(sql.FieldIn(FieldSellPrice, vs...))
+}
+
+// SellPriceNotIn applies the NotIn predicate on the "sellPrice" field.
+func SellPriceNotIn(vs ...decimal.Decimal) predicate.Grid {
+	return predicate.Grid(sql.FieldNotIn(FieldSellPrice, vs...))
+}
+
+// SellPriceGT applies the GT predicate on the "sellPrice" field.
+func SellPriceGT(v decimal.Decimal) predicate.Grid {
+	return predicate.Grid(sql.FieldGT(FieldSellPrice, v))
+}
+
+// SellPriceGTE applies the GTE predicate on the "sellPrice" field.
+func SellPriceGTE(v decimal.Decimal) predicate.Grid {
+	return predicate.Grid(sql.FieldGTE(FieldSellPrice, v))
+}
+
+// SellPriceLT applies the LT predicate on the "sellPrice" field.
+func SellPriceLT(v decimal.Decimal) predicate.Grid {
+	return predicate.Grid(sql.FieldLT(FieldSellPrice, v))
+}
+
+// SellPriceLTE applies the LTE predicate on the "sellPrice" field.
+func SellPriceLTE(v decimal.Decimal) predicate.Grid {
+	return predicate.Grid(sql.FieldLTE(FieldSellPrice, v))
+}
+
+// SellPriceContains applies the Contains predicate on the "sellPrice" field.
+func SellPriceContains(v decimal.Decimal) predicate.Grid {
+	vc := v.String()
+	return predicate.Grid(sql.FieldContains(FieldSellPrice, vc))
+}
+
+// SellPriceHasPrefix applies the HasPrefix predicate on the "sellPrice" field.
+func SellPriceHasPrefix(v decimal.Decimal) predicate.Grid {
+	vc := v.String()
+	return predicate.Grid(sql.FieldHasPrefix(FieldSellPrice, vc))
+}
+
+// SellPriceHasSuffix applies the HasSuffix predicate on the "sellPrice" field.
+func SellPriceHasSuffix(v decimal.Decimal) predicate.Grid {
+	vc := v.String()
+	return predicate.Grid(sql.FieldHasSuffix(FieldSellPrice, vc))
+}
+
+// SellPriceIsNil applies the IsNil predicate on the "sellPrice" field.
+func SellPriceIsNil() predicate.Grid {
+	return predicate.Grid(sql.FieldIsNull(FieldSellPrice))
+}
+
+// SellPriceNotNil applies the NotNil predicate on the "sellPrice" field.
+func SellPriceNotNil() predicate.Grid {
+	return predicate.Grid(sql.FieldNotNull(FieldSellPrice))
+}
+
+// SellPriceEqualFold applies the EqualFold predicate on the "sellPrice" field.
+func SellPriceEqualFold(v decimal.Decimal) predicate.Grid {
+	vc := v.String()
+	return predicate.Grid(sql.FieldEqualFold(FieldSellPrice, vc))
+}
+
+// SellPriceContainsFold applies the ContainsFold predicate on the "sellPrice" field.
+func SellPriceContainsFold(v decimal.Decimal) predicate.Grid {
+	vc := v.String()
+	return predicate.Grid(sql.FieldContainsFold(FieldSellPrice, vc))
+}
+
+// TakeProfitPriceEQ applies the EQ predicate on the "takeProfitPrice" field.
+func TakeProfitPriceEQ(v decimal.Decimal) predicate.Grid {
+	return predicate.Grid(sql.FieldEQ(FieldTakeProfitPrice, v))
+}
+
+// TakeProfitPriceNEQ applies the NEQ predicate on the "takeProfitPrice" field.
+func TakeProfitPriceNEQ(v decimal.Decimal) predicate.Grid {
+	return predicate.Grid(sql.FieldNEQ(FieldTakeProfitPrice, v))
+}
+
+// TakeProfitPriceIn applies the In predicate on the "takeProfitPrice" field.
+func TakeProfitPriceIn(vs ...decimal.Decimal) predicate.Grid {
+	return predicate.Grid(sql.FieldIn(FieldTakeProfitPrice, vs...))
+}
+
+// TakeProfitPriceNotIn applies the NotIn predicate on the "takeProfitPrice" field.
+func TakeProfitPriceNotIn(vs ...decimal.Decimal) predicate.Grid {
+	return predicate.Grid(sql.FieldNotIn(FieldTakeProfitPrice, vs...))
+}
+
+// TakeProfitPriceGT applies the GT predicate on the "takeProfitPrice" field.
+func TakeProfitPriceGT(v decimal.Decimal) predicate.Grid {
+	return predicate.Grid(sql.FieldGT(FieldTakeProfitPrice, v))
+}
+
+// TakeProfitPriceGTE applies the GTE predicate on the "takeProfitPrice" field.
+func TakeProfitPriceGTE(v decimal.Decimal) predicate.Grid {
+	return predicate.Grid(sql.FieldGTE(FieldTakeProfitPrice, v))
+}
+
+// TakeProfitPriceLT applies the LT predicate on the "takeProfitPrice" field.
+func TakeProfitPriceLT(v decimal.Decimal) predicate.Grid {
+	return predicate.Grid(sql.FieldLT(FieldTakeProfitPrice, v))
+}
+
+// TakeProfitPriceLTE applies the LTE predicate on the "takeProfitPrice" field.
+func TakeProfitPriceLTE(v decimal.Decimal) predicate.Grid {
+	return predicate.Grid(sql.FieldLTE(FieldTakeProfitPrice, v))
+}
+
+// TakeProfitPriceContains applies the Contains predicate on the "takeProfitPrice" field.
+func TakeProfitPriceContains(v decimal.Decimal) predicate.Grid {
+	vc := v.String()
+	return predicate.Grid(sql.FieldContains(FieldTakeProfitPrice, vc))
+}
+
+// TakeProfitPriceHasPrefix applies the HasPrefix predicate on the "takeProfitPrice" field.
+func TakeProfitPriceHasPrefix(v decimal.Decimal) predicate.Grid {
+	vc := v.String()
+	return predicate.Grid(sql.FieldHasPrefix(FieldTakeProfitPrice, vc))
+}
+
+// TakeProfitPriceHasSuffix applies the HasSuffix predicate on the "takeProfitPrice" field.
+func TakeProfitPriceHasSuffix(v decimal.Decimal) predicate.Grid {
+	vc := v.String()
+	return predicate.Grid(sql.FieldHasSuffix(FieldTakeProfitPrice, vc))
+}
+
+// TakeProfitPriceIsNil applies the IsNil predicate on the "takeProfitPrice" field.
+func TakeProfitPriceIsNil() predicate.Grid {
+	return predicate.Grid(sql.FieldIsNull(FieldTakeProfitPrice))
+}
+
+// TakeProfitPriceNotNil applies the NotNil predicate on the "takeProfitPrice" field.
+func TakeProfitPriceNotNil() predicate.Grid {
+	return predicate.Grid(sql.FieldNotNull(FieldTakeProfitPrice))
+}
+
+// TakeProfitPriceEqualFold applies the EqualFold predicate on the "takeProfitPrice" field.
+func TakeProfitPriceEqualFold(v decimal.Decimal) predicate.Grid {
+	vc := v.String()
+	return predicate.Grid(sql.FieldEqualFold(FieldTakeProfitPrice, vc))
+}
+
+// TakeProfitPriceContainsFold applies the ContainsFold predicate on the "takeProfitPrice" field.
+func TakeProfitPriceContainsFold(v decimal.Decimal) predicate.Grid {
+	vc := v.String()
+	return predicate.Grid(sql.FieldContainsFold(FieldTakeProfitPrice, vc))
+}
+
+// PeakPriceEQ applies the EQ predicate on the "peakPrice" field.
+func PeakPriceEQ(v decimal.Decimal) predicate.Grid {
+	return predicate.Grid(sql.FieldEQ(FieldPeakPrice, v))
+}
+
+// PeakPriceNEQ applies the NEQ predicate on the "peakPrice" field.
+func PeakPriceNEQ(v decimal.Decimal) predicate.Grid {
+	return predicate.Grid(sql.FieldNEQ(FieldPeakPrice, v))
+}
+
+// PeakPriceIn applies the In predicate on the "peakPrice" field.
+func PeakPriceIn(vs ...decimal.Decimal) predicate.Grid {
+	return predicate.Grid(sql.FieldIn(FieldPeakPrice, vs...))
+}
+
+// PeakPriceNotIn applies the NotIn predicate on the "peakPrice" field.
+func PeakPriceNotIn(vs ...decimal.Decimal) predicate.Grid {
+	return predicate.Grid(sql.FieldNotIn(FieldPeakPrice, vs...))
+}
+
+// PeakPriceGT applies the GT predicate on the "peakPrice" field.
+func PeakPriceGT(v decimal.Decimal) predicate.Grid {
+	return predicate.Grid(sql.FieldGT(FieldPeakPrice, v))
+}
+
+// PeakPriceGTE applies the GTE predicate on the "peakPrice" field.
+func PeakPriceGTE(v decimal.Decimal) predicate.Grid {
+	return predicate.Grid(sql.FieldGTE(FieldPeakPrice, v))
+}
+
+// PeakPriceLT applies the LT predicate on the "peakPrice" field.
+func PeakPriceLT(v decimal.Decimal) predicate.Grid {
+	return predicate.Grid(sql.FieldLT(FieldPeakPrice, v))
+}
+
+// PeakPriceLTE applies the LTE predicate on the "peakPrice" field.
+func PeakPriceLTE(v decimal.Decimal) predicate.Grid {
+	return predicate.Grid(sql.FieldLTE(FieldPeakPrice, v))
+}
+
+// PeakPriceContains applies the Contains predicate on the "peakPrice" field.
+func PeakPriceContains(v decimal.Decimal) predicate.Grid {
+	vc := v.String()
+	return predicate.Grid(sql.FieldContains(FieldPeakPrice, vc))
+}
+
+// PeakPriceHasPrefix applies the HasPrefix predicate on the "peakPrice" field.
+func PeakPriceHasPrefix(v decimal.Decimal) predicate.Grid {
+	vc := v.String()
+	return predicate.Grid(sql.FieldHasPrefix(FieldPeakPrice, vc))
+}
+
+// PeakPriceHasSuffix applies the HasSuffix predicate on the "peakPrice" field.
+func PeakPriceHasSuffix(v decimal.Decimal) predicate.Grid {
+	vc := v.String()
+	return predicate.Grid(sql.FieldHasSuffix(FieldPeakPrice, vc))
+}
+
+// PeakPriceIsNil applies the IsNil predicate on the "peakPrice" field.
+func PeakPriceIsNil() predicate.Grid {
+	return predicate.Grid(sql.FieldIsNull(FieldPeakPrice))
+}
+
+// PeakPriceNotNil applies the NotNil predicate on the "peakPrice" field.
+func PeakPriceNotNil() predicate.Grid {
+	return predicate.Grid(sql.FieldNotNull(FieldPeakPrice))
+}
+
+// PeakPriceEqualFold applies the EqualFold predicate on the "peakPrice" field.
+func PeakPriceEqualFold(v decimal.Decimal) predicate.Grid {
+	vc := v.String()
+	return predicate.Grid(sql.FieldEqualFold(FieldPeakPrice, vc))
+}
+
+// PeakPriceContainsFold applies the ContainsFold predicate on the "peakPrice" field.
+func PeakPriceContainsFold(v decimal.Decimal) predicate.Grid {
+	vc := v.String()
+	return predicate.Grid(sql.FieldContainsFold(FieldPeakPrice, vc))
+}
+
+// RunnerEQ applies the EQ predicate on the "runner" field.
+func RunnerEQ(v bool) predicate.Grid {
+	return predicate.Grid(sql.FieldEQ(FieldRunner, v))
+}
+
+// RunnerNEQ applies the NEQ predicate on the "runner" field.
+func RunnerNEQ(v bool) predicate.Grid {
+	return predicate.Grid(sql.FieldNEQ(FieldRunner, v))
+}
+
+// RunnerIsNil applies the IsNil predicate on the "runner" field.
+func RunnerIsNil() predicate.Grid {
+	return predicate.Grid(sql.FieldIsNull(FieldRunner))
+}
+
+// RunnerNotNil applies the NotNil predicate on the "runner" field.
+func RunnerNotNil() predicate.Grid {
+	return predicate.Grid(sql.FieldNotNull(FieldRunner))
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
 func StatusEQ(v Status) predicate.Grid {
 	return predicate.Grid(sql.FieldEQ(FieldStatus, v))
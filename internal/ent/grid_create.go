@@ -110,6 +110,62 @@ func (_c *GridCreate) SetQuantity(v decimal.Decimal) *GridCreate {
 	return _c
 }
 
+// SetSellPrice sets the "sellPrice" field.
+func (_c *GridCreate) SetSellPrice(v decimal.Decimal) *GridCreate {
+	_c.mutation.SetSellPrice(v)
+	return _c
+}
+
+// SetNillableSellPrice sets the "sellPrice" field if the given value is not nil.
+func (_c *GridCreate) SetNillableSellPrice(v *decimal.Decimal) *GridCreate {
+	if v != nil {
+		_c.SetSellPrice(*v)
+	}
+	return _c
+}
+
+// SetTakeProfitPrice sets the "takeProfitPrice" field.
+func (_c *GridCreate) SetTakeProfitPrice(v decimal.Decimal) *GridCreate {
+	_c.mutation.SetTakeProfitPrice(v)
+	return _c
+}
+
+// SetNillableTakeProfitPrice sets the "takeProfitPrice" field if the given value is not nil.
+func (_c *GridCreate) SetNillableTakeProfitPrice(v *decimal.Decimal) *GridCreate {
+	if v != nil {
+		_c.SetTakeProfitPrice(*v)
+	}
+	return _c
+}
+
+// SetPeakPrice sets the "peakPrice" field.
+func (_c *GridCreate) SetPeakPrice(v decimal.Decimal) *GridCreate {
+	_c.mutation.SetPeakPrice(v)
+	return _c
+}
+
+// SetNillablePeakPrice sets the "peakPrice" field if the given value is not nil.
+func (_c *GridCreate) SetNillablePeakPrice(v *decimal.Decimal) *GridCreate {
+	if v != nil {
+		_c.SetPeakPrice(*v)
+	}
+	return _c
+}
+
+// SetRunner sets the "runner" field.
+func (_c *GridCreate) SetRunner(v bool) *GridCreate {
+	_c.mutation.SetRunner(v)
+	return _c
+}
+
+// SetNillableRunner sets the "runner" field if the given value is not nil.
+func (_c *GridCreate) SetNillableRunner(v *bool) *GridCreate {
+	if v != nil {
+		_c.SetRunner(*v)
+	}
+	return _c
+}
+
 // SetStatus sets the "status" field.
 func (_c *GridCreate) SetStatus(v grid.Status) *GridCreate {
 	_c.mutation.SetStatus(v)
@@ -212,11 +268,6 @@ func (_c *GridCreate) check() error {
 	if _, ok := _c.mutation.GridNumber(); !ok {
 		return &ValidationError{Name: "gridNumber", err: errors.New(`ent: missing required field "Grid.gridNumber"`)}
 	}
-	if v, ok := _c.mutation.GridNumber(); ok {
-		if err := grid.GridNumberValidator(v); err != nil {
-			return &ValidationError{Name: "gridNumber", err: fmt.Errorf(`ent: validator failed for field "Grid.gridNumber": %w`, err)}
-		}
-	}
 	if _, ok := _c.mutation.OrderPrice(); !ok {
 		return &ValidationError{Name: "orderPrice", err: errors.New(`ent: missing required field "Grid.orderPrice"`)}
 	}
@@ -311,6 +362,22 @@ func (_c *GridCreate) createSpec() (*Grid, *sqlgraph.CreateSpec) {
 		_spec.SetField(grid.FieldQuantity, field.TypeString, value)
 		_node.Quantity = value
 	}
+	if value, ok := _c.mutation.SellPrice(); ok {
+		_spec.SetField(grid.FieldSellPrice, field.TypeString, value)
+		_node.SellPrice = &value
+	}
+	if value, ok := _c.mutation.TakeProfitPrice(); ok {
+		_spec.SetField(grid.FieldTakeProfitPrice, field.TypeString, value)
+		_node.TakeProfitPrice = &value
+	}
+	if value, ok := _c.mutation.PeakPrice(); ok {
+		_spec.SetField(grid.FieldPeakPrice, field.TypeString, value)
+		_node.PeakPrice = &value
+	}
+	if value, ok := _c.mutation.Runner(); ok {
+		_spec.SetField(grid.FieldRunner, field.TypeBool, value)
+		_node.Runner = value
+	}
 	if value, ok := _c.mutation.Status(); ok {
 		_spec.SetField(grid.FieldStatus, field.TypeEnum, value)
 		_node.Status = value
@@ -183,6 +183,86 @@ func (_u *GridUpdate) SetNillableQuantity(v *decimal.Decimal) *GridUpdate {
 	return _u
 }
 
+// SetSellPrice sets the "sellPrice" field.
+func (_u *GridUpdate) SetSellPrice(v decimal.Decimal) *GridUpdate {
+	_u.mutation.SetSellPrice(v)
+	return _u
+}
+
+// SetNillableSellPrice sets the "sellPrice" field if the given value is not nil.
+func (_u *GridUpdate) SetNillableSellPrice(v *decimal.Decimal) *GridUpdate {
+	if v != nil {
+		_u.SetSellPrice(*v)
+	}
+	return _u
+}
+
+// ClearSellPrice clears the value of the "sellPrice" field.
+func (_u *GridUpdate) ClearSellPrice() *GridUpdate {
+	_u.mutation.ClearSellPrice()
+	return _u
+}
+
+// SetTakeProfitPrice sets the "takeProfitPrice" field.
+func (_u *GridUpdate) SetTakeProfitPrice(v decimal.Decimal) *GridUpdate {
+	_u.mutation.SetTakeProfitPrice(v)
+	return _u
+}
+
+// SetNillableTakeProfitPrice sets the "takeProfitPrice" field if the given value is not nil.
+func (_u *GridUpdate) SetNillableTakeProfitPrice(v *decimal.Decimal) *GridUpdate {
+	if v != nil {
+		_u.SetTakeProfitPrice(*v)
+	}
+	return _u
+}
+
+// ClearTakeProfitPrice clears the value of the "takeProfitPrice" field.
+func (_u *GridUpdate) ClearTakeProfitPrice() *GridUpdate {
+	_u.mutation.ClearTakeProfitPrice()
+	return _u
+}
+
+// SetPeakPrice sets the "peakPrice" field.
+func (_u *GridUpdate) SetPeakPrice(v decimal.Decimal) *GridUpdate {
+	_u.mutation.SetPeakPrice(v)
+	return _u
+}
+
+// SetNillablePeakPrice sets the "peakPrice" field if the given value is not nil.
+func (_u *GridUpdate) SetNillablePeakPrice(v *decimal.Decimal) *GridUpdate {
+	if v != nil {
+		_u.SetPeakPrice(*v)
+	}
+	return _u
+}
+
+// ClearPeakPrice clears the value of the "peakPrice" field.
+func (_u *GridUpdate) ClearPeakPrice() *GridUpdate {
+	_u.mutation.ClearPeakPrice()
+	return _u
+}
+
+// SetRunner sets the "runner" field.
+func (_u *GridUpdate) SetRunner(v bool) *GridUpdate {
+	_u.mutation.SetRunner(v)
+	return _u
+}
+
+// SetNillableRunner sets the "runner" field if the given value is not nil.
+func (_u *GridUpdate) SetNillableRunner(v *bool) *GridUpdate {
+	if v != nil {
+		_u.SetRunner(*v)
+	}
+	return _u
+}
+
+// ClearRunner clears the value of the "runner" field.
+func (_u *GridUpdate) ClearRunner() *GridUpdate {
+	_u.mutation.ClearRunner()
+	return _u
+}
+
 // SetStatus sets the "status" field.
 func (_u *GridUpdate) SetStatus(v grid.Status) *GridUpdate {
 	_u.mutation.SetStatus(v)
@@ -265,11 +345,6 @@ func (_u *GridUpdate) check() error {
 			return &ValidationError{Name: "strategyId", err: fmt.Errorf(`ent: validator failed for field "Grid.strategyId": %w`, err)}
 		}
 	}
-	if v, ok := _u.mutation.GridNumber(); ok {
-		if err := grid.GridNumberValidator(v); err != nil {
-			return &ValidationError{Name: "gridNumber", err: fmt.Errorf(`ent: validator failed for field "Grid.gridNumber": %w`, err)}
-		}
-	}
 	if v, ok := _u.mutation.Status(); ok {
 		if err := grid.StatusValidator(v); err != nil {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Grid.status": %w`, err)}
@@ -326,6 +401,30 @@ func (_u *GridUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Quantity(); ok {
 		_spec.SetField(grid.FieldQuantity, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.SellPrice(); ok {
+		_spec.SetField(grid.FieldSellPrice, field.TypeString, value)
+	}
+	if _u.mutation.SellPriceCleared() {
+		_spec.ClearField(grid.FieldSellPrice, field.TypeString)
+	}
+	if value, ok := _u.mutation.TakeProfitPrice(); ok {
+		_spec.SetField(grid.FieldTakeProfitPrice, field.TypeString, value)
+	}
+	if _u.mutation.TakeProfitPriceCleared() {
+		_spec.ClearField(grid.FieldTakeProfitPrice, field.TypeString)
+	}
+	if value, ok := _u.mutation.PeakPrice(); ok {
+		_spec.SetField(grid.FieldPeakPrice, field.TypeString, value)
+	}
+	if _u.mutation.PeakPriceCleared() {
+		_spec.ClearField(grid.FieldPeakPrice, field.TypeString)
+	}
+	if value, ok := _u.mutation.Runner(); ok {
+		_spec.SetField(grid.FieldRunner, field.TypeBool, value)
+	}
+	if _u.mutation.RunnerCleared() {
+		_spec.ClearField(grid.FieldRunner, field.TypeBool)
+	}
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(grid.FieldStatus, field.TypeEnum, value)
 	}
@@ -502,6 +601,86 @@ func (_u *GridUpdateOne) SetNillableQuantity(v *decimal.Decimal) *GridUpdateOne
 	return _u
 }
 
+// SetSellPrice sets the "sellPrice" field.
+func (_u *GridUpdateOne) SetSellPrice(v decimal.Decimal) *GridUpdateOne {
+	_u.mutation.SetSellPrice(v)
+	return _u
+}
+
+// SetNillableSellPrice sets the "sellPrice" field if the given value is not nil.
+func (_u *GridUpdateOne) SetNillableSellPrice(v *decimal.Decimal) *GridUpdateOne {
+	if v != nil {
+		_u.SetSellPrice(*v)
+	}
+	return _u
+}
+
+// ClearSellPrice clears the value of the "sellPrice" field.
+func (_u *GridUpdateOne) ClearSellPrice() *GridUpdateOne {
+	_u.mutation.ClearSellPrice()
+	return _u
+}
+
+// SetTakeProfitPrice sets the "takeProfitPrice" field.
+func (_u *GridUpdateOne) SetTakeProfitPrice(v decimal.Decimal) *GridUpdateOne {
+	_u.mutation.SetTakeProfitPrice(v)
+	return _u
+}
+
+// SetNillableTakeProfitPrice sets the "takeProfitPrice" field if the given value is not nil.
+func (_u *GridUpdateOne) SetNillableTakeProfitPrice(v *decimal.Decimal) *GridUpdateOne {
+	if v != nil {
+		_u.SetTakeProfitPrice(*v)
+	}
+	return _u
+}
+
+// ClearTakeProfitPrice clears the value of the "takeProfitPrice" field.
+func (_u *GridUpdateOne) ClearTakeProfitPrice() *GridUpdateOne {
+	_u.mutation.ClearTakeProfitPrice()
+	return _u
+}
+
+// SetPeakPrice sets the "peakPrice" field.
+func (_u *GridUpdateOne) SetPeakPrice(v decimal.Decimal) *GridUpdateOne {
+	_u.mutation.SetPeakPrice(v)
+	return _u
+}
+
+// SetNillablePeakPrice sets the "peakPrice" field if the given value is not nil.
+func (_u *GridUpdateOne) SetNillablePeakPrice(v *decimal.Decimal) *GridUpdateOne {
+	if v != nil {
+		_u.SetPeakPrice(*v)
+	}
+	return _u
+}
+
+// ClearPeakPrice clears the value of the "peakPrice" field.
+func (_u *GridUpdateOne) ClearPeakPrice() *GridUpdateOne {
+	_u.mutation.ClearPeakPrice()
+	return _u
+}
+
+// SetRunner sets the "runner" field.
+func (_u *GridUpdateOne) SetRunner(v bool) *GridUpdateOne {
+	_u.mutation.SetRunner(v)
+	return _u
+}
+
+// SetNillableRunner sets the "runner" field if the given value is not nil.
+func (_u *GridUpdateOne) SetNillableRunner(v *bool) *GridUpdateOne {
+	if v != nil {
+		_u.SetRunner(*v)
+	}
+	return _u
+}
+
+// ClearRunner clears the value of the "runner" field.
+func (_u *GridUpdateOne) ClearRunner() *GridUpdateOne {
+	_u.mutation.ClearRunner()
+	return _u
+}
+
 // SetStatus sets the "status" field.
 func (_u *GridUpdateOne) SetStatus(v grid.Status) *GridUpdateOne {
 	_u.mutation.SetStatus(v)
@@ -597,11 +776,6 @@ func (_u *GridUpdateOne) check() error {
 			return &ValidationError{Name: "strategyId", err: fmt.Errorf(`ent: validator failed for field "Grid.strategyId": %w`, err)}
 		}
 	}
-	if v, ok := _u.mutation.GridNumber(); ok {
-		if err := grid.GridNumberValidator(v); err != nil {
-			return &ValidationError{Name: "gridNumber", err: fmt.Errorf(`ent: validator failed for field "Grid.gridNumber": %w`, err)}
-		}
-	}
 	if v, ok := _u.mutation.Status(); ok {
 		if err := grid.StatusValidator(v); err != nil {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Grid.status": %w`, err)}
@@ -675,6 +849,30 @@ func (_u *GridUpdateOne) sqlSave(ctx context.Context) (_node *Grid, err error) {
 	if value, ok := _u.mutation.Quantity(); ok {
 		_spec.SetField(grid.FieldQuantity, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.SellPrice(); ok {
+		_spec.SetField(grid.FieldSellPrice, field.TypeString, value)
+	}
+	if _u.mutation.SellPriceCleared() {
+		_spec.ClearField(grid.FieldSellPrice, field.TypeString)
+	}
+	if value, ok := _u.mutation.TakeProfitPrice(); ok {
+		_spec.SetField(grid.FieldTakeProfitPrice, field.TypeString, value)
+	}
+	if _u.mutation.TakeProfitPriceCleared() {
+		_spec.ClearField(grid.FieldTakeProfitPrice, field.TypeString)
+	}
+	if value, ok := _u.mutation.PeakPrice(); ok {
+		_spec.SetField(grid.FieldPeakPrice, field.TypeString, value)
+	}
+	if _u.mutation.PeakPriceCleared() {
+		_spec.ClearField(grid.FieldPeakPrice, field.TypeString)
+	}
+	if value, ok := _u.mutation.Runner(); ok {
+		_spec.SetField(grid.FieldRunner, field.TypeBool, value)
+	}
+	if _u.mutation.RunnerCleared() {
+		_spec.ClearField(grid.FieldRunner, field.TypeBool)
+	}
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(grid.FieldStatus, field.TypeEnum, value)
 	}
@@ -23,6 +23,10 @@ var (
 		{Name: "final_price", Type: field.TypeString},
 		{Name: "amount", Type: field.TypeString},
 		{Name: "quantity", Type: field.TypeString},
+		{Name: "sell_price", Type: field.TypeString, Nullable: true},
+		{Name: "take_profit_price", Type: field.TypeString, Nullable: true},
+		{Name: "peak_price", Type: field.TypeString, Nullable: true},
+		{Name: "runner", Type: field.TypeBool, Nullable: true},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"buying", "selling", "bought"}},
 	}
 	// GridsTable holds the schema information for the "grids" table.
@@ -74,11 +78,22 @@ var (
 		{Name: "final_price", Type: field.TypeString},
 		{Name: "in_amount", Type: field.TypeString},
 		{Name: "out_amount", Type: field.TypeString},
-		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "closed", "rejected"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"submitting", "pending", "submitted", "timeout", "replaced", "dropped", "closed", "rejected"}},
 		{Name: "nonce", Type: field.TypeUint64},
 		{Name: "tx_hash", Type: field.TypeString, Size: 100},
 		{Name: "reason", Type: field.TypeString, Size: 500},
 		{Name: "profit", Type: field.TypeString, Nullable: true},
+		{Name: "paper", Type: field.TypeBool, Nullable: true},
+		{Name: "raw_tx", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "replaced_tx_hashes", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "replace_count", Type: field.TypeInt, Default: 0},
+		{Name: "replace_time", Type: field.TypeTime, Nullable: true},
+		{Name: "cancelled", Type: field.TypeBool, Default: false},
+		{Name: "retry_count", Type: field.TypeInt, Default: 0},
+		{Name: "next_retry_time", Type: field.TypeTime, Nullable: true},
+		{Name: "route", Type: field.TypeString, Nullable: true, Size: 100},
+		{Name: "runner_up_route", Type: field.TypeString, Nullable: true, Size: 100},
+		{Name: "route_spread_usd", Type: field.TypeString, Nullable: true},
 	}
 	// OrdersTable holds the schema information for the "orders" table.
 	OrdersTable = &schema.Table{
@@ -112,7 +127,7 @@ var (
 		{Name: "slippage_bps", Type: field.TypeInt},
 		{Name: "sell_slippage_bps", Type: field.TypeInt, Nullable: true},
 		{Name: "exit_slippage_bps", Type: field.TypeInt, Nullable: true},
-		{Name: "dex_aggregator", Type: field.TypeEnum, Enums: []string{"relay"}},
+		{Name: "dex_aggregator", Type: field.TypeEnum, Enums: []string{"relay", "okx", "onchain", "best"}},
 		{Name: "enable_infinite_approval", Type: field.TypeBool, Nullable: true},
 	}
 	// SettingsTable holds the schema information for the "settings" table.
@@ -139,7 +154,20 @@ var (
 		{Name: "symbol", Type: field.TypeString, Size: 32},
 		{Name: "martin_factor", Type: field.TypeFloat64},
 		{Name: "max_grid_limit", Type: field.TypeInt, Nullable: true},
+		{Name: "grid_mode", Type: field.TypeEnum, Enums: []string{"long", "neutral"}, Default: "long"},
+		{Name: "inventory_seeded", Type: field.TypeBool, Nullable: true},
+		{Name: "grid_type", Type: field.TypeEnum, Enums: []string{"geometric", "arithmetic", "custom"}, Default: "geometric"},
+		{Name: "grid_count", Type: field.TypeInt, Nullable: true},
+		{Name: "price_step", Type: field.TypeString, Nullable: true},
+		{Name: "grid_ladder", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "take_profit_ratio", Type: field.TypeString},
+		{Name: "trailing_take_profit", Type: field.TypeString, Nullable: true},
+		{Name: "take_profit_portion", Type: field.TypeString, Nullable: true},
+		{Name: "adaptive_spacing", Type: field.TypeBool, Nullable: true},
+		{Name: "adaptive_min_ratio", Type: field.TypeString, Nullable: true},
+		{Name: "adaptive_max_ratio", Type: field.TypeString, Nullable: true},
+		{Name: "adaptive_volatility", Type: field.TypeString, Nullable: true},
+		{Name: "adaptive_update_time", Type: field.TypeTime, Nullable: true},
 		{Name: "upper_price_bound", Type: field.TypeString},
 		{Name: "lower_price_bound", Type: field.TypeString},
 		{Name: "initial_order_size", Type: field.TypeString},
@@ -151,17 +179,27 @@ var (
 		{Name: "take_profit_exit", Type: field.TypeString, Nullable: true},
 		{Name: "global_take_profit_ratio", Type: field.TypeString, Nullable: true},
 		{Name: "dynamic_stop_loss", Type: field.TypeBool, Nullable: true},
+		{Name: "trailing_up", Type: field.TypeBool, Nullable: true},
+		{Name: "trailing_down", Type: field.TypeBool, Nullable: true},
+		{Name: "trailing_dwell_minutes", Type: field.TypeInt, Nullable: true, Default: 0},
 		{Name: "drop_on", Type: field.TypeBool, Nullable: true},
 		{Name: "candles_to_check", Type: field.TypeInt, Nullable: true, Default: 0},
 		{Name: "drop_threshold", Type: field.TypeString, Nullable: true},
+		{Name: "rsi_threshold", Type: field.TypeString, Nullable: true},
+		{Name: "ema_filter", Type: field.TypeEnum, Enums: []string{"off", "above", "below"}, Default: "off"},
+		{Name: "ema_period", Type: field.TypeInt, Nullable: true, Default: 0},
+		{Name: "macd_filter", Type: field.TypeBool, Nullable: true},
 		{Name: "enable_auto_buy", Type: field.TypeBool},
 		{Name: "enable_auto_sell", Type: field.TypeBool},
 		{Name: "enable_auto_exit", Type: field.TypeBool},
 		{Name: "enable_push_notification", Type: field.TypeBool},
+		{Name: "paper_trading", Type: field.TypeBool, Nullable: true},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "inactive"}},
 		{Name: "grid_trend", Type: field.TypeString, Nullable: true},
+		{Name: "buy_filter_reason", Type: field.TypeString, Nullable: true, Size: 200},
 		{Name: "last_lower_threshold_alert_time", Type: field.TypeTime, Nullable: true},
 		{Name: "last_upper_threshold_alert_time", Type: field.TypeTime, Nullable: true},
+		{Name: "out_of_range_time", Type: field.TypeTime, Nullable: true},
 	}
 	// StrategiesTable holds the schema information for the "strategies" table.
 	StrategiesTable = &schema.Table{
@@ -42,27 +42,31 @@ const (
 // GridMutation represents an operation that mutates the Grid nodes in the graph.
 type GridMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	create_time   *time.Time
-	update_time   *time.Time
-	guid          *string
-	account       *string
-	token         *string
-	symbol        *string
-	strategyId    *string
-	gridNumber    *int
-	addgridNumber *int
-	orderPrice    *decimal.Decimal
-	finalPrice    *decimal.Decimal
-	amount        *decimal.Decimal
-	quantity      *decimal.Decimal
-	status        *grid.Status
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*Grid, error)
-	predicates    []predicate.Grid
+	op              Op
+	typ             string
+	id              *int
+	create_time     *time.Time
+	update_time     *time.Time
+	guid            *string
+	account         *string
+	token           *string
+	symbol          *string
+	strategyId      *string
+	gridNumber      *int
+	addgridNumber   *int
+	orderPrice      *decimal.Decimal
+	finalPrice      *decimal.Decimal
+	amount          *decimal.Decimal
+	quantity        *decimal.Decimal
+	sellPrice       *decimal.Decimal
+	takeProfitPrice *decimal.Decimal
+	peakPrice       *decimal.Decimal
+	runner          *bool
+	status          *grid.Status
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*Grid, error)
+	predicates      []predicate.Grid
 }
 
 var _ ent.Mutation = (*GridMutation)(nil)
@@ -615,6 +619,202 @@ func (m *GridMutation) ResetQuantity() {
 	m.quantity = nil
 }
 
+// SetSellPrice sets the "sellPrice" field.
+func (m *GridMutation) SetSellPrice(d decimal.Decimal) {
+	m.sellPrice = &d
+}
+
+// SellPrice returns the value of the "sellPrice" field in the mutation.
+func (m *GridMutation) SellPrice() (r decimal.Decimal, exists bool) {
+	v := m.sellPrice
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSellPrice returns the old "sellPrice" field's value of the Grid entity.
+// If the Grid object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GridMutation) OldSellPrice(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSellPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSellPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSellPrice: %w", err)
+	}
+	return oldValue.SellPrice, nil
+}
+
+// ClearSellPrice clears the value of the "sellPrice" field.
+func (m *GridMutation) ClearSellPrice() {
+	m.sellPrice = nil
+	m.clearedFields[grid.FieldSellPrice] = struct{}{}
+}
+
+// SellPriceCleared returns if the "sellPrice" field was cleared in this mutation.
+func (m *GridMutation) SellPriceCleared() bool {
+	_, ok := m.clearedFields[grid.FieldSellPrice]
+	return ok
+}
+
+// ResetSellPrice resets all changes to the "sellPrice" field.
+func (m *GridMutation) ResetSellPrice() {
+	m.sellPrice = nil
+	delete(m.clearedFields, grid.FieldSellPrice)
+}
+
+// SetTakeProfitPrice sets the "takeProfitPrice" field.
+func (m *GridMutation) SetTakeProfitPrice(d decimal.Decimal) {
+	m.takeProfitPrice = &d
+}
+
+// TakeProfitPrice returns the value of the "takeProfitPrice" field in the mutation.
+func (m *GridMutation) TakeProfitPrice() (r decimal.Decimal, exists bool) {
+	v := m.takeProfitPrice
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTakeProfitPrice returns the old "takeProfitPrice" field's value of the Grid entity.
+// If the Grid object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GridMutation) OldTakeProfitPrice(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTakeProfitPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTakeProfitPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTakeProfitPrice: %w", err)
+	}
+	return oldValue.TakeProfitPrice, nil
+}
+
+// ClearTakeProfitPrice clears the value of the "takeProfitPrice" field.
+func (m *GridMutation) ClearTakeProfitPrice() {
+	m.takeProfitPrice = nil
+	m.clearedFields[grid.FieldTakeProfitPrice] = struct{}{}
+}
+
+// TakeProfitPriceCleared returns if the "takeProfitPrice" field was cleared in this mutation.
+func (m *GridMutation) TakeProfitPriceCleared() bool {
+	_, ok := m.clearedFields[grid.FieldTakeProfitPrice]
+	return ok
+}
+
+// ResetTakeProfitPrice resets all changes to the "takeProfitPrice" field.
+func (m *GridMutation) ResetTakeProfitPrice() {
+	m.takeProfitPrice = nil
+	delete(m.clearedFields, grid.FieldTakeProfitPrice)
+}
+
+// SetPeakPrice sets the "peakPrice" field.
+func (m *GridMutation) SetPeakPrice(d decimal.Decimal) {
+	m.peakPrice = &d
+}
+
+// PeakPrice returns the value of the "peakPrice" field in the mutation.
+func (m *GridMutation) PeakPrice() (r decimal.Decimal, exists bool) {
+	v := m.peakPrice
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPeakPrice returns the old "peakPrice" field's value of the Grid entity.
+// If the Grid object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GridMutation) OldPeakPrice(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPeakPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPeakPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPeakPrice: %w", err)
+	}
+	return oldValue.PeakPrice, nil
+}
+
+// ClearPeakPrice clears the value of the "peakPrice" field.
+func (m *GridMutation) ClearPeakPrice() {
+	m.peakPrice = nil
+	m.clearedFields[grid.FieldPeakPrice] = struct{}{}
+}
+
+// PeakPriceCleared returns if the "peakPrice" field was cleared in this mutation.
+func (m *GridMutation) PeakPriceCleared() bool {
+	_, ok := m.clearedFields[grid.FieldPeakPrice]
+	return ok
+}
+
+// ResetPeakPrice resets all changes to the "peakPrice" field.
+func (m *GridMutation) ResetPeakPrice() {
+	m.peakPrice = nil
+	delete(m.clearedFields, grid.FieldPeakPrice)
+}
+
+// SetRunner sets the "runner" field.
+func (m *GridMutation) SetRunner(b bool) {
+	m.runner = &b
+}
+
+// Runner returns the value of the "runner" field in the mutation.
+func (m *GridMutation) Runner() (r bool, exists bool) {
+	v := m.runner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRunner returns the old "runner" field's value of the Grid entity.
+// If the Grid object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GridMutation) OldRunner(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRunner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRunner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRunner: %w", err)
+	}
+	return oldValue.Runner, nil
+}
+
+// ClearRunner clears the value of the "runner" field.
+func (m *GridMutation) ClearRunner() {
+	m.runner = nil
+	m.clearedFields[grid.FieldRunner] = struct{}{}
+}
+
+// RunnerCleared returns if the "runner" field was cleared in this mutation.
+func (m *GridMutation) RunnerCleared() bool {
+	_, ok := m.clearedFields[grid.FieldRunner]
+	return ok
+}
+
+// ResetRunner resets all changes to the "runner" field.
+func (m *GridMutation) ResetRunner() {
+	m.runner = nil
+	delete(m.clearedFields, grid.FieldRunner)
+}
+
 // SetStatus sets the "status" field.
 func (m *GridMutation) SetStatus(gr grid.Status) {
 	m.status = &gr
@@ -685,7 +885,7 @@ func (m *GridMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *GridMutation) Fields() []string {
-	fields := make([]string, 0, 13)
+	fields := make([]string, 0, 17)
 	if m.create_time != nil {
 		fields = append(fields, grid.FieldCreateTime)
 	}
@@ -722,6 +922,18 @@ func (m *GridMutation) Fields() []string {
 	if m.quantity != nil {
 		fields = append(fields, grid.FieldQuantity)
 	}
+	if m.sellPrice != nil {
+		fields = append(fields, grid.FieldSellPrice)
+	}
+	if m.takeProfitPrice != nil {
+		fields = append(fields, grid.FieldTakeProfitPrice)
+	}
+	if m.peakPrice != nil {
+		fields = append(fields, grid.FieldPeakPrice)
+	}
+	if m.runner != nil {
+		fields = append(fields, grid.FieldRunner)
+	}
 	if m.status != nil {
 		fields = append(fields, grid.FieldStatus)
 	}
@@ -757,6 +969,14 @@ func (m *GridMutation) Field(name string) (ent.Value, bool) {
 		return m.Amount()
 	case grid.FieldQuantity:
 		return m.Quantity()
+	case grid.FieldSellPrice:
+		return m.SellPrice()
+	case grid.FieldTakeProfitPrice:
+		return m.TakeProfitPrice()
+	case grid.FieldPeakPrice:
+		return m.PeakPrice()
+	case grid.FieldRunner:
+		return m.Runner()
 	case grid.FieldStatus:
 		return m.Status()
 	}
@@ -792,6 +1012,14 @@ func (m *GridMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldAmount(ctx)
 	case grid.FieldQuantity:
 		return m.OldQuantity(ctx)
+	case grid.FieldSellPrice:
+		return m.OldSellPrice(ctx)
+	case grid.FieldTakeProfitPrice:
+		return m.OldTakeProfitPrice(ctx)
+	case grid.FieldPeakPrice:
+		return m.OldPeakPrice(ctx)
+	case grid.FieldRunner:
+		return m.OldRunner(ctx)
 	case grid.FieldStatus:
 		return m.OldStatus(ctx)
 	}
@@ -887,6 +1115,34 @@ func (m *GridMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetQuantity(v)
 		return nil
+	case grid.FieldSellPrice:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSellPrice(v)
+		return nil
+	case grid.FieldTakeProfitPrice:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTakeProfitPrice(v)
+		return nil
+	case grid.FieldPeakPrice:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPeakPrice(v)
+		return nil
+	case grid.FieldRunner:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunner(v)
+		return nil
 	case grid.FieldStatus:
 		v, ok := value.(grid.Status)
 		if !ok {
@@ -938,7 +1194,20 @@ func (m *GridMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *GridMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(grid.FieldSellPrice) {
+		fields = append(fields, grid.FieldSellPrice)
+	}
+	if m.FieldCleared(grid.FieldTakeProfitPrice) {
+		fields = append(fields, grid.FieldTakeProfitPrice)
+	}
+	if m.FieldCleared(grid.FieldPeakPrice) {
+		fields = append(fields, grid.FieldPeakPrice)
+	}
+	if m.FieldCleared(grid.FieldRunner) {
+		fields = append(fields, grid.FieldRunner)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -951,6 +1220,20 @@ func (m *GridMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *GridMutation) ClearField(name string) error {
+	switch name {
+	case grid.FieldSellPrice:
+		m.ClearSellPrice()
+		return nil
+	case grid.FieldTakeProfitPrice:
+		m.ClearTakeProfitPrice()
+		return nil
+	case grid.FieldPeakPrice:
+		m.ClearPeakPrice()
+		return nil
+	case grid.FieldRunner:
+		m.ClearRunner()
+		return nil
+	}
 	return fmt.Errorf("unknown Grid nullable field %s", name)
 }
 
@@ -994,6 +1277,18 @@ func (m *GridMutation) ResetField(name string) error {
 	case grid.FieldQuantity:
 		m.ResetQuantity()
 		return nil
+	case grid.FieldSellPrice:
+		m.ResetSellPrice()
+		return nil
+	case grid.FieldTakeProfitPrice:
+		m.ResetTakeProfitPrice()
+		return nil
+	case grid.FieldPeakPrice:
+		m.ResetPeakPrice()
+		return nil
+	case grid.FieldRunner:
+		m.ResetRunner()
+		return nil
 	case grid.FieldStatus:
 		m.ResetStatus()
 		return nil
@@ -1576,34 +1871,47 @@ func (m *NonceMutation) ResetEdge(name string) error {
 // OrderMutation represents an operation that mutates the Order nodes in the graph.
 type OrderMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	create_time   *time.Time
-	update_time   *time.Time
-	account       *string
-	token         *string
-	symbol        *string
-	gridId        *string
-	gridNumber    *int
-	addgridNumber *int
-	gridBuyCost   *decimal.Decimal
-	strategyId    *string
-	_type         *order.Type
-	price         *decimal.Decimal
-	finalPrice    *decimal.Decimal
-	inAmount      *decimal.Decimal
-	outAmount     *decimal.Decimal
-	status        *order.Status
-	nonce         *uint64
-	addnonce      *int64
-	txHash        *string
-	reason        *string
-	profit        *decimal.Decimal
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*Order, error)
-	predicates    []predicate.Order
+	op               Op
+	typ              string
+	id               *int
+	create_time      *time.Time
+	update_time      *time.Time
+	account          *string
+	token            *string
+	symbol           *string
+	gridId           *string
+	gridNumber       *int
+	addgridNumber    *int
+	gridBuyCost      *decimal.Decimal
+	strategyId       *string
+	_type            *order.Type
+	price            *decimal.Decimal
+	finalPrice       *decimal.Decimal
+	inAmount         *decimal.Decimal
+	outAmount        *decimal.Decimal
+	status           *order.Status
+	nonce            *uint64
+	addnonce         *int64
+	txHash           *string
+	reason           *string
+	profit           *decimal.Decimal
+	paper            *bool
+	rawTx            *string
+	replacedTxHashes *string
+	replaceCount     *int
+	addreplaceCount  *int
+	replaceTime      *time.Time
+	cancelled        *bool
+	retryCount       *int
+	addretryCount    *int
+	nextRetryTime    *time.Time
+	route            *string
+	runnerUpRoute    *string
+	routeSpreadUsd   *decimal.Decimal
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*Order, error)
+	predicates       []predicate.Order
 }
 
 var _ ent.Mutation = (*OrderMutation)(nil)
@@ -2481,97 +2789,1933 @@ func (m *OrderMutation) ResetProfit() {
 	delete(m.clearedFields, order.FieldProfit)
 }
 
-// Where appends a list predicates to the OrderMutation builder.
-func (m *OrderMutation) Where(ps ...predicate.Order) {
-	m.predicates = append(m.predicates, ps...)
+// SetPaper sets the "paper" field.
+func (m *OrderMutation) SetPaper(b bool) {
+	m.paper = &b
 }
 
-// WhereP appends storage-level predicates to the OrderMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *OrderMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Order, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// Paper returns the value of the "paper" field in the mutation.
+func (m *OrderMutation) Paper() (r bool, exists bool) {
+	v := m.paper
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *OrderMutation) Op() Op {
-	return m.op
+// OldPaper returns the old "paper" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldPaper(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPaper is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPaper requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPaper: %w", err)
+	}
+	return oldValue.Paper, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *OrderMutation) SetOp(op Op) {
-	m.op = op
+// ClearPaper clears the value of the "paper" field.
+func (m *OrderMutation) ClearPaper() {
+	m.paper = nil
+	m.clearedFields[order.FieldPaper] = struct{}{}
 }
 
-// Type returns the node type of this mutation (Order).
-func (m *OrderMutation) Type() string {
-	return m.typ
+// PaperCleared returns if the "paper" field was cleared in this mutation.
+func (m *OrderMutation) PaperCleared() bool {
+	_, ok := m.clearedFields[order.FieldPaper]
+	return ok
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *OrderMutation) Fields() []string {
-	fields := make([]string, 0, 19)
-	if m.create_time != nil {
-		fields = append(fields, order.FieldCreateTime)
-	}
-	if m.update_time != nil {
-		fields = append(fields, order.FieldUpdateTime)
+// ResetPaper resets all changes to the "paper" field.
+func (m *OrderMutation) ResetPaper() {
+	m.paper = nil
+	delete(m.clearedFields, order.FieldPaper)
+}
+
+// SetRawTx sets the "rawTx" field.
+func (m *OrderMutation) SetRawTx(s string) {
+	m.rawTx = &s
+}
+
+// RawTx returns the value of the "rawTx" field in the mutation.
+func (m *OrderMutation) RawTx() (r string, exists bool) {
+	v := m.rawTx
+	if v == nil {
+		return
 	}
-	if m.account != nil {
-		fields = append(fields, order.FieldAccount)
+	return *v, true
+}
+
+// OldRawTx returns the old "rawTx" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldRawTx(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRawTx is only allowed on UpdateOne operations")
 	}
-	if m.token != nil {
-		fields = append(fields, order.FieldToken)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRawTx requires an ID field in the mutation")
 	}
-	if m.symbol != nil {
-		fields = append(fields, order.FieldSymbol)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRawTx: %w", err)
 	}
-	if m.gridId != nil {
-		fields = append(fields, order.FieldGridId)
+	return oldValue.RawTx, nil
+}
+
+// ClearRawTx clears the value of the "rawTx" field.
+func (m *OrderMutation) ClearRawTx() {
+	m.rawTx = nil
+	m.clearedFields[order.FieldRawTx] = struct{}{}
+}
+
+// RawTxCleared returns if the "rawTx" field was cleared in this mutation.
+func (m *OrderMutation) RawTxCleared() bool {
+	_, ok := m.clearedFields[order.FieldRawTx]
+	return ok
+}
+
+// ResetRawTx resets all changes to the "rawTx" field.
+func (m *OrderMutation) ResetRawTx() {
+	m.rawTx = nil
+	delete(m.clearedFields, order.FieldRawTx)
+}
+
+// SetReplacedTxHashes sets the "replacedTxHashes" field.
+func (m *OrderMutation) SetReplacedTxHashes(s string) {
+	m.replacedTxHashes = &s
+}
+
+// ReplacedTxHashes returns the value of the "replacedTxHashes" field in the mutation.
+func (m *OrderMutation) ReplacedTxHashes() (r string, exists bool) {
+	v := m.replacedTxHashes
+	if v == nil {
+		return
 	}
-	if m.gridNumber != nil {
-		fields = append(fields, order.FieldGridNumber)
+	return *v, true
+}
+
+// OldReplacedTxHashes returns the old "replacedTxHashes" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldReplacedTxHashes(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReplacedTxHashes is only allowed on UpdateOne operations")
 	}
-	if m.gridBuyCost != nil {
-		fields = append(fields, order.FieldGridBuyCost)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReplacedTxHashes requires an ID field in the mutation")
 	}
-	if m.strategyId != nil {
-		fields = append(fields, order.FieldStrategyId)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReplacedTxHashes: %w", err)
 	}
-	if m._type != nil {
+	return oldValue.ReplacedTxHashes, nil
+}
+
+// ClearReplacedTxHashes clears the value of the "replacedTxHashes" field.
+func (m *OrderMutation) ClearReplacedTxHashes() {
+	m.replacedTxHashes = nil
+	m.clearedFields[order.FieldReplacedTxHashes] = struct{}{}
+}
+
+// ReplacedTxHashesCleared returns if the "replacedTxHashes" field was cleared in this mutation.
+func (m *OrderMutation) ReplacedTxHashesCleared() bool {
+	_, ok := m.clearedFields[order.FieldReplacedTxHashes]
+	return ok
+}
+
+// ResetReplacedTxHashes resets all changes to the "replacedTxHashes" field.
+func (m *OrderMutation) ResetReplacedTxHashes() {
+	m.replacedTxHashes = nil
+	delete(m.clearedFields, order.FieldReplacedTxHashes)
+}
+
+// SetReplaceCount sets the "replaceCount" field.
+func (m *OrderMutation) SetReplaceCount(i int) {
+	m.replaceCount = &i
+	m.addreplaceCount = nil
+}
+
+// ReplaceCount returns the value of the "replaceCount" field in the mutation.
+func (m *OrderMutation) ReplaceCount() (r int, exists bool) {
+	v := m.replaceCount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReplaceCount returns the old "replaceCount" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldReplaceCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReplaceCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReplaceCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReplaceCount: %w", err)
+	}
+	return oldValue.ReplaceCount, nil
+}
+
+// AddReplaceCount adds i to the "replaceCount" field.
+func (m *OrderMutation) AddReplaceCount(i int) {
+	if m.addreplaceCount != nil {
+		*m.addreplaceCount += i
+	} else {
+		m.addreplaceCount = &i
+	}
+}
+
+// AddedReplaceCount returns the value that was added to the "replaceCount" field in this mutation.
+func (m *OrderMutation) AddedReplaceCount() (r int, exists bool) {
+	v := m.addreplaceCount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetReplaceCount resets all changes to the "replaceCount" field.
+func (m *OrderMutation) ResetReplaceCount() {
+	m.replaceCount = nil
+	m.addreplaceCount = nil
+}
+
+// SetReplaceTime sets the "replaceTime" field.
+func (m *OrderMutation) SetReplaceTime(t time.Time) {
+	m.replaceTime = &t
+}
+
+// ReplaceTime returns the value of the "replaceTime" field in the mutation.
+func (m *OrderMutation) ReplaceTime() (r time.Time, exists bool) {
+	v := m.replaceTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReplaceTime returns the old "replaceTime" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldReplaceTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReplaceTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReplaceTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReplaceTime: %w", err)
+	}
+	return oldValue.ReplaceTime, nil
+}
+
+// ClearReplaceTime clears the value of the "replaceTime" field.
+func (m *OrderMutation) ClearReplaceTime() {
+	m.replaceTime = nil
+	m.clearedFields[order.FieldReplaceTime] = struct{}{}
+}
+
+// ReplaceTimeCleared returns if the "replaceTime" field was cleared in this mutation.
+func (m *OrderMutation) ReplaceTimeCleared() bool {
+	_, ok := m.clearedFields[order.FieldReplaceTime]
+	return ok
+}
+
+// ResetReplaceTime resets all changes to the "replaceTime" field.
+func (m *OrderMutation) ResetReplaceTime() {
+	m.replaceTime = nil
+	delete(m.clearedFields, order.FieldReplaceTime)
+}
+
+// SetCancelled sets the "cancelled" field.
+func (m *OrderMutation) SetCancelled(b bool) {
+	m.cancelled = &b
+}
+
+// Cancelled returns the value of the "cancelled" field in the mutation.
+func (m *OrderMutation) Cancelled() (r bool, exists bool) {
+	v := m.cancelled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCancelled returns the old "cancelled" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldCancelled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCancelled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCancelled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCancelled: %w", err)
+	}
+	return oldValue.Cancelled, nil
+}
+
+// ResetCancelled resets all changes to the "cancelled" field.
+func (m *OrderMutation) ResetCancelled() {
+	m.cancelled = nil
+}
+
+// SetRetryCount sets the "retryCount" field.
+func (m *OrderMutation) SetRetryCount(i int) {
+	m.retryCount = &i
+	m.addretryCount = nil
+}
+
+// RetryCount returns the value of the "retryCount" field in the mutation.
+func (m *OrderMutation) RetryCount() (r int, exists bool) {
+	v := m.retryCount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetryCount returns the old "retryCount" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldRetryCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRetryCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRetryCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetryCount: %w", err)
+	}
+	return oldValue.RetryCount, nil
+}
+
+// AddRetryCount adds i to the "retryCount" field.
+func (m *OrderMutation) AddRetryCount(i int) {
+	if m.addretryCount != nil {
+		*m.addretryCount += i
+	} else {
+		m.addretryCount = &i
+	}
+}
+
+// AddedRetryCount returns the value that was added to the "retryCount" field in this mutation.
+func (m *OrderMutation) AddedRetryCount() (r int, exists bool) {
+	v := m.addretryCount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRetryCount resets all changes to the "retryCount" field.
+func (m *OrderMutation) ResetRetryCount() {
+	m.retryCount = nil
+	m.addretryCount = nil
+}
+
+// SetNextRetryTime sets the "nextRetryTime" field.
+func (m *OrderMutation) SetNextRetryTime(t time.Time) {
+	m.nextRetryTime = &t
+}
+
+// NextRetryTime returns the value of the "nextRetryTime" field in the mutation.
+func (m *OrderMutation) NextRetryTime() (r time.Time, exists bool) {
+	v := m.nextRetryTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextRetryTime returns the old "nextRetryTime" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldNextRetryTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextRetryTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextRetryTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextRetryTime: %w", err)
+	}
+	return oldValue.NextRetryTime, nil
+}
+
+// ClearNextRetryTime clears the value of the "nextRetryTime" field.
+func (m *OrderMutation) ClearNextRetryTime() {
+	m.nextRetryTime = nil
+	m.clearedFields[order.FieldNextRetryTime] = struct{}{}
+}
+
+// NextRetryTimeCleared returns if the "nextRetryTime" field was cleared in this mutation.
+func (m *OrderMutation) NextRetryTimeCleared() bool {
+	_, ok := m.clearedFields[order.FieldNextRetryTime]
+	return ok
+}
+
+// ResetNextRetryTime resets all changes to the "nextRetryTime" field.
+func (m *OrderMutation) ResetNextRetryTime() {
+	m.nextRetryTime = nil
+	delete(m.clearedFields, order.FieldNextRetryTime)
+}
+
+// SetRoute sets the "route" field.
+func (m *OrderMutation) SetRoute(s string) {
+	m.route = &s
+}
+
+// Route returns the value of the "route" field in the mutation.
+func (m *OrderMutation) Route() (r string, exists bool) {
+	v := m.route
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoute returns the old "route" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldRoute(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoute is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoute requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoute: %w", err)
+	}
+	return oldValue.Route, nil
+}
+
+// ClearRoute clears the value of the "route" field.
+func (m *OrderMutation) ClearRoute() {
+	m.route = nil
+	m.clearedFields[order.FieldRoute] = struct{}{}
+}
+
+// RouteCleared returns if the "route" field was cleared in this mutation.
+func (m *OrderMutation) RouteCleared() bool {
+	_, ok := m.clearedFields[order.FieldRoute]
+	return ok
+}
+
+// ResetRoute resets all changes to the "route" field.
+func (m *OrderMutation) ResetRoute() {
+	m.route = nil
+	delete(m.clearedFields, order.FieldRoute)
+}
+
+// SetRunnerUpRoute sets the "runnerUpRoute" field.
+func (m *OrderMutation) SetRunnerUpRoute(s string) {
+	m.runnerUpRoute = &s
+}
+
+// RunnerUpRoute returns the value of the "runnerUpRoute" field in the mutation.
+func (m *OrderMutation) RunnerUpRoute() (r string, exists bool) {
+	v := m.runnerUpRoute
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRunnerUpRoute returns the old "runnerUpRoute" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldRunnerUpRoute(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRunnerUpRoute is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRunnerUpRoute requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRunnerUpRoute: %w", err)
+	}
+	return oldValue.RunnerUpRoute, nil
+}
+
+// ClearRunnerUpRoute clears the value of the "runnerUpRoute" field.
+func (m *OrderMutation) ClearRunnerUpRoute() {
+	m.runnerUpRoute = nil
+	m.clearedFields[order.FieldRunnerUpRoute] = struct{}{}
+}
+
+// RunnerUpRouteCleared returns if the "runnerUpRoute" field was cleared in this mutation.
+func (m *OrderMutation) RunnerUpRouteCleared() bool {
+	_, ok := m.clearedFields[order.FieldRunnerUpRoute]
+	return ok
+}
+
+// ResetRunnerUpRoute resets all changes to the "runnerUpRoute" field.
+func (m *OrderMutation) ResetRunnerUpRoute() {
+	m.runnerUpRoute = nil
+	delete(m.clearedFields, order.FieldRunnerUpRoute)
+}
+
+// SetRouteSpreadUsd sets the "routeSpreadUsd" field.
+func (m *OrderMutation) SetRouteSpreadUsd(d decimal.Decimal) {
+	m.routeSpreadUsd = &d
+}
+
+// RouteSpreadUsd returns the value of the "routeSpreadUsd" field in the mutation.
+func (m *OrderMutation) RouteSpreadUsd() (r decimal.Decimal, exists bool) {
+	v := m.routeSpreadUsd
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRouteSpreadUsd returns the old "routeSpreadUsd" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldRouteSpreadUsd(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRouteSpreadUsd is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRouteSpreadUsd requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRouteSpreadUsd: %w", err)
+	}
+	return oldValue.RouteSpreadUsd, nil
+}
+
+// ClearRouteSpreadUsd clears the value of the "routeSpreadUsd" field.
+func (m *OrderMutation) ClearRouteSpreadUsd() {
+	m.routeSpreadUsd = nil
+	m.clearedFields[order.FieldRouteSpreadUsd] = struct{}{}
+}
+
+// RouteSpreadUsdCleared returns if the "routeSpreadUsd" field was cleared in this mutation.
+func (m *OrderMutation) RouteSpreadUsdCleared() bool {
+	_, ok := m.clearedFields[order.FieldRouteSpreadUsd]
+	return ok
+}
+
+// ResetRouteSpreadUsd resets all changes to the "routeSpreadUsd" field.
+func (m *OrderMutation) ResetRouteSpreadUsd() {
+	m.routeSpreadUsd = nil
+	delete(m.clearedFields, order.FieldRouteSpreadUsd)
+}
+
+// Where appends a list predicates to the OrderMutation builder.
+func (m *OrderMutation) Where(ps ...predicate.Order) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OrderMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OrderMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Order, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OrderMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OrderMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Order).
+func (m *OrderMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OrderMutation) Fields() []string {
+	fields := make([]string, 0, 30)
+	if m.create_time != nil {
+		fields = append(fields, order.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, order.FieldUpdateTime)
+	}
+	if m.account != nil {
+		fields = append(fields, order.FieldAccount)
+	}
+	if m.token != nil {
+		fields = append(fields, order.FieldToken)
+	}
+	if m.symbol != nil {
+		fields = append(fields, order.FieldSymbol)
+	}
+	if m.gridId != nil {
+		fields = append(fields, order.FieldGridId)
+	}
+	if m.gridNumber != nil {
+		fields = append(fields, order.FieldGridNumber)
+	}
+	if m.gridBuyCost != nil {
+		fields = append(fields, order.FieldGridBuyCost)
+	}
+	if m.strategyId != nil {
+		fields = append(fields, order.FieldStrategyId)
+	}
+	if m._type != nil {
 		fields = append(fields, order.FieldType)
 	}
-	if m.price != nil {
-		fields = append(fields, order.FieldPrice)
+	if m.price != nil {
+		fields = append(fields, order.FieldPrice)
+	}
+	if m.finalPrice != nil {
+		fields = append(fields, order.FieldFinalPrice)
+	}
+	if m.inAmount != nil {
+		fields = append(fields, order.FieldInAmount)
+	}
+	if m.outAmount != nil {
+		fields = append(fields, order.FieldOutAmount)
+	}
+	if m.status != nil {
+		fields = append(fields, order.FieldStatus)
+	}
+	if m.nonce != nil {
+		fields = append(fields, order.FieldNonce)
+	}
+	if m.txHash != nil {
+		fields = append(fields, order.FieldTxHash)
+	}
+	if m.reason != nil {
+		fields = append(fields, order.FieldReason)
+	}
+	if m.profit != nil {
+		fields = append(fields, order.FieldProfit)
+	}
+	if m.paper != nil {
+		fields = append(fields, order.FieldPaper)
+	}
+	if m.rawTx != nil {
+		fields = append(fields, order.FieldRawTx)
+	}
+	if m.replacedTxHashes != nil {
+		fields = append(fields, order.FieldReplacedTxHashes)
+	}
+	if m.replaceCount != nil {
+		fields = append(fields, order.FieldReplaceCount)
+	}
+	if m.replaceTime != nil {
+		fields = append(fields, order.FieldReplaceTime)
+	}
+	if m.cancelled != nil {
+		fields = append(fields, order.FieldCancelled)
+	}
+	if m.retryCount != nil {
+		fields = append(fields, order.FieldRetryCount)
+	}
+	if m.nextRetryTime != nil {
+		fields = append(fields, order.FieldNextRetryTime)
+	}
+	if m.route != nil {
+		fields = append(fields, order.FieldRoute)
+	}
+	if m.runnerUpRoute != nil {
+		fields = append(fields, order.FieldRunnerUpRoute)
+	}
+	if m.routeSpreadUsd != nil {
+		fields = append(fields, order.FieldRouteSpreadUsd)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OrderMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case order.FieldCreateTime:
+		return m.CreateTime()
+	case order.FieldUpdateTime:
+		return m.UpdateTime()
+	case order.FieldAccount:
+		return m.Account()
+	case order.FieldToken:
+		return m.Token()
+	case order.FieldSymbol:
+		return m.Symbol()
+	case order.FieldGridId:
+		return m.GridId()
+	case order.FieldGridNumber:
+		return m.GridNumber()
+	case order.FieldGridBuyCost:
+		return m.GridBuyCost()
+	case order.FieldStrategyId:
+		return m.StrategyId()
+	case order.FieldType:
+		return m.GetType()
+	case order.FieldPrice:
+		return m.Price()
+	case order.FieldFinalPrice:
+		return m.FinalPrice()
+	case order.FieldInAmount:
+		return m.InAmount()
+	case order.FieldOutAmount:
+		return m.OutAmount()
+	case order.FieldStatus:
+		return m.Status()
+	case order.FieldNonce:
+		return m.Nonce()
+	case order.FieldTxHash:
+		return m.TxHash()
+	case order.FieldReason:
+		return m.Reason()
+	case order.FieldProfit:
+		return m.Profit()
+	case order.FieldPaper:
+		return m.Paper()
+	case order.FieldRawTx:
+		return m.RawTx()
+	case order.FieldReplacedTxHashes:
+		return m.ReplacedTxHashes()
+	case order.FieldReplaceCount:
+		return m.ReplaceCount()
+	case order.FieldReplaceTime:
+		return m.ReplaceTime()
+	case order.FieldCancelled:
+		return m.Cancelled()
+	case order.FieldRetryCount:
+		return m.RetryCount()
+	case order.FieldNextRetryTime:
+		return m.NextRetryTime()
+	case order.FieldRoute:
+		return m.Route()
+	case order.FieldRunnerUpRoute:
+		return m.RunnerUpRoute()
+	case order.FieldRouteSpreadUsd:
+		return m.RouteSpreadUsd()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OrderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case order.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case order.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case order.FieldAccount:
+		return m.OldAccount(ctx)
+	case order.FieldToken:
+		return m.OldToken(ctx)
+	case order.FieldSymbol:
+		return m.OldSymbol(ctx)
+	case order.FieldGridId:
+		return m.OldGridId(ctx)
+	case order.FieldGridNumber:
+		return m.OldGridNumber(ctx)
+	case order.FieldGridBuyCost:
+		return m.OldGridBuyCost(ctx)
+	case order.FieldStrategyId:
+		return m.OldStrategyId(ctx)
+	case order.FieldType:
+		return m.OldType(ctx)
+	case order.FieldPrice:
+		return m.OldPrice(ctx)
+	case order.FieldFinalPrice:
+		return m.OldFinalPrice(ctx)
+	case order.FieldInAmount:
+		return m.OldInAmount(ctx)
+	case order.FieldOutAmount:
+		return m.OldOutAmount(ctx)
+	case order.FieldStatus:
+		return m.OldStatus(ctx)
+	case order.FieldNonce:
+		return m.OldNonce(ctx)
+	case order.FieldTxHash:
+		return m.OldTxHash(ctx)
+	case order.FieldReason:
+		return m.OldReason(ctx)
+	case order.FieldProfit:
+		return m.OldProfit(ctx)
+	case order.FieldPaper:
+		return m.OldPaper(ctx)
+	case order.FieldRawTx:
+		return m.OldRawTx(ctx)
+	case order.FieldReplacedTxHashes:
+		return m.OldReplacedTxHashes(ctx)
+	case order.FieldReplaceCount:
+		return m.OldReplaceCount(ctx)
+	case order.FieldReplaceTime:
+		return m.OldReplaceTime(ctx)
+	case order.FieldCancelled:
+		return m.OldCancelled(ctx)
+	case order.FieldRetryCount:
+		return m.OldRetryCount(ctx)
+	case order.FieldNextRetryTime:
+		return m.OldNextRetryTime(ctx)
+	case order.FieldRoute:
+		return m.OldRoute(ctx)
+	case order.FieldRunnerUpRoute:
+		return m.OldRunnerUpRoute(ctx)
+	case order.FieldRouteSpreadUsd:
+		return m.OldRouteSpreadUsd(ctx)
+	}
+	return nil, fmt.Errorf("unknown Order field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrderMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case order.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case order.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case order.FieldAccount:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccount(v)
+		return nil
+	case order.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case order.FieldSymbol:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSymbol(v)
+		return nil
+	case order.FieldGridId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGridId(v)
+		return nil
+	case order.FieldGridNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGridNumber(v)
+		return nil
+	case order.FieldGridBuyCost:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGridBuyCost(v)
+		return nil
+	case order.FieldStrategyId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStrategyId(v)
+		return nil
+	case order.FieldType:
+		v, ok := value.(order.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case order.FieldPrice:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrice(v)
+		return nil
+	case order.FieldFinalPrice:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinalPrice(v)
+		return nil
+	case order.FieldInAmount:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInAmount(v)
+		return nil
+	case order.FieldOutAmount:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOutAmount(v)
+		return nil
+	case order.FieldStatus:
+		v, ok := value.(order.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case order.FieldNonce:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNonce(v)
+		return nil
+	case order.FieldTxHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTxHash(v)
+		return nil
+	case order.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case order.FieldProfit:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProfit(v)
+		return nil
+	case order.FieldPaper:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPaper(v)
+		return nil
+	case order.FieldRawTx:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRawTx(v)
+		return nil
+	case order.FieldReplacedTxHashes:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReplacedTxHashes(v)
+		return nil
+	case order.FieldReplaceCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReplaceCount(v)
+		return nil
+	case order.FieldReplaceTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReplaceTime(v)
+		return nil
+	case order.FieldCancelled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCancelled(v)
+		return nil
+	case order.FieldRetryCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetryCount(v)
+		return nil
+	case order.FieldNextRetryTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextRetryTime(v)
+		return nil
+	case order.FieldRoute:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoute(v)
+		return nil
+	case order.FieldRunnerUpRoute:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunnerUpRoute(v)
+		return nil
+	case order.FieldRouteSpreadUsd:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRouteSpreadUsd(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Order field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OrderMutation) AddedFields() []string {
+	var fields []string
+	if m.addgridNumber != nil {
+		fields = append(fields, order.FieldGridNumber)
+	}
+	if m.addnonce != nil {
+		fields = append(fields, order.FieldNonce)
+	}
+	if m.addreplaceCount != nil {
+		fields = append(fields, order.FieldReplaceCount)
+	}
+	if m.addretryCount != nil {
+		fields = append(fields, order.FieldRetryCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OrderMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case order.FieldGridNumber:
+		return m.AddedGridNumber()
+	case order.FieldNonce:
+		return m.AddedNonce()
+	case order.FieldReplaceCount:
+		return m.AddedReplaceCount()
+	case order.FieldRetryCount:
+		return m.AddedRetryCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrderMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case order.FieldGridNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGridNumber(v)
+		return nil
+	case order.FieldNonce:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNonce(v)
+		return nil
+	case order.FieldReplaceCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReplaceCount(v)
+		return nil
+	case order.FieldRetryCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRetryCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Order numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OrderMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(order.FieldGridId) {
+		fields = append(fields, order.FieldGridId)
+	}
+	if m.FieldCleared(order.FieldGridNumber) {
+		fields = append(fields, order.FieldGridNumber)
+	}
+	if m.FieldCleared(order.FieldGridBuyCost) {
+		fields = append(fields, order.FieldGridBuyCost)
+	}
+	if m.FieldCleared(order.FieldProfit) {
+		fields = append(fields, order.FieldProfit)
+	}
+	if m.FieldCleared(order.FieldPaper) {
+		fields = append(fields, order.FieldPaper)
+	}
+	if m.FieldCleared(order.FieldRawTx) {
+		fields = append(fields, order.FieldRawTx)
+	}
+	if m.FieldCleared(order.FieldReplacedTxHashes) {
+		fields = append(fields, order.FieldReplacedTxHashes)
+	}
+	if m.FieldCleared(order.FieldReplaceTime) {
+		fields = append(fields, order.FieldReplaceTime)
+	}
+	if m.FieldCleared(order.FieldNextRetryTime) {
+		fields = append(fields, order.FieldNextRetryTime)
+	}
+	if m.FieldCleared(order.FieldRoute) {
+		fields = append(fields, order.FieldRoute)
+	}
+	if m.FieldCleared(order.FieldRunnerUpRoute) {
+		fields = append(fields, order.FieldRunnerUpRoute)
+	}
+	if m.FieldCleared(order.FieldRouteSpreadUsd) {
+		fields = append(fields, order.FieldRouteSpreadUsd)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OrderMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OrderMutation) ClearField(name string) error {
+	switch name {
+	case order.FieldGridId:
+		m.ClearGridId()
+		return nil
+	case order.FieldGridNumber:
+		m.ClearGridNumber()
+		return nil
+	case order.FieldGridBuyCost:
+		m.ClearGridBuyCost()
+		return nil
+	case order.FieldProfit:
+		m.ClearProfit()
+		return nil
+	case order.FieldPaper:
+		m.ClearPaper()
+		return nil
+	case order.FieldRawTx:
+		m.ClearRawTx()
+		return nil
+	case order.FieldReplacedTxHashes:
+		m.ClearReplacedTxHashes()
+		return nil
+	case order.FieldReplaceTime:
+		m.ClearReplaceTime()
+		return nil
+	case order.FieldNextRetryTime:
+		m.ClearNextRetryTime()
+		return nil
+	case order.FieldRoute:
+		m.ClearRoute()
+		return nil
+	case order.FieldRunnerUpRoute:
+		m.ClearRunnerUpRoute()
+		return nil
+	case order.FieldRouteSpreadUsd:
+		m.ClearRouteSpreadUsd()
+		return nil
+	}
+	return fmt.Errorf("unknown Order nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OrderMutation) ResetField(name string) error {
+	switch name {
+	case order.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case order.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case order.FieldAccount:
+		m.ResetAccount()
+		return nil
+	case order.FieldToken:
+		m.ResetToken()
+		return nil
+	case order.FieldSymbol:
+		m.ResetSymbol()
+		return nil
+	case order.FieldGridId:
+		m.ResetGridId()
+		return nil
+	case order.FieldGridNumber:
+		m.ResetGridNumber()
+		return nil
+	case order.FieldGridBuyCost:
+		m.ResetGridBuyCost()
+		return nil
+	case order.FieldStrategyId:
+		m.ResetStrategyId()
+		return nil
+	case order.FieldType:
+		m.ResetType()
+		return nil
+	case order.FieldPrice:
+		m.ResetPrice()
+		return nil
+	case order.FieldFinalPrice:
+		m.ResetFinalPrice()
+		return nil
+	case order.FieldInAmount:
+		m.ResetInAmount()
+		return nil
+	case order.FieldOutAmount:
+		m.ResetOutAmount()
+		return nil
+	case order.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case order.FieldNonce:
+		m.ResetNonce()
+		return nil
+	case order.FieldTxHash:
+		m.ResetTxHash()
+		return nil
+	case order.FieldReason:
+		m.ResetReason()
+		return nil
+	case order.FieldProfit:
+		m.ResetProfit()
+		return nil
+	case order.FieldPaper:
+		m.ResetPaper()
+		return nil
+	case order.FieldRawTx:
+		m.ResetRawTx()
+		return nil
+	case order.FieldReplacedTxHashes:
+		m.ResetReplacedTxHashes()
+		return nil
+	case order.FieldReplaceCount:
+		m.ResetReplaceCount()
+		return nil
+	case order.FieldReplaceTime:
+		m.ResetReplaceTime()
+		return nil
+	case order.FieldCancelled:
+		m.ResetCancelled()
+		return nil
+	case order.FieldRetryCount:
+		m.ResetRetryCount()
+		return nil
+	case order.FieldNextRetryTime:
+		m.ResetNextRetryTime()
+		return nil
+	case order.FieldRoute:
+		m.ResetRoute()
+		return nil
+	case order.FieldRunnerUpRoute:
+		m.ResetRunnerUpRoute()
+		return nil
+	case order.FieldRouteSpreadUsd:
+		m.ResetRouteSpreadUsd()
+		return nil
+	}
+	return fmt.Errorf("unknown Order field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OrderMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OrderMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OrderMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OrderMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OrderMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OrderMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OrderMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Order unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OrderMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Order edge %s", name)
+}
+
+// SettingsMutation represents an operation that mutates the Settings nodes in the graph.
+type SettingsMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *int
+	create_time            *time.Time
+	update_time            *time.Time
+	userId                 *int64
+	adduserId              *int64
+	slippageBps            *int
+	addslippageBps         *int
+	sellSlippageBps        *int
+	addsellSlippageBps     *int
+	exitSlippageBps        *int
+	addexitSlippageBps     *int
+	dexAggregator          *settings.DexAggregator
+	enableInfiniteApproval *bool
+	clearedFields          map[string]struct{}
+	done                   bool
+	oldValue               func(context.Context) (*Settings, error)
+	predicates             []predicate.Settings
+}
+
+var _ ent.Mutation = (*SettingsMutation)(nil)
+
+// settingsOption allows management of the mutation configuration using functional options.
+type settingsOption func(*SettingsMutation)
+
+// newSettingsMutation creates new mutation for the Settings entity.
+func newSettingsMutation(c config, op Op, opts ...settingsOption) *SettingsMutation {
+	m := &SettingsMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSettings,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSettingsID sets the ID field of the mutation.
+func withSettingsID(id int) settingsOption {
+	return func(m *SettingsMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Settings
+		)
+		m.oldValue = func(ctx context.Context) (*Settings, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Settings.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSettings sets the old Settings of the mutation.
+func withSettings(node *Settings) settingsOption {
+	return func(m *SettingsMutation) {
+		m.oldValue = func(context.Context) (*Settings, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SettingsMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SettingsMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SettingsMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SettingsMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Settings.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *SettingsMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *SettingsMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *SettingsMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *SettingsMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *SettingsMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *SettingsMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetUserId sets the "userId" field.
+func (m *SettingsMutation) SetUserId(i int64) {
+	m.userId = &i
+	m.adduserId = nil
+}
+
+// UserId returns the value of the "userId" field in the mutation.
+func (m *SettingsMutation) UserId() (r int64, exists bool) {
+	v := m.userId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserId returns the old "userId" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldUserId(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserId: %w", err)
+	}
+	return oldValue.UserId, nil
+}
+
+// AddUserId adds i to the "userId" field.
+func (m *SettingsMutation) AddUserId(i int64) {
+	if m.adduserId != nil {
+		*m.adduserId += i
+	} else {
+		m.adduserId = &i
+	}
+}
+
+// AddedUserId returns the value that was added to the "userId" field in this mutation.
+func (m *SettingsMutation) AddedUserId() (r int64, exists bool) {
+	v := m.adduserId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUserId resets all changes to the "userId" field.
+func (m *SettingsMutation) ResetUserId() {
+	m.userId = nil
+	m.adduserId = nil
+}
+
+// SetSlippageBps sets the "slippageBps" field.
+func (m *SettingsMutation) SetSlippageBps(i int) {
+	m.slippageBps = &i
+	m.addslippageBps = nil
+}
+
+// SlippageBps returns the value of the "slippageBps" field in the mutation.
+func (m *SettingsMutation) SlippageBps() (r int, exists bool) {
+	v := m.slippageBps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSlippageBps returns the old "slippageBps" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldSlippageBps(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlippageBps is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlippageBps requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlippageBps: %w", err)
+	}
+	return oldValue.SlippageBps, nil
+}
+
+// AddSlippageBps adds i to the "slippageBps" field.
+func (m *SettingsMutation) AddSlippageBps(i int) {
+	if m.addslippageBps != nil {
+		*m.addslippageBps += i
+	} else {
+		m.addslippageBps = &i
+	}
+}
+
+// AddedSlippageBps returns the value that was added to the "slippageBps" field in this mutation.
+func (m *SettingsMutation) AddedSlippageBps() (r int, exists bool) {
+	v := m.addslippageBps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSlippageBps resets all changes to the "slippageBps" field.
+func (m *SettingsMutation) ResetSlippageBps() {
+	m.slippageBps = nil
+	m.addslippageBps = nil
+}
+
+// SetSellSlippageBps sets the "sellSlippageBps" field.
+func (m *SettingsMutation) SetSellSlippageBps(i int) {
+	m.sellSlippageBps = &i
+	m.addsellSlippageBps = nil
+}
+
+// SellSlippageBps returns the value of the "sellSlippageBps" field in the mutation.
+func (m *SettingsMutation) SellSlippageBps() (r int, exists bool) {
+	v := m.sellSlippageBps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSellSlippageBps returns the old "sellSlippageBps" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldSellSlippageBps(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSellSlippageBps is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSellSlippageBps requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSellSlippageBps: %w", err)
+	}
+	return oldValue.SellSlippageBps, nil
+}
+
+// AddSellSlippageBps adds i to the "sellSlippageBps" field.
+func (m *SettingsMutation) AddSellSlippageBps(i int) {
+	if m.addsellSlippageBps != nil {
+		*m.addsellSlippageBps += i
+	} else {
+		m.addsellSlippageBps = &i
+	}
+}
+
+// AddedSellSlippageBps returns the value that was added to the "sellSlippageBps" field in this mutation.
+func (m *SettingsMutation) AddedSellSlippageBps() (r int, exists bool) {
+	v := m.addsellSlippageBps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSellSlippageBps clears the value of the "sellSlippageBps" field.
+func (m *SettingsMutation) ClearSellSlippageBps() {
+	m.sellSlippageBps = nil
+	m.addsellSlippageBps = nil
+	m.clearedFields[settings.FieldSellSlippageBps] = struct{}{}
+}
+
+// SellSlippageBpsCleared returns if the "sellSlippageBps" field was cleared in this mutation.
+func (m *SettingsMutation) SellSlippageBpsCleared() bool {
+	_, ok := m.clearedFields[settings.FieldSellSlippageBps]
+	return ok
+}
+
+// ResetSellSlippageBps resets all changes to the "sellSlippageBps" field.
+func (m *SettingsMutation) ResetSellSlippageBps() {
+	m.sellSlippageBps = nil
+	m.addsellSlippageBps = nil
+	delete(m.clearedFields, settings.FieldSellSlippageBps)
+}
+
+// SetExitSlippageBps sets the "exitSlippageBps" field.
+func (m *SettingsMutation) SetExitSlippageBps(i int) {
+	m.exitSlippageBps = &i
+	m.addexitSlippageBps = nil
+}
+
+// ExitSlippageBps returns the value of the "exitSlippageBps" field in the mutation.
+func (m *SettingsMutation) ExitSlippageBps() (r int, exists bool) {
+	v := m.exitSlippageBps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExitSlippageBps returns the old "exitSlippageBps" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldExitSlippageBps(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExitSlippageBps is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExitSlippageBps requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExitSlippageBps: %w", err)
 	}
-	if m.finalPrice != nil {
-		fields = append(fields, order.FieldFinalPrice)
+	return oldValue.ExitSlippageBps, nil
+}
+
+// AddExitSlippageBps adds i to the "exitSlippageBps" field.
+func (m *SettingsMutation) AddExitSlippageBps(i int) {
+	if m.addexitSlippageBps != nil {
+		*m.addexitSlippageBps += i
+	} else {
+		m.addexitSlippageBps = &i
 	}
-	if m.inAmount != nil {
-		fields = append(fields, order.FieldInAmount)
+}
+
+// AddedExitSlippageBps returns the value that was added to the "exitSlippageBps" field in this mutation.
+func (m *SettingsMutation) AddedExitSlippageBps() (r int, exists bool) {
+	v := m.addexitSlippageBps
+	if v == nil {
+		return
 	}
-	if m.outAmount != nil {
-		fields = append(fields, order.FieldOutAmount)
+	return *v, true
+}
+
+// ClearExitSlippageBps clears the value of the "exitSlippageBps" field.
+func (m *SettingsMutation) ClearExitSlippageBps() {
+	m.exitSlippageBps = nil
+	m.addexitSlippageBps = nil
+	m.clearedFields[settings.FieldExitSlippageBps] = struct{}{}
+}
+
+// ExitSlippageBpsCleared returns if the "exitSlippageBps" field was cleared in this mutation.
+func (m *SettingsMutation) ExitSlippageBpsCleared() bool {
+	_, ok := m.clearedFields[settings.FieldExitSlippageBps]
+	return ok
+}
+
+// ResetExitSlippageBps resets all changes to the "exitSlippageBps" field.
+func (m *SettingsMutation) ResetExitSlippageBps() {
+	m.exitSlippageBps = nil
+	m.addexitSlippageBps = nil
+	delete(m.clearedFields, settings.FieldExitSlippageBps)
+}
+
+// SetDexAggregator sets the "dexAggregator" field.
+func (m *SettingsMutation) SetDexAggregator(sa settings.DexAggregator) {
+	m.dexAggregator = &sa
+}
+
+// DexAggregator returns the value of the "dexAggregator" field in the mutation.
+func (m *SettingsMutation) DexAggregator() (r settings.DexAggregator, exists bool) {
+	v := m.dexAggregator
+	if v == nil {
+		return
 	}
-	if m.status != nil {
-		fields = append(fields, order.FieldStatus)
+	return *v, true
+}
+
+// OldDexAggregator returns the old "dexAggregator" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldDexAggregator(ctx context.Context) (v settings.DexAggregator, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDexAggregator is only allowed on UpdateOne operations")
 	}
-	if m.nonce != nil {
-		fields = append(fields, order.FieldNonce)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDexAggregator requires an ID field in the mutation")
 	}
-	if m.txHash != nil {
-		fields = append(fields, order.FieldTxHash)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDexAggregator: %w", err)
 	}
-	if m.reason != nil {
-		fields = append(fields, order.FieldReason)
+	return oldValue.DexAggregator, nil
+}
+
+// ResetDexAggregator resets all changes to the "dexAggregator" field.
+func (m *SettingsMutation) ResetDexAggregator() {
+	m.dexAggregator = nil
+}
+
+// SetEnableInfiniteApproval sets the "enableInfiniteApproval" field.
+func (m *SettingsMutation) SetEnableInfiniteApproval(b bool) {
+	m.enableInfiniteApproval = &b
+}
+
+// EnableInfiniteApproval returns the value of the "enableInfiniteApproval" field in the mutation.
+func (m *SettingsMutation) EnableInfiniteApproval() (r bool, exists bool) {
+	v := m.enableInfiniteApproval
+	if v == nil {
+		return
 	}
-	if m.profit != nil {
-		fields = append(fields, order.FieldProfit)
+	return *v, true
+}
+
+// OldEnableInfiniteApproval returns the old "enableInfiniteApproval" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldEnableInfiniteApproval(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnableInfiniteApproval is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnableInfiniteApproval requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnableInfiniteApproval: %w", err)
+	}
+	return oldValue.EnableInfiniteApproval, nil
+}
+
+// ClearEnableInfiniteApproval clears the value of the "enableInfiniteApproval" field.
+func (m *SettingsMutation) ClearEnableInfiniteApproval() {
+	m.enableInfiniteApproval = nil
+	m.clearedFields[settings.FieldEnableInfiniteApproval] = struct{}{}
+}
+
+// EnableInfiniteApprovalCleared returns if the "enableInfiniteApproval" field was cleared in this mutation.
+func (m *SettingsMutation) EnableInfiniteApprovalCleared() bool {
+	_, ok := m.clearedFields[settings.FieldEnableInfiniteApproval]
+	return ok
+}
+
+// ResetEnableInfiniteApproval resets all changes to the "enableInfiniteApproval" field.
+func (m *SettingsMutation) ResetEnableInfiniteApproval() {
+	m.enableInfiniteApproval = nil
+	delete(m.clearedFields, settings.FieldEnableInfiniteApproval)
+}
+
+// Where appends a list predicates to the SettingsMutation builder.
+func (m *SettingsMutation) Where(ps ...predicate.Settings) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SettingsMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SettingsMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Settings, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SettingsMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SettingsMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Settings).
+func (m *SettingsMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SettingsMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.create_time != nil {
+		fields = append(fields, settings.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, settings.FieldUpdateTime)
+	}
+	if m.userId != nil {
+		fields = append(fields, settings.FieldUserId)
+	}
+	if m.slippageBps != nil {
+		fields = append(fields, settings.FieldSlippageBps)
+	}
+	if m.sellSlippageBps != nil {
+		fields = append(fields, settings.FieldSellSlippageBps)
+	}
+	if m.exitSlippageBps != nil {
+		fields = append(fields, settings.FieldExitSlippageBps)
+	}
+	if m.dexAggregator != nil {
+		fields = append(fields, settings.FieldDexAggregator)
+	}
+	if m.enableInfiniteApproval != nil {
+		fields = append(fields, settings.FieldEnableInfiniteApproval)
 	}
 	return fields
 }
@@ -2579,46 +4723,24 @@ func (m *OrderMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *OrderMutation) Field(name string) (ent.Value, bool) {
+func (m *SettingsMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case order.FieldCreateTime:
+	case settings.FieldCreateTime:
 		return m.CreateTime()
-	case order.FieldUpdateTime:
+	case settings.FieldUpdateTime:
 		return m.UpdateTime()
-	case order.FieldAccount:
-		return m.Account()
-	case order.FieldToken:
-		return m.Token()
-	case order.FieldSymbol:
-		return m.Symbol()
-	case order.FieldGridId:
-		return m.GridId()
-	case order.FieldGridNumber:
-		return m.GridNumber()
-	case order.FieldGridBuyCost:
-		return m.GridBuyCost()
-	case order.FieldStrategyId:
-		return m.StrategyId()
-	case order.FieldType:
-		return m.GetType()
-	case order.FieldPrice:
-		return m.Price()
-	case order.FieldFinalPrice:
-		return m.FinalPrice()
-	case order.FieldInAmount:
-		return m.InAmount()
-	case order.FieldOutAmount:
-		return m.OutAmount()
-	case order.FieldStatus:
-		return m.Status()
-	case order.FieldNonce:
-		return m.Nonce()
-	case order.FieldTxHash:
-		return m.TxHash()
-	case order.FieldReason:
-		return m.Reason()
-	case order.FieldProfit:
-		return m.Profit()
+	case settings.FieldUserId:
+		return m.UserId()
+	case settings.FieldSlippageBps:
+		return m.SlippageBps()
+	case settings.FieldSellSlippageBps:
+		return m.SellSlippageBps()
+	case settings.FieldExitSlippageBps:
+		return m.ExitSlippageBps()
+	case settings.FieldDexAggregator:
+		return m.DexAggregator()
+	case settings.FieldEnableInfiniteApproval:
+		return m.EnableInfiniteApproval()
 	}
 	return nil, false
 }
@@ -2626,201 +4748,108 @@ func (m *OrderMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *OrderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SettingsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case order.FieldCreateTime:
+	case settings.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case order.FieldUpdateTime:
+	case settings.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case order.FieldAccount:
-		return m.OldAccount(ctx)
-	case order.FieldToken:
-		return m.OldToken(ctx)
-	case order.FieldSymbol:
-		return m.OldSymbol(ctx)
-	case order.FieldGridId:
-		return m.OldGridId(ctx)
-	case order.FieldGridNumber:
-		return m.OldGridNumber(ctx)
-	case order.FieldGridBuyCost:
-		return m.OldGridBuyCost(ctx)
-	case order.FieldStrategyId:
-		return m.OldStrategyId(ctx)
-	case order.FieldType:
-		return m.OldType(ctx)
-	case order.FieldPrice:
-		return m.OldPrice(ctx)
-	case order.FieldFinalPrice:
-		return m.OldFinalPrice(ctx)
-	case order.FieldInAmount:
-		return m.OldInAmount(ctx)
-	case order.FieldOutAmount:
-		return m.OldOutAmount(ctx)
-	case order.FieldStatus:
-		return m.OldStatus(ctx)
-	case order.FieldNonce:
-		return m.OldNonce(ctx)
-	case order.FieldTxHash:
-		return m.OldTxHash(ctx)
-	case order.FieldReason:
-		return m.OldReason(ctx)
-	case order.FieldProfit:
-		return m.OldProfit(ctx)
+	case settings.FieldUserId:
+		return m.OldUserId(ctx)
+	case settings.FieldSlippageBps:
+		return m.OldSlippageBps(ctx)
+	case settings.FieldSellSlippageBps:
+		return m.OldSellSlippageBps(ctx)
+	case settings.FieldExitSlippageBps:
+		return m.OldExitSlippageBps(ctx)
+	case settings.FieldDexAggregator:
+		return m.OldDexAggregator(ctx)
+	case settings.FieldEnableInfiniteApproval:
+		return m.OldEnableInfiniteApproval(ctx)
 	}
-	return nil, fmt.Errorf("unknown Order field %s", name)
+	return nil, fmt.Errorf("unknown Settings field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OrderMutation) SetField(name string, value ent.Value) error {
+func (m *SettingsMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case order.FieldCreateTime:
+	case settings.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case order.FieldUpdateTime:
+	case settings.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case order.FieldAccount:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAccount(v)
-		return nil
-	case order.FieldToken:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetToken(v)
-		return nil
-	case order.FieldSymbol:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSymbol(v)
-		return nil
-	case order.FieldGridId:
-		v, ok := value.(string)
+	case settings.FieldUserId:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetGridId(v)
+		m.SetUserId(v)
 		return nil
-	case order.FieldGridNumber:
+	case settings.FieldSlippageBps:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetGridNumber(v)
-		return nil
-	case order.FieldGridBuyCost:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetGridBuyCost(v)
-		return nil
-	case order.FieldStrategyId:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStrategyId(v)
-		return nil
-	case order.FieldType:
-		v, ok := value.(order.Type)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetType(v)
-		return nil
-	case order.FieldPrice:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPrice(v)
-		return nil
-	case order.FieldFinalPrice:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFinalPrice(v)
-		return nil
-	case order.FieldInAmount:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetInAmount(v)
-		return nil
-	case order.FieldOutAmount:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOutAmount(v)
-		return nil
-	case order.FieldStatus:
-		v, ok := value.(order.Status)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
+		m.SetSlippageBps(v)
 		return nil
-	case order.FieldNonce:
-		v, ok := value.(uint64)
+	case settings.FieldSellSlippageBps:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNonce(v)
+		m.SetSellSlippageBps(v)
 		return nil
-	case order.FieldTxHash:
-		v, ok := value.(string)
+	case settings.FieldExitSlippageBps:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTxHash(v)
+		m.SetExitSlippageBps(v)
 		return nil
-	case order.FieldReason:
-		v, ok := value.(string)
+	case settings.FieldDexAggregator:
+		v, ok := value.(settings.DexAggregator)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReason(v)
+		m.SetDexAggregator(v)
 		return nil
-	case order.FieldProfit:
-		v, ok := value.(decimal.Decimal)
+	case settings.FieldEnableInfiniteApproval:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProfit(v)
+		m.SetEnableInfiniteApproval(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Order field %s", name)
+	return fmt.Errorf("unknown Settings field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *OrderMutation) AddedFields() []string {
+func (m *SettingsMutation) AddedFields() []string {
 	var fields []string
-	if m.addgridNumber != nil {
-		fields = append(fields, order.FieldGridNumber)
+	if m.adduserId != nil {
+		fields = append(fields, settings.FieldUserId)
 	}
-	if m.addnonce != nil {
-		fields = append(fields, order.FieldNonce)
+	if m.addslippageBps != nil {
+		fields = append(fields, settings.FieldSlippageBps)
+	}
+	if m.addsellSlippageBps != nil {
+		fields = append(fields, settings.FieldSellSlippageBps)
+	}
+	if m.addexitSlippageBps != nil {
+		fields = append(fields, settings.FieldExitSlippageBps)
 	}
 	return fields
 }
@@ -2828,12 +4857,16 @@ func (m *OrderMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *OrderMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SettingsMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case order.FieldGridNumber:
-		return m.AddedGridNumber()
-	case order.FieldNonce:
-		return m.AddedNonce()
+	case settings.FieldUserId:
+		return m.AddedUserId()
+	case settings.FieldSlippageBps:
+		return m.AddedSlippageBps()
+	case settings.FieldSellSlippageBps:
+		return m.AddedSellSlippageBps()
+	case settings.FieldExitSlippageBps:
+		return m.AddedExitSlippageBps()
 	}
 	return nil, false
 }
@@ -2841,220 +4874,245 @@ func (m *OrderMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OrderMutation) AddField(name string, value ent.Value) error {
+func (m *SettingsMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case order.FieldGridNumber:
+	case settings.FieldUserId:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserId(v)
+		return nil
+	case settings.FieldSlippageBps:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddGridNumber(v)
+		m.AddSlippageBps(v)
 		return nil
-	case order.FieldNonce:
-		v, ok := value.(int64)
+	case settings.FieldSellSlippageBps:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddNonce(v)
+		m.AddSellSlippageBps(v)
+		return nil
+	case settings.FieldExitSlippageBps:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExitSlippageBps(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Order numeric field %s", name)
+	return fmt.Errorf("unknown Settings numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *OrderMutation) ClearedFields() []string {
+func (m *SettingsMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(order.FieldGridId) {
-		fields = append(fields, order.FieldGridId)
-	}
-	if m.FieldCleared(order.FieldGridNumber) {
-		fields = append(fields, order.FieldGridNumber)
+	if m.FieldCleared(settings.FieldSellSlippageBps) {
+		fields = append(fields, settings.FieldSellSlippageBps)
 	}
-	if m.FieldCleared(order.FieldGridBuyCost) {
-		fields = append(fields, order.FieldGridBuyCost)
+	if m.FieldCleared(settings.FieldExitSlippageBps) {
+		fields = append(fields, settings.FieldExitSlippageBps)
 	}
-	if m.FieldCleared(order.FieldProfit) {
-		fields = append(fields, order.FieldProfit)
+	if m.FieldCleared(settings.FieldEnableInfiniteApproval) {
+		fields = append(fields, settings.FieldEnableInfiniteApproval)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *OrderMutation) FieldCleared(name string) bool {
+func (m *SettingsMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *OrderMutation) ClearField(name string) error {
+func (m *SettingsMutation) ClearField(name string) error {
 	switch name {
-	case order.FieldGridId:
-		m.ClearGridId()
-		return nil
-	case order.FieldGridNumber:
-		m.ClearGridNumber()
+	case settings.FieldSellSlippageBps:
+		m.ClearSellSlippageBps()
 		return nil
-	case order.FieldGridBuyCost:
-		m.ClearGridBuyCost()
+	case settings.FieldExitSlippageBps:
+		m.ClearExitSlippageBps()
 		return nil
-	case order.FieldProfit:
-		m.ClearProfit()
+	case settings.FieldEnableInfiniteApproval:
+		m.ClearEnableInfiniteApproval()
 		return nil
 	}
-	return fmt.Errorf("unknown Order nullable field %s", name)
+	return fmt.Errorf("unknown Settings nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *OrderMutation) ResetField(name string) error {
+func (m *SettingsMutation) ResetField(name string) error {
 	switch name {
-	case order.FieldCreateTime:
+	case settings.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case order.FieldUpdateTime:
+	case settings.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case order.FieldAccount:
-		m.ResetAccount()
-		return nil
-	case order.FieldToken:
-		m.ResetToken()
-		return nil
-	case order.FieldSymbol:
-		m.ResetSymbol()
-		return nil
-	case order.FieldGridId:
-		m.ResetGridId()
-		return nil
-	case order.FieldGridNumber:
-		m.ResetGridNumber()
-		return nil
-	case order.FieldGridBuyCost:
-		m.ResetGridBuyCost()
-		return nil
-	case order.FieldStrategyId:
-		m.ResetStrategyId()
-		return nil
-	case order.FieldType:
-		m.ResetType()
-		return nil
-	case order.FieldPrice:
-		m.ResetPrice()
-		return nil
-	case order.FieldFinalPrice:
-		m.ResetFinalPrice()
-		return nil
-	case order.FieldInAmount:
-		m.ResetInAmount()
-		return nil
-	case order.FieldOutAmount:
-		m.ResetOutAmount()
+	case settings.FieldUserId:
+		m.ResetUserId()
 		return nil
-	case order.FieldStatus:
-		m.ResetStatus()
+	case settings.FieldSlippageBps:
+		m.ResetSlippageBps()
 		return nil
-	case order.FieldNonce:
-		m.ResetNonce()
+	case settings.FieldSellSlippageBps:
+		m.ResetSellSlippageBps()
 		return nil
-	case order.FieldTxHash:
-		m.ResetTxHash()
+	case settings.FieldExitSlippageBps:
+		m.ResetExitSlippageBps()
 		return nil
-	case order.FieldReason:
-		m.ResetReason()
+	case settings.FieldDexAggregator:
+		m.ResetDexAggregator()
 		return nil
-	case order.FieldProfit:
-		m.ResetProfit()
+	case settings.FieldEnableInfiniteApproval:
+		m.ResetEnableInfiniteApproval()
 		return nil
 	}
-	return fmt.Errorf("unknown Order field %s", name)
+	return fmt.Errorf("unknown Settings field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *OrderMutation) AddedEdges() []string {
+func (m *SettingsMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *OrderMutation) AddedIDs(name string) []ent.Value {
+func (m *SettingsMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *OrderMutation) RemovedEdges() []string {
+func (m *SettingsMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *OrderMutation) RemovedIDs(name string) []ent.Value {
+func (m *SettingsMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *OrderMutation) ClearedEdges() []string {
+func (m *SettingsMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *OrderMutation) EdgeCleared(name string) bool {
+func (m *SettingsMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *OrderMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Order unique edge %s", name)
+func (m *SettingsMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Settings unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *OrderMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Order edge %s", name)
+func (m *SettingsMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Settings edge %s", name)
 }
 
-// SettingsMutation represents an operation that mutates the Settings nodes in the graph.
-type SettingsMutation struct {
+// StrategyMutation represents an operation that mutates the Strategy nodes in the graph.
+type StrategyMutation struct {
 	config
-	op                     Op
-	typ                    string
-	id                     *int
-	create_time            *time.Time
-	update_time            *time.Time
-	userId                 *int64
-	adduserId              *int64
-	slippageBps            *int
-	addslippageBps         *int
-	sellSlippageBps        *int
-	addsellSlippageBps     *int
-	exitSlippageBps        *int
-	addexitSlippageBps     *int
-	dexAggregator          *settings.DexAggregator
-	enableInfiniteApproval *bool
-	clearedFields          map[string]struct{}
-	done                   bool
-	oldValue               func(context.Context) (*Settings, error)
-	predicates             []predicate.Settings
+	op                          Op
+	typ                         string
+	id                          *int
+	create_time                 *time.Time
+	update_time                 *time.Time
+	guid                        *string
+	userId                      *int64
+	adduserId                   *int64
+	token                       *string
+	symbol                      *string
+	martinFactor                *float64
+	addmartinFactor             *float64
+	maxGridLimit                *int
+	addmaxGridLimit             *int
+	gridMode                    *strategy.GridMode
+	inventorySeeded             *bool
+	gridType                    *strategy.GridType
+	gridCount                   *int
+	addgridCount                *int
+	priceStep                   *decimal.Decimal
+	gridLadder                  *string
+	takeProfitRatio             *decimal.Decimal
+	trailingTakeProfit          *decimal.Decimal
+	takeProfitPortion           *decimal.Decimal
+	adaptiveSpacing             *bool
+	adaptiveMinRatio            *decimal.Decimal
+	adaptiveMaxRatio            *decimal.Decimal
+	adaptiveVolatility          *decimal.Decimal
+	adaptiveUpdateTime          *time.Time
+	upperPriceBound             *decimal.Decimal
+	lowerPriceBound             *decimal.Decimal
+	initialOrderSize            *decimal.Decimal
+	lastKlineVolume             *decimal.Decimal
+	fiveKlineVolume             *decimal.Decimal
+	firstOrderId                *int
+	addfirstOrderId             *int
+	upperBoundExit              *decimal.Decimal
+	stopLossExit                *decimal.Decimal
+	takeProfitExit              *decimal.Decimal
+	globalTakeProfitRatio       *decimal.Decimal
+	dynamicStopLoss             *bool
+	trailingUp                  *bool
+	trailingDown                *bool
+	trailingDwellMinutes        *int
+	addtrailingDwellMinutes     *int
+	dropOn                      *bool
+	candlesToCheck              *int
+	addcandlesToCheck           *int
+	dropThreshold               *decimal.Decimal
+	rsiThreshold                *decimal.Decimal
+	emaFilter                   *strategy.EmaFilter
+	emaPeriod                   *int
+	addemaPeriod                *int
+	macdFilter                  *bool
+	enableAutoBuy               *bool
+	enableAutoSell              *bool
+	enableAutoExit              *bool
+	enablePushNotification      *bool
+	paperTrading                *bool
+	status                      *strategy.Status
+	gridTrend                   *string
+	buyFilterReason             *string
+	lastLowerThresholdAlertTime *time.Time
+	lastUpperThresholdAlertTime *time.Time
+	outOfRangeTime              *time.Time
+	clearedFields               map[string]struct{}
+	done                        bool
+	oldValue                    func(context.Context) (*Strategy, error)
+	predicates                  []predicate.Strategy
 }
 
-var _ ent.Mutation = (*SettingsMutation)(nil)
+var _ ent.Mutation = (*StrategyMutation)(nil)
 
-// settingsOption allows management of the mutation configuration using functional options.
-type settingsOption func(*SettingsMutation)
+// strategyOption allows management of the mutation configuration using functional options.
+type strategyOption func(*StrategyMutation)
 
-// newSettingsMutation creates new mutation for the Settings entity.
-func newSettingsMutation(c config, op Op, opts ...settingsOption) *SettingsMutation {
-	m := &SettingsMutation{
+// newStrategyMutation creates new mutation for the Strategy entity.
+func newStrategyMutation(c config, op Op, opts ...strategyOption) *StrategyMutation {
+	m := &StrategyMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSettings,
+		typ:           TypeStrategy,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3063,20 +5121,20 @@ func newSettingsMutation(c config, op Op, opts ...settingsOption) *SettingsMutat
 	return m
 }
 
-// withSettingsID sets the ID field of the mutation.
-func withSettingsID(id int) settingsOption {
-	return func(m *SettingsMutation) {
+// withStrategyID sets the ID field of the mutation.
+func withStrategyID(id int) strategyOption {
+	return func(m *StrategyMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Settings
+			value *Strategy
 		)
-		m.oldValue = func(ctx context.Context) (*Settings, error) {
+		m.oldValue = func(ctx context.Context) (*Strategy, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Settings.Get(ctx, id)
+					value, err = m.Client().Strategy.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3085,10 +5143,10 @@ func withSettingsID(id int) settingsOption {
 	}
 }
 
-// withSettings sets the old Settings of the mutation.
-func withSettings(node *Settings) settingsOption {
-	return func(m *SettingsMutation) {
-		m.oldValue = func(context.Context) (*Settings, error) {
+// withStrategy sets the old Strategy of the mutation.
+func withStrategy(node *Strategy) strategyOption {
+	return func(m *StrategyMutation) {
+		m.oldValue = func(context.Context) (*Strategy, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3097,7 +5155,7 @@ func withSettings(node *Settings) settingsOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SettingsMutation) Client() *Client {
+func (m StrategyMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3105,7 +5163,7 @@ func (m SettingsMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SettingsMutation) Tx() (*Tx, error) {
+func (m StrategyMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3116,7 +5174,7 @@ func (m SettingsMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SettingsMutation) ID() (id int, exists bool) {
+func (m *StrategyMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3127,7 +5185,7 @@ func (m *SettingsMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SettingsMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *StrategyMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3136,19 +5194,19 @@ func (m *SettingsMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Settings.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Strategy.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *SettingsMutation) SetCreateTime(t time.Time) {
+func (m *StrategyMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *SettingsMutation) CreateTime() (r time.Time, exists bool) {
+func (m *StrategyMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -3156,10 +5214,10 @@ func (m *SettingsMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Settings entity.
-// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingsMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *StrategyMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -3174,1295 +5232,1002 @@ func (m *SettingsMutation) OldCreateTime(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *SettingsMutation) ResetCreateTime() {
+func (m *StrategyMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
-// SetUpdateTime sets the "update_time" field.
-func (m *SettingsMutation) SetUpdateTime(t time.Time) {
-	m.update_time = &t
-}
-
-// UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *SettingsMutation) UpdateTime() (r time.Time, exists bool) {
-	v := m.update_time
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldUpdateTime returns the old "update_time" field's value of the Settings entity.
-// If the Settings object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingsMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
-	}
-	return oldValue.UpdateTime, nil
-}
-
-// ResetUpdateTime resets all changes to the "update_time" field.
-func (m *SettingsMutation) ResetUpdateTime() {
-	m.update_time = nil
-}
-
-// SetUserId sets the "userId" field.
-func (m *SettingsMutation) SetUserId(i int64) {
-	m.userId = &i
-	m.adduserId = nil
-}
-
-// UserId returns the value of the "userId" field in the mutation.
-func (m *SettingsMutation) UserId() (r int64, exists bool) {
-	v := m.userId
+// SetUpdateTime sets the "update_time" field.
+func (m *StrategyMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *StrategyMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserId returns the old "userId" field's value of the Settings entity.
-// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingsMutation) OldUserId(ctx context.Context) (v int64, err error) {
+func (m *StrategyMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserId is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserId requires an ID field in the mutation")
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserId: %w", err)
-	}
-	return oldValue.UserId, nil
-}
-
-// AddUserId adds i to the "userId" field.
-func (m *SettingsMutation) AddUserId(i int64) {
-	if m.adduserId != nil {
-		*m.adduserId += i
-	} else {
-		m.adduserId = &i
-	}
-}
-
-// AddedUserId returns the value that was added to the "userId" field in this mutation.
-func (m *SettingsMutation) AddedUserId() (r int64, exists bool) {
-	v := m.adduserId
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
 	}
-	return *v, true
+	return oldValue.UpdateTime, nil
 }
 
-// ResetUserId resets all changes to the "userId" field.
-func (m *SettingsMutation) ResetUserId() {
-	m.userId = nil
-	m.adduserId = nil
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *StrategyMutation) ResetUpdateTime() {
+	m.update_time = nil
 }
 
-// SetSlippageBps sets the "slippageBps" field.
-func (m *SettingsMutation) SetSlippageBps(i int) {
-	m.slippageBps = &i
-	m.addslippageBps = nil
+// SetGUID sets the "guid" field.
+func (m *StrategyMutation) SetGUID(s string) {
+	m.guid = &s
 }
 
-// SlippageBps returns the value of the "slippageBps" field in the mutation.
-func (m *SettingsMutation) SlippageBps() (r int, exists bool) {
-	v := m.slippageBps
+// GUID returns the value of the "guid" field in the mutation.
+func (m *StrategyMutation) GUID() (r string, exists bool) {
+	v := m.guid
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSlippageBps returns the old "slippageBps" field's value of the Settings entity.
-// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// OldGUID returns the old "guid" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingsMutation) OldSlippageBps(ctx context.Context) (v int, err error) {
+func (m *StrategyMutation) OldGUID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSlippageBps is only allowed on UpdateOne operations")
+		return v, errors.New("OldGUID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSlippageBps requires an ID field in the mutation")
+		return v, errors.New("OldGUID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSlippageBps: %w", err)
-	}
-	return oldValue.SlippageBps, nil
-}
-
-// AddSlippageBps adds i to the "slippageBps" field.
-func (m *SettingsMutation) AddSlippageBps(i int) {
-	if m.addslippageBps != nil {
-		*m.addslippageBps += i
-	} else {
-		m.addslippageBps = &i
-	}
-}
-
-// AddedSlippageBps returns the value that was added to the "slippageBps" field in this mutation.
-func (m *SettingsMutation) AddedSlippageBps() (r int, exists bool) {
-	v := m.addslippageBps
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldGUID: %w", err)
 	}
-	return *v, true
+	return oldValue.GUID, nil
 }
 
-// ResetSlippageBps resets all changes to the "slippageBps" field.
-func (m *SettingsMutation) ResetSlippageBps() {
-	m.slippageBps = nil
-	m.addslippageBps = nil
+// ResetGUID resets all changes to the "guid" field.
+func (m *StrategyMutation) ResetGUID() {
+	m.guid = nil
 }
 
-// SetSellSlippageBps sets the "sellSlippageBps" field.
-func (m *SettingsMutation) SetSellSlippageBps(i int) {
-	m.sellSlippageBps = &i
-	m.addsellSlippageBps = nil
+// SetUserId sets the "userId" field.
+func (m *StrategyMutation) SetUserId(i int64) {
+	m.userId = &i
+	m.adduserId = nil
 }
 
-// SellSlippageBps returns the value of the "sellSlippageBps" field in the mutation.
-func (m *SettingsMutation) SellSlippageBps() (r int, exists bool) {
-	v := m.sellSlippageBps
+// UserId returns the value of the "userId" field in the mutation.
+func (m *StrategyMutation) UserId() (r int64, exists bool) {
+	v := m.userId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSellSlippageBps returns the old "sellSlippageBps" field's value of the Settings entity.
-// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// OldUserId returns the old "userId" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingsMutation) OldSellSlippageBps(ctx context.Context) (v *int, err error) {
+func (m *StrategyMutation) OldUserId(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSellSlippageBps is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSellSlippageBps requires an ID field in the mutation")
+		return v, errors.New("OldUserId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSellSlippageBps: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserId: %w", err)
 	}
-	return oldValue.SellSlippageBps, nil
+	return oldValue.UserId, nil
 }
 
-// AddSellSlippageBps adds i to the "sellSlippageBps" field.
-func (m *SettingsMutation) AddSellSlippageBps(i int) {
-	if m.addsellSlippageBps != nil {
-		*m.addsellSlippageBps += i
+// AddUserId adds i to the "userId" field.
+func (m *StrategyMutation) AddUserId(i int64) {
+	if m.adduserId != nil {
+		*m.adduserId += i
 	} else {
-		m.addsellSlippageBps = &i
+		m.adduserId = &i
 	}
 }
 
-// AddedSellSlippageBps returns the value that was added to the "sellSlippageBps" field in this mutation.
-func (m *SettingsMutation) AddedSellSlippageBps() (r int, exists bool) {
-	v := m.addsellSlippageBps
+// AddedUserId returns the value that was added to the "userId" field in this mutation.
+func (m *StrategyMutation) AddedUserId() (r int64, exists bool) {
+	v := m.adduserId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearSellSlippageBps clears the value of the "sellSlippageBps" field.
-func (m *SettingsMutation) ClearSellSlippageBps() {
-	m.sellSlippageBps = nil
-	m.addsellSlippageBps = nil
-	m.clearedFields[settings.FieldSellSlippageBps] = struct{}{}
-}
-
-// SellSlippageBpsCleared returns if the "sellSlippageBps" field was cleared in this mutation.
-func (m *SettingsMutation) SellSlippageBpsCleared() bool {
-	_, ok := m.clearedFields[settings.FieldSellSlippageBps]
-	return ok
-}
-
-// ResetSellSlippageBps resets all changes to the "sellSlippageBps" field.
-func (m *SettingsMutation) ResetSellSlippageBps() {
-	m.sellSlippageBps = nil
-	m.addsellSlippageBps = nil
-	delete(m.clearedFields, settings.FieldSellSlippageBps)
+// ResetUserId resets all changes to the "userId" field.
+func (m *StrategyMutation) ResetUserId() {
+	m.userId = nil
+	m.adduserId = nil
 }
 
-// SetExitSlippageBps sets the "exitSlippageBps" field.
-func (m *SettingsMutation) SetExitSlippageBps(i int) {
-	m.exitSlippageBps = &i
-	m.addexitSlippageBps = nil
+// SetToken sets the "token" field.
+func (m *StrategyMutation) SetToken(s string) {
+	m.token = &s
 }
 
-// ExitSlippageBps returns the value of the "exitSlippageBps" field in the mutation.
-func (m *SettingsMutation) ExitSlippageBps() (r int, exists bool) {
-	v := m.exitSlippageBps
+// Token returns the value of the "token" field in the mutation.
+func (m *StrategyMutation) Token() (r string, exists bool) {
+	v := m.token
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExitSlippageBps returns the old "exitSlippageBps" field's value of the Settings entity.
-// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// OldToken returns the old "token" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingsMutation) OldExitSlippageBps(ctx context.Context) (v *int, err error) {
+func (m *StrategyMutation) OldToken(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExitSlippageBps is only allowed on UpdateOne operations")
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExitSlippageBps requires an ID field in the mutation")
+		return v, errors.New("OldToken requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExitSlippageBps: %w", err)
-	}
-	return oldValue.ExitSlippageBps, nil
-}
-
-// AddExitSlippageBps adds i to the "exitSlippageBps" field.
-func (m *SettingsMutation) AddExitSlippageBps(i int) {
-	if m.addexitSlippageBps != nil {
-		*m.addexitSlippageBps += i
-	} else {
-		m.addexitSlippageBps = &i
-	}
-}
-
-// AddedExitSlippageBps returns the value that was added to the "exitSlippageBps" field in this mutation.
-func (m *SettingsMutation) AddedExitSlippageBps() (r int, exists bool) {
-	v := m.addexitSlippageBps
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
 	}
-	return *v, true
-}
-
-// ClearExitSlippageBps clears the value of the "exitSlippageBps" field.
-func (m *SettingsMutation) ClearExitSlippageBps() {
-	m.exitSlippageBps = nil
-	m.addexitSlippageBps = nil
-	m.clearedFields[settings.FieldExitSlippageBps] = struct{}{}
-}
-
-// ExitSlippageBpsCleared returns if the "exitSlippageBps" field was cleared in this mutation.
-func (m *SettingsMutation) ExitSlippageBpsCleared() bool {
-	_, ok := m.clearedFields[settings.FieldExitSlippageBps]
-	return ok
+	return oldValue.Token, nil
 }
 
-// ResetExitSlippageBps resets all changes to the "exitSlippageBps" field.
-func (m *SettingsMutation) ResetExitSlippageBps() {
-	m.exitSlippageBps = nil
-	m.addexitSlippageBps = nil
-	delete(m.clearedFields, settings.FieldExitSlippageBps)
+// ResetToken resets all changes to the "token" field.
+func (m *StrategyMutation) ResetToken() {
+	m.token = nil
 }
 
-// SetDexAggregator sets the "dexAggregator" field.
-func (m *SettingsMutation) SetDexAggregator(sa settings.DexAggregator) {
-	m.dexAggregator = &sa
+// SetSymbol sets the "symbol" field.
+func (m *StrategyMutation) SetSymbol(s string) {
+	m.symbol = &s
 }
 
-// DexAggregator returns the value of the "dexAggregator" field in the mutation.
-func (m *SettingsMutation) DexAggregator() (r settings.DexAggregator, exists bool) {
-	v := m.dexAggregator
+// Symbol returns the value of the "symbol" field in the mutation.
+func (m *StrategyMutation) Symbol() (r string, exists bool) {
+	v := m.symbol
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDexAggregator returns the old "dexAggregator" field's value of the Settings entity.
-// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// OldSymbol returns the old "symbol" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingsMutation) OldDexAggregator(ctx context.Context) (v settings.DexAggregator, err error) {
+func (m *StrategyMutation) OldSymbol(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDexAggregator is only allowed on UpdateOne operations")
+		return v, errors.New("OldSymbol is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDexAggregator requires an ID field in the mutation")
+		return v, errors.New("OldSymbol requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDexAggregator: %w", err)
+		return v, fmt.Errorf("querying old value for OldSymbol: %w", err)
 	}
-	return oldValue.DexAggregator, nil
+	return oldValue.Symbol, nil
 }
 
-// ResetDexAggregator resets all changes to the "dexAggregator" field.
-func (m *SettingsMutation) ResetDexAggregator() {
-	m.dexAggregator = nil
+// ResetSymbol resets all changes to the "symbol" field.
+func (m *StrategyMutation) ResetSymbol() {
+	m.symbol = nil
 }
 
-// SetEnableInfiniteApproval sets the "enableInfiniteApproval" field.
-func (m *SettingsMutation) SetEnableInfiniteApproval(b bool) {
-	m.enableInfiniteApproval = &b
+// SetMartinFactor sets the "martinFactor" field.
+func (m *StrategyMutation) SetMartinFactor(f float64) {
+	m.martinFactor = &f
+	m.addmartinFactor = nil
 }
 
-// EnableInfiniteApproval returns the value of the "enableInfiniteApproval" field in the mutation.
-func (m *SettingsMutation) EnableInfiniteApproval() (r bool, exists bool) {
-	v := m.enableInfiniteApproval
+// MartinFactor returns the value of the "martinFactor" field in the mutation.
+func (m *StrategyMutation) MartinFactor() (r float64, exists bool) {
+	v := m.martinFactor
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEnableInfiniteApproval returns the old "enableInfiniteApproval" field's value of the Settings entity.
-// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// OldMartinFactor returns the old "martinFactor" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingsMutation) OldEnableInfiniteApproval(ctx context.Context) (v *bool, err error) {
+func (m *StrategyMutation) OldMartinFactor(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnableInfiniteApproval is only allowed on UpdateOne operations")
+		return v, errors.New("OldMartinFactor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnableInfiniteApproval requires an ID field in the mutation")
+		return v, errors.New("OldMartinFactor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnableInfiniteApproval: %w", err)
+		return v, fmt.Errorf("querying old value for OldMartinFactor: %w", err)
 	}
-	return oldValue.EnableInfiniteApproval, nil
-}
-
-// ClearEnableInfiniteApproval clears the value of the "enableInfiniteApproval" field.
-func (m *SettingsMutation) ClearEnableInfiniteApproval() {
-	m.enableInfiniteApproval = nil
-	m.clearedFields[settings.FieldEnableInfiniteApproval] = struct{}{}
-}
-
-// EnableInfiniteApprovalCleared returns if the "enableInfiniteApproval" field was cleared in this mutation.
-func (m *SettingsMutation) EnableInfiniteApprovalCleared() bool {
-	_, ok := m.clearedFields[settings.FieldEnableInfiniteApproval]
-	return ok
-}
-
-// ResetEnableInfiniteApproval resets all changes to the "enableInfiniteApproval" field.
-func (m *SettingsMutation) ResetEnableInfiniteApproval() {
-	m.enableInfiniteApproval = nil
-	delete(m.clearedFields, settings.FieldEnableInfiniteApproval)
-}
-
-// Where appends a list predicates to the SettingsMutation builder.
-func (m *SettingsMutation) Where(ps ...predicate.Settings) {
-	m.predicates = append(m.predicates, ps...)
+	return oldValue.MartinFactor, nil
 }
 
-// WhereP appends storage-level predicates to the SettingsMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SettingsMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Settings, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// AddMartinFactor adds f to the "martinFactor" field.
+func (m *StrategyMutation) AddMartinFactor(f float64) {
+	if m.addmartinFactor != nil {
+		*m.addmartinFactor += f
+	} else {
+		m.addmartinFactor = &f
 	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *SettingsMutation) Op() Op {
-	return m.op
-}
-
-// SetOp allows setting the mutation operation.
-func (m *SettingsMutation) SetOp(op Op) {
-	m.op = op
-}
-
-// Type returns the node type of this mutation (Settings).
-func (m *SettingsMutation) Type() string {
-	return m.typ
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *SettingsMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.create_time != nil {
-		fields = append(fields, settings.FieldCreateTime)
-	}
-	if m.update_time != nil {
-		fields = append(fields, settings.FieldUpdateTime)
-	}
-	if m.userId != nil {
-		fields = append(fields, settings.FieldUserId)
-	}
-	if m.slippageBps != nil {
-		fields = append(fields, settings.FieldSlippageBps)
-	}
-	if m.sellSlippageBps != nil {
-		fields = append(fields, settings.FieldSellSlippageBps)
-	}
-	if m.exitSlippageBps != nil {
-		fields = append(fields, settings.FieldExitSlippageBps)
-	}
-	if m.dexAggregator != nil {
-		fields = append(fields, settings.FieldDexAggregator)
-	}
-	if m.enableInfiniteApproval != nil {
-		fields = append(fields, settings.FieldEnableInfiniteApproval)
+// AddedMartinFactor returns the value that was added to the "martinFactor" field in this mutation.
+func (m *StrategyMutation) AddedMartinFactor() (r float64, exists bool) {
+	v := m.addmartinFactor
+	if v == nil {
+		return
 	}
-	return fields
+	return *v, true
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *SettingsMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case settings.FieldCreateTime:
-		return m.CreateTime()
-	case settings.FieldUpdateTime:
-		return m.UpdateTime()
-	case settings.FieldUserId:
-		return m.UserId()
-	case settings.FieldSlippageBps:
-		return m.SlippageBps()
-	case settings.FieldSellSlippageBps:
-		return m.SellSlippageBps()
-	case settings.FieldExitSlippageBps:
-		return m.ExitSlippageBps()
-	case settings.FieldDexAggregator:
-		return m.DexAggregator()
-	case settings.FieldEnableInfiniteApproval:
-		return m.EnableInfiniteApproval()
-	}
-	return nil, false
+// ResetMartinFactor resets all changes to the "martinFactor" field.
+func (m *StrategyMutation) ResetMartinFactor() {
+	m.martinFactor = nil
+	m.addmartinFactor = nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *SettingsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case settings.FieldCreateTime:
-		return m.OldCreateTime(ctx)
-	case settings.FieldUpdateTime:
-		return m.OldUpdateTime(ctx)
-	case settings.FieldUserId:
-		return m.OldUserId(ctx)
-	case settings.FieldSlippageBps:
-		return m.OldSlippageBps(ctx)
-	case settings.FieldSellSlippageBps:
-		return m.OldSellSlippageBps(ctx)
-	case settings.FieldExitSlippageBps:
-		return m.OldExitSlippageBps(ctx)
-	case settings.FieldDexAggregator:
-		return m.OldDexAggregator(ctx)
-	case settings.FieldEnableInfiniteApproval:
-		return m.OldEnableInfiniteApproval(ctx)
-	}
-	return nil, fmt.Errorf("unknown Settings field %s", name)
+// SetMaxGridLimit sets the "maxGridLimit" field.
+func (m *StrategyMutation) SetMaxGridLimit(i int) {
+	m.maxGridLimit = &i
+	m.addmaxGridLimit = nil
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *SettingsMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case settings.FieldCreateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreateTime(v)
-		return nil
-	case settings.FieldUpdateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdateTime(v)
-		return nil
-	case settings.FieldUserId:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUserId(v)
-		return nil
-	case settings.FieldSlippageBps:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSlippageBps(v)
-		return nil
-	case settings.FieldSellSlippageBps:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSellSlippageBps(v)
-		return nil
-	case settings.FieldExitSlippageBps:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetExitSlippageBps(v)
-		return nil
-	case settings.FieldDexAggregator:
-		v, ok := value.(settings.DexAggregator)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDexAggregator(v)
-		return nil
-	case settings.FieldEnableInfiniteApproval:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEnableInfiniteApproval(v)
-		return nil
+// MaxGridLimit returns the value of the "maxGridLimit" field in the mutation.
+func (m *StrategyMutation) MaxGridLimit() (r int, exists bool) {
+	v := m.maxGridLimit
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Settings field %s", name)
+	return *v, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *SettingsMutation) AddedFields() []string {
-	var fields []string
-	if m.adduserId != nil {
-		fields = append(fields, settings.FieldUserId)
-	}
-	if m.addslippageBps != nil {
-		fields = append(fields, settings.FieldSlippageBps)
+// OldMaxGridLimit returns the old "maxGridLimit" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldMaxGridLimit(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxGridLimit is only allowed on UpdateOne operations")
 	}
-	if m.addsellSlippageBps != nil {
-		fields = append(fields, settings.FieldSellSlippageBps)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxGridLimit requires an ID field in the mutation")
 	}
-	if m.addexitSlippageBps != nil {
-		fields = append(fields, settings.FieldExitSlippageBps)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxGridLimit: %w", err)
 	}
-	return fields
+	return oldValue.MaxGridLimit, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *SettingsMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case settings.FieldUserId:
-		return m.AddedUserId()
-	case settings.FieldSlippageBps:
-		return m.AddedSlippageBps()
-	case settings.FieldSellSlippageBps:
-		return m.AddedSellSlippageBps()
-	case settings.FieldExitSlippageBps:
-		return m.AddedExitSlippageBps()
+// AddMaxGridLimit adds i to the "maxGridLimit" field.
+func (m *StrategyMutation) AddMaxGridLimit(i int) {
+	if m.addmaxGridLimit != nil {
+		*m.addmaxGridLimit += i
+	} else {
+		m.addmaxGridLimit = &i
 	}
-	return nil, false
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *SettingsMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case settings.FieldUserId:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddUserId(v)
-		return nil
-	case settings.FieldSlippageBps:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSlippageBps(v)
-		return nil
-	case settings.FieldSellSlippageBps:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSellSlippageBps(v)
-		return nil
-	case settings.FieldExitSlippageBps:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddExitSlippageBps(v)
-		return nil
+// AddedMaxGridLimit returns the value that was added to the "maxGridLimit" field in this mutation.
+func (m *StrategyMutation) AddedMaxGridLimit() (r int, exists bool) {
+	v := m.addmaxGridLimit
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Settings numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *SettingsMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(settings.FieldSellSlippageBps) {
-		fields = append(fields, settings.FieldSellSlippageBps)
-	}
-	if m.FieldCleared(settings.FieldExitSlippageBps) {
-		fields = append(fields, settings.FieldExitSlippageBps)
-	}
-	if m.FieldCleared(settings.FieldEnableInfiniteApproval) {
-		fields = append(fields, settings.FieldEnableInfiniteApproval)
-	}
-	return fields
+// ClearMaxGridLimit clears the value of the "maxGridLimit" field.
+func (m *StrategyMutation) ClearMaxGridLimit() {
+	m.maxGridLimit = nil
+	m.addmaxGridLimit = nil
+	m.clearedFields[strategy.FieldMaxGridLimit] = struct{}{}
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *SettingsMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// MaxGridLimitCleared returns if the "maxGridLimit" field was cleared in this mutation.
+func (m *StrategyMutation) MaxGridLimitCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldMaxGridLimit]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *SettingsMutation) ClearField(name string) error {
-	switch name {
-	case settings.FieldSellSlippageBps:
-		m.ClearSellSlippageBps()
-		return nil
-	case settings.FieldExitSlippageBps:
-		m.ClearExitSlippageBps()
-		return nil
-	case settings.FieldEnableInfiniteApproval:
-		m.ClearEnableInfiniteApproval()
-		return nil
-	}
-	return fmt.Errorf("unknown Settings nullable field %s", name)
+// ResetMaxGridLimit resets all changes to the "maxGridLimit" field.
+func (m *StrategyMutation) ResetMaxGridLimit() {
+	m.maxGridLimit = nil
+	m.addmaxGridLimit = nil
+	delete(m.clearedFields, strategy.FieldMaxGridLimit)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *SettingsMutation) ResetField(name string) error {
-	switch name {
-	case settings.FieldCreateTime:
-		m.ResetCreateTime()
-		return nil
-	case settings.FieldUpdateTime:
-		m.ResetUpdateTime()
-		return nil
-	case settings.FieldUserId:
-		m.ResetUserId()
-		return nil
-	case settings.FieldSlippageBps:
-		m.ResetSlippageBps()
-		return nil
-	case settings.FieldSellSlippageBps:
-		m.ResetSellSlippageBps()
-		return nil
-	case settings.FieldExitSlippageBps:
-		m.ResetExitSlippageBps()
-		return nil
-	case settings.FieldDexAggregator:
-		m.ResetDexAggregator()
-		return nil
-	case settings.FieldEnableInfiniteApproval:
-		m.ResetEnableInfiniteApproval()
-		return nil
-	}
-	return fmt.Errorf("unknown Settings field %s", name)
+// SetGridMode sets the "gridMode" field.
+func (m *StrategyMutation) SetGridMode(sm strategy.GridMode) {
+	m.gridMode = &sm
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SettingsMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// GridMode returns the value of the "gridMode" field in the mutation.
+func (m *StrategyMutation) GridMode() (r strategy.GridMode, exists bool) {
+	v := m.gridMode
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *SettingsMutation) AddedIDs(name string) []ent.Value {
-	return nil
+// OldGridMode returns the old "gridMode" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldGridMode(ctx context.Context) (v strategy.GridMode, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGridMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGridMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGridMode: %w", err)
+	}
+	return oldValue.GridMode, nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SettingsMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// ResetGridMode resets all changes to the "gridMode" field.
+func (m *StrategyMutation) ResetGridMode() {
+	m.gridMode = nil
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *SettingsMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// SetInventorySeeded sets the "inventorySeeded" field.
+func (m *StrategyMutation) SetInventorySeeded(b bool) {
+	m.inventorySeeded = &b
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SettingsMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// InventorySeeded returns the value of the "inventorySeeded" field in the mutation.
+func (m *StrategyMutation) InventorySeeded() (r bool, exists bool) {
+	v := m.inventorySeeded
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *SettingsMutation) EdgeCleared(name string) bool {
-	return false
+// OldInventorySeeded returns the old "inventorySeeded" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldInventorySeeded(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInventorySeeded is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInventorySeeded requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInventorySeeded: %w", err)
+	}
+	return oldValue.InventorySeeded, nil
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *SettingsMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Settings unique edge %s", name)
+// ClearInventorySeeded clears the value of the "inventorySeeded" field.
+func (m *StrategyMutation) ClearInventorySeeded() {
+	m.inventorySeeded = nil
+	m.clearedFields[strategy.FieldInventorySeeded] = struct{}{}
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *SettingsMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Settings edge %s", name)
+// InventorySeededCleared returns if the "inventorySeeded" field was cleared in this mutation.
+func (m *StrategyMutation) InventorySeededCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldInventorySeeded]
+	return ok
 }
 
-// StrategyMutation represents an operation that mutates the Strategy nodes in the graph.
-type StrategyMutation struct {
-	config
-	op                          Op
-	typ                         string
-	id                          *int
-	create_time                 *time.Time
-	update_time                 *time.Time
-	guid                        *string
-	userId                      *int64
-	adduserId                   *int64
-	token                       *string
-	symbol                      *string
-	martinFactor                *float64
-	addmartinFactor             *float64
-	maxGridLimit                *int
-	addmaxGridLimit             *int
-	takeProfitRatio             *decimal.Decimal
-	upperPriceBound             *decimal.Decimal
-	lowerPriceBound             *decimal.Decimal
-	initialOrderSize            *decimal.Decimal
-	lastKlineVolume             *decimal.Decimal
-	fiveKlineVolume             *decimal.Decimal
-	firstOrderId                *int
-	addfirstOrderId             *int
-	upperBoundExit              *decimal.Decimal
-	stopLossExit                *decimal.Decimal
-	takeProfitExit              *decimal.Decimal
-	globalTakeProfitRatio       *decimal.Decimal
-	dynamicStopLoss             *bool
-	dropOn                      *bool
-	candlesToCheck              *int
-	addcandlesToCheck           *int
-	dropThreshold               *decimal.Decimal
-	enableAutoBuy               *bool
-	enableAutoSell              *bool
-	enableAutoExit              *bool
-	enablePushNotification      *bool
-	status                      *strategy.Status
-	gridTrend                   *string
-	lastLowerThresholdAlertTime *time.Time
-	lastUpperThresholdAlertTime *time.Time
-	clearedFields               map[string]struct{}
-	done                        bool
-	oldValue                    func(context.Context) (*Strategy, error)
-	predicates                  []predicate.Strategy
+// ResetInventorySeeded resets all changes to the "inventorySeeded" field.
+func (m *StrategyMutation) ResetInventorySeeded() {
+	m.inventorySeeded = nil
+	delete(m.clearedFields, strategy.FieldInventorySeeded)
 }
 
-var _ ent.Mutation = (*StrategyMutation)(nil)
+// SetGridType sets the "gridType" field.
+func (m *StrategyMutation) SetGridType(st strategy.GridType) {
+	m.gridType = &st
+}
 
-// strategyOption allows management of the mutation configuration using functional options.
-type strategyOption func(*StrategyMutation)
+// GridType returns the value of the "gridType" field in the mutation.
+func (m *StrategyMutation) GridType() (r strategy.GridType, exists bool) {
+	v := m.gridType
+	if v == nil {
+		return
+	}
+	return *v, true
+}
 
-// newStrategyMutation creates new mutation for the Strategy entity.
-func newStrategyMutation(c config, op Op, opts ...strategyOption) *StrategyMutation {
-	m := &StrategyMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeStrategy,
-		clearedFields: make(map[string]struct{}),
+// OldGridType returns the old "gridType" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldGridType(ctx context.Context) (v strategy.GridType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGridType is only allowed on UpdateOne operations")
 	}
-	for _, opt := range opts {
-		opt(m)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGridType requires an ID field in the mutation")
 	}
-	return m
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGridType: %w", err)
+	}
+	return oldValue.GridType, nil
 }
 
-// withStrategyID sets the ID field of the mutation.
-func withStrategyID(id int) strategyOption {
-	return func(m *StrategyMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Strategy
-		)
-		m.oldValue = func(ctx context.Context) (*Strategy, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Strategy.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
+// ResetGridType resets all changes to the "gridType" field.
+func (m *StrategyMutation) ResetGridType() {
+	m.gridType = nil
 }
 
-// withStrategy sets the old Strategy of the mutation.
-func withStrategy(node *Strategy) strategyOption {
-	return func(m *StrategyMutation) {
-		m.oldValue = func(context.Context) (*Strategy, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// SetGridCount sets the "gridCount" field.
+func (m *StrategyMutation) SetGridCount(i int) {
+	m.gridCount = &i
+	m.addgridCount = nil
+}
+
+// GridCount returns the value of the "gridCount" field in the mutation.
+func (m *StrategyMutation) GridCount() (r int, exists bool) {
+	v := m.gridCount
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m StrategyMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// OldGridCount returns the old "gridCount" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldGridCount(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGridCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGridCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGridCount: %w", err)
+	}
+	return oldValue.GridCount, nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m StrategyMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// AddGridCount adds i to the "gridCount" field.
+func (m *StrategyMutation) AddGridCount(i int) {
+	if m.addgridCount != nil {
+		*m.addgridCount += i
+	} else {
+		m.addgridCount = &i
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *StrategyMutation) ID() (id int, exists bool) {
-	if m.id == nil {
+// AddedGridCount returns the value that was added to the "gridCount" field in this mutation.
+func (m *StrategyMutation) AddedGridCount() (r int, exists bool) {
+	v := m.addgridCount
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *StrategyMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Strategy.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ClearGridCount clears the value of the "gridCount" field.
+func (m *StrategyMutation) ClearGridCount() {
+	m.gridCount = nil
+	m.addgridCount = nil
+	m.clearedFields[strategy.FieldGridCount] = struct{}{}
 }
 
-// SetCreateTime sets the "create_time" field.
-func (m *StrategyMutation) SetCreateTime(t time.Time) {
-	m.create_time = &t
+// GridCountCleared returns if the "gridCount" field was cleared in this mutation.
+func (m *StrategyMutation) GridCountCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldGridCount]
+	return ok
 }
 
-// CreateTime returns the value of the "create_time" field in the mutation.
-func (m *StrategyMutation) CreateTime() (r time.Time, exists bool) {
-	v := m.create_time
+// ResetGridCount resets all changes to the "gridCount" field.
+func (m *StrategyMutation) ResetGridCount() {
+	m.gridCount = nil
+	m.addgridCount = nil
+	delete(m.clearedFields, strategy.FieldGridCount)
+}
+
+// SetPriceStep sets the "priceStep" field.
+func (m *StrategyMutation) SetPriceStep(d decimal.Decimal) {
+	m.priceStep = &d
+}
+
+// PriceStep returns the value of the "priceStep" field in the mutation.
+func (m *StrategyMutation) PriceStep() (r decimal.Decimal, exists bool) {
+	v := m.priceStep
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Strategy entity.
+// OldPriceStep returns the old "priceStep" field's value of the Strategy entity.
 // If the Strategy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *StrategyMutation) OldPriceStep(ctx context.Context) (v *decimal.Decimal, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldPriceStep is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+		return v, errors.New("OldPriceStep requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldPriceStep: %w", err)
 	}
-	return oldValue.CreateTime, nil
+	return oldValue.PriceStep, nil
 }
 
-// ResetCreateTime resets all changes to the "create_time" field.
-func (m *StrategyMutation) ResetCreateTime() {
-	m.create_time = nil
+// ClearPriceStep clears the value of the "priceStep" field.
+func (m *StrategyMutation) ClearPriceStep() {
+	m.priceStep = nil
+	m.clearedFields[strategy.FieldPriceStep] = struct{}{}
 }
 
-// SetUpdateTime sets the "update_time" field.
-func (m *StrategyMutation) SetUpdateTime(t time.Time) {
-	m.update_time = &t
+// PriceStepCleared returns if the "priceStep" field was cleared in this mutation.
+func (m *StrategyMutation) PriceStepCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldPriceStep]
+	return ok
 }
 
-// UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *StrategyMutation) UpdateTime() (r time.Time, exists bool) {
-	v := m.update_time
+// ResetPriceStep resets all changes to the "priceStep" field.
+func (m *StrategyMutation) ResetPriceStep() {
+	m.priceStep = nil
+	delete(m.clearedFields, strategy.FieldPriceStep)
+}
+
+// SetGridLadder sets the "gridLadder" field.
+func (m *StrategyMutation) SetGridLadder(s string) {
+	m.gridLadder = &s
+}
+
+// GridLadder returns the value of the "gridLadder" field in the mutation.
+func (m *StrategyMutation) GridLadder() (r string, exists bool) {
+	v := m.gridLadder
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Strategy entity.
+// OldGridLadder returns the old "gridLadder" field's value of the Strategy entity.
 // If the Strategy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *StrategyMutation) OldGridLadder(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldGridLadder is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+		return v, errors.New("OldGridLadder requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldGridLadder: %w", err)
 	}
-	return oldValue.UpdateTime, nil
+	return oldValue.GridLadder, nil
 }
 
-// ResetUpdateTime resets all changes to the "update_time" field.
-func (m *StrategyMutation) ResetUpdateTime() {
-	m.update_time = nil
+// ClearGridLadder clears the value of the "gridLadder" field.
+func (m *StrategyMutation) ClearGridLadder() {
+	m.gridLadder = nil
+	m.clearedFields[strategy.FieldGridLadder] = struct{}{}
 }
 
-// SetGUID sets the "guid" field.
-func (m *StrategyMutation) SetGUID(s string) {
-	m.guid = &s
+// GridLadderCleared returns if the "gridLadder" field was cleared in this mutation.
+func (m *StrategyMutation) GridLadderCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldGridLadder]
+	return ok
 }
 
-// GUID returns the value of the "guid" field in the mutation.
-func (m *StrategyMutation) GUID() (r string, exists bool) {
-	v := m.guid
+// ResetGridLadder resets all changes to the "gridLadder" field.
+func (m *StrategyMutation) ResetGridLadder() {
+	m.gridLadder = nil
+	delete(m.clearedFields, strategy.FieldGridLadder)
+}
+
+// SetTakeProfitRatio sets the "takeProfitRatio" field.
+func (m *StrategyMutation) SetTakeProfitRatio(d decimal.Decimal) {
+	m.takeProfitRatio = &d
+}
+
+// TakeProfitRatio returns the value of the "takeProfitRatio" field in the mutation.
+func (m *StrategyMutation) TakeProfitRatio() (r decimal.Decimal, exists bool) {
+	v := m.takeProfitRatio
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGUID returns the old "guid" field's value of the Strategy entity.
+// OldTakeProfitRatio returns the old "takeProfitRatio" field's value of the Strategy entity.
 // If the Strategy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldGUID(ctx context.Context) (v string, err error) {
+func (m *StrategyMutation) OldTakeProfitRatio(ctx context.Context) (v decimal.Decimal, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGUID is only allowed on UpdateOne operations")
+		return v, errors.New("OldTakeProfitRatio is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGUID requires an ID field in the mutation")
+		return v, errors.New("OldTakeProfitRatio requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGUID: %w", err)
+		return v, fmt.Errorf("querying old value for OldTakeProfitRatio: %w", err)
 	}
-	return oldValue.GUID, nil
+	return oldValue.TakeProfitRatio, nil
 }
 
-// ResetGUID resets all changes to the "guid" field.
-func (m *StrategyMutation) ResetGUID() {
-	m.guid = nil
+// ResetTakeProfitRatio resets all changes to the "takeProfitRatio" field.
+func (m *StrategyMutation) ResetTakeProfitRatio() {
+	m.takeProfitRatio = nil
 }
 
-// SetUserId sets the "userId" field.
-func (m *StrategyMutation) SetUserId(i int64) {
-	m.userId = &i
-	m.adduserId = nil
+// SetTrailingTakeProfit sets the "trailingTakeProfit" field.
+func (m *StrategyMutation) SetTrailingTakeProfit(d decimal.Decimal) {
+	m.trailingTakeProfit = &d
 }
 
-// UserId returns the value of the "userId" field in the mutation.
-func (m *StrategyMutation) UserId() (r int64, exists bool) {
-	v := m.userId
+// TrailingTakeProfit returns the value of the "trailingTakeProfit" field in the mutation.
+func (m *StrategyMutation) TrailingTakeProfit() (r decimal.Decimal, exists bool) {
+	v := m.trailingTakeProfit
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserId returns the old "userId" field's value of the Strategy entity.
+// OldTrailingTakeProfit returns the old "trailingTakeProfit" field's value of the Strategy entity.
 // If the Strategy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldUserId(ctx context.Context) (v int64, err error) {
+func (m *StrategyMutation) OldTrailingTakeProfit(ctx context.Context) (v *decimal.Decimal, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserId is only allowed on UpdateOne operations")
+		return v, errors.New("OldTrailingTakeProfit is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserId requires an ID field in the mutation")
+		return v, errors.New("OldTrailingTakeProfit requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserId: %w", err)
+		return v, fmt.Errorf("querying old value for OldTrailingTakeProfit: %w", err)
 	}
-	return oldValue.UserId, nil
+	return oldValue.TrailingTakeProfit, nil
 }
 
-// AddUserId adds i to the "userId" field.
-func (m *StrategyMutation) AddUserId(i int64) {
-	if m.adduserId != nil {
-		*m.adduserId += i
-	} else {
-		m.adduserId = &i
-	}
+// ClearTrailingTakeProfit clears the value of the "trailingTakeProfit" field.
+func (m *StrategyMutation) ClearTrailingTakeProfit() {
+	m.trailingTakeProfit = nil
+	m.clearedFields[strategy.FieldTrailingTakeProfit] = struct{}{}
 }
 
-// AddedUserId returns the value that was added to the "userId" field in this mutation.
-func (m *StrategyMutation) AddedUserId() (r int64, exists bool) {
-	v := m.adduserId
+// TrailingTakeProfitCleared returns if the "trailingTakeProfit" field was cleared in this mutation.
+func (m *StrategyMutation) TrailingTakeProfitCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldTrailingTakeProfit]
+	return ok
+}
+
+// ResetTrailingTakeProfit resets all changes to the "trailingTakeProfit" field.
+func (m *StrategyMutation) ResetTrailingTakeProfit() {
+	m.trailingTakeProfit = nil
+	delete(m.clearedFields, strategy.FieldTrailingTakeProfit)
+}
+
+// SetTakeProfitPortion sets the "takeProfitPortion" field.
+func (m *StrategyMutation) SetTakeProfitPortion(d decimal.Decimal) {
+	m.takeProfitPortion = &d
+}
+
+// TakeProfitPortion returns the value of the "takeProfitPortion" field in the mutation.
+func (m *StrategyMutation) TakeProfitPortion() (r decimal.Decimal, exists bool) {
+	v := m.takeProfitPortion
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetUserId resets all changes to the "userId" field.
-func (m *StrategyMutation) ResetUserId() {
-	m.userId = nil
-	m.adduserId = nil
+// OldTakeProfitPortion returns the old "takeProfitPortion" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldTakeProfitPortion(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTakeProfitPortion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTakeProfitPortion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTakeProfitPortion: %w", err)
+	}
+	return oldValue.TakeProfitPortion, nil
 }
 
-// SetToken sets the "token" field.
-func (m *StrategyMutation) SetToken(s string) {
-	m.token = &s
+// ClearTakeProfitPortion clears the value of the "takeProfitPortion" field.
+func (m *StrategyMutation) ClearTakeProfitPortion() {
+	m.takeProfitPortion = nil
+	m.clearedFields[strategy.FieldTakeProfitPortion] = struct{}{}
 }
 
-// Token returns the value of the "token" field in the mutation.
-func (m *StrategyMutation) Token() (r string, exists bool) {
-	v := m.token
+// TakeProfitPortionCleared returns if the "takeProfitPortion" field was cleared in this mutation.
+func (m *StrategyMutation) TakeProfitPortionCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldTakeProfitPortion]
+	return ok
+}
+
+// ResetTakeProfitPortion resets all changes to the "takeProfitPortion" field.
+func (m *StrategyMutation) ResetTakeProfitPortion() {
+	m.takeProfitPortion = nil
+	delete(m.clearedFields, strategy.FieldTakeProfitPortion)
+}
+
+// SetAdaptiveSpacing sets the "adaptiveSpacing" field.
+func (m *StrategyMutation) SetAdaptiveSpacing(b bool) {
+	m.adaptiveSpacing = &b
+}
+
+// AdaptiveSpacing returns the value of the "adaptiveSpacing" field in the mutation.
+func (m *StrategyMutation) AdaptiveSpacing() (r bool, exists bool) {
+	v := m.adaptiveSpacing
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldToken returns the old "token" field's value of the Strategy entity.
+// OldAdaptiveSpacing returns the old "adaptiveSpacing" field's value of the Strategy entity.
 // If the Strategy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldToken(ctx context.Context) (v string, err error) {
+func (m *StrategyMutation) OldAdaptiveSpacing(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldAdaptiveSpacing is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldToken requires an ID field in the mutation")
+		return v, errors.New("OldAdaptiveSpacing requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldAdaptiveSpacing: %w", err)
 	}
-	return oldValue.Token, nil
+	return oldValue.AdaptiveSpacing, nil
 }
 
-// ResetToken resets all changes to the "token" field.
-func (m *StrategyMutation) ResetToken() {
-	m.token = nil
+// ClearAdaptiveSpacing clears the value of the "adaptiveSpacing" field.
+func (m *StrategyMutation) ClearAdaptiveSpacing() {
+	m.adaptiveSpacing = nil
+	m.clearedFields[strategy.FieldAdaptiveSpacing] = struct{}{}
 }
 
-// SetSymbol sets the "symbol" field.
-func (m *StrategyMutation) SetSymbol(s string) {
-	m.symbol = &s
+// AdaptiveSpacingCleared returns if the "adaptiveSpacing" field was cleared in this mutation.
+func (m *StrategyMutation) AdaptiveSpacingCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldAdaptiveSpacing]
+	return ok
 }
 
-// Symbol returns the value of the "symbol" field in the mutation.
-func (m *StrategyMutation) Symbol() (r string, exists bool) {
-	v := m.symbol
+// ResetAdaptiveSpacing resets all changes to the "adaptiveSpacing" field.
+func (m *StrategyMutation) ResetAdaptiveSpacing() {
+	m.adaptiveSpacing = nil
+	delete(m.clearedFields, strategy.FieldAdaptiveSpacing)
+}
+
+// SetAdaptiveMinRatio sets the "adaptiveMinRatio" field.
+func (m *StrategyMutation) SetAdaptiveMinRatio(d decimal.Decimal) {
+	m.adaptiveMinRatio = &d
+}
+
+// AdaptiveMinRatio returns the value of the "adaptiveMinRatio" field in the mutation.
+func (m *StrategyMutation) AdaptiveMinRatio() (r decimal.Decimal, exists bool) {
+	v := m.adaptiveMinRatio
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSymbol returns the old "symbol" field's value of the Strategy entity.
+// OldAdaptiveMinRatio returns the old "adaptiveMinRatio" field's value of the Strategy entity.
 // If the Strategy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldSymbol(ctx context.Context) (v string, err error) {
+func (m *StrategyMutation) OldAdaptiveMinRatio(ctx context.Context) (v *decimal.Decimal, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSymbol is only allowed on UpdateOne operations")
+		return v, errors.New("OldAdaptiveMinRatio is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSymbol requires an ID field in the mutation")
+		return v, errors.New("OldAdaptiveMinRatio requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSymbol: %w", err)
+		return v, fmt.Errorf("querying old value for OldAdaptiveMinRatio: %w", err)
 	}
-	return oldValue.Symbol, nil
+	return oldValue.AdaptiveMinRatio, nil
 }
 
-// ResetSymbol resets all changes to the "symbol" field.
-func (m *StrategyMutation) ResetSymbol() {
-	m.symbol = nil
+// ClearAdaptiveMinRatio clears the value of the "adaptiveMinRatio" field.
+func (m *StrategyMutation) ClearAdaptiveMinRatio() {
+	m.adaptiveMinRatio = nil
+	m.clearedFields[strategy.FieldAdaptiveMinRatio] = struct{}{}
 }
 
-// SetMartinFactor sets the "martinFactor" field.
-func (m *StrategyMutation) SetMartinFactor(f float64) {
-	m.martinFactor = &f
-	m.addmartinFactor = nil
+// AdaptiveMinRatioCleared returns if the "adaptiveMinRatio" field was cleared in this mutation.
+func (m *StrategyMutation) AdaptiveMinRatioCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldAdaptiveMinRatio]
+	return ok
 }
 
-// MartinFactor returns the value of the "martinFactor" field in the mutation.
-func (m *StrategyMutation) MartinFactor() (r float64, exists bool) {
-	v := m.martinFactor
+// ResetAdaptiveMinRatio resets all changes to the "adaptiveMinRatio" field.
+func (m *StrategyMutation) ResetAdaptiveMinRatio() {
+	m.adaptiveMinRatio = nil
+	delete(m.clearedFields, strategy.FieldAdaptiveMinRatio)
+}
+
+// SetAdaptiveMaxRatio sets the "adaptiveMaxRatio" field.
+func (m *StrategyMutation) SetAdaptiveMaxRatio(d decimal.Decimal) {
+	m.adaptiveMaxRatio = &d
+}
+
+// AdaptiveMaxRatio returns the value of the "adaptiveMaxRatio" field in the mutation.
+func (m *StrategyMutation) AdaptiveMaxRatio() (r decimal.Decimal, exists bool) {
+	v := m.adaptiveMaxRatio
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMartinFactor returns the old "martinFactor" field's value of the Strategy entity.
+// OldAdaptiveMaxRatio returns the old "adaptiveMaxRatio" field's value of the Strategy entity.
 // If the Strategy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldMartinFactor(ctx context.Context) (v float64, err error) {
+func (m *StrategyMutation) OldAdaptiveMaxRatio(ctx context.Context) (v *decimal.Decimal, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMartinFactor is only allowed on UpdateOne operations")
+		return v, errors.New("OldAdaptiveMaxRatio is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMartinFactor requires an ID field in the mutation")
+		return v, errors.New("OldAdaptiveMaxRatio requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMartinFactor: %w", err)
+		return v, fmt.Errorf("querying old value for OldAdaptiveMaxRatio: %w", err)
 	}
-	return oldValue.MartinFactor, nil
+	return oldValue.AdaptiveMaxRatio, nil
 }
 
-// AddMartinFactor adds f to the "martinFactor" field.
-func (m *StrategyMutation) AddMartinFactor(f float64) {
-	if m.addmartinFactor != nil {
-		*m.addmartinFactor += f
-	} else {
-		m.addmartinFactor = &f
-	}
+// ClearAdaptiveMaxRatio clears the value of the "adaptiveMaxRatio" field.
+func (m *StrategyMutation) ClearAdaptiveMaxRatio() {
+	m.adaptiveMaxRatio = nil
+	m.clearedFields[strategy.FieldAdaptiveMaxRatio] = struct{}{}
 }
 
-// AddedMartinFactor returns the value that was added to the "martinFactor" field in this mutation.
-func (m *StrategyMutation) AddedMartinFactor() (r float64, exists bool) {
-	v := m.addmartinFactor
-	if v == nil {
-		return
-	}
-	return *v, true
+// AdaptiveMaxRatioCleared returns if the "adaptiveMaxRatio" field was cleared in this mutation.
+func (m *StrategyMutation) AdaptiveMaxRatioCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldAdaptiveMaxRatio]
+	return ok
 }
 
-// ResetMartinFactor resets all changes to the "martinFactor" field.
-func (m *StrategyMutation) ResetMartinFactor() {
-	m.martinFactor = nil
-	m.addmartinFactor = nil
+// ResetAdaptiveMaxRatio resets all changes to the "adaptiveMaxRatio" field.
+func (m *StrategyMutation) ResetAdaptiveMaxRatio() {
+	m.adaptiveMaxRatio = nil
+	delete(m.clearedFields, strategy.FieldAdaptiveMaxRatio)
 }
 
-// SetMaxGridLimit sets the "maxGridLimit" field.
-func (m *StrategyMutation) SetMaxGridLimit(i int) {
-	m.maxGridLimit = &i
-	m.addmaxGridLimit = nil
+// SetAdaptiveVolatility sets the "adaptiveVolatility" field.
+func (m *StrategyMutation) SetAdaptiveVolatility(d decimal.Decimal) {
+	m.adaptiveVolatility = &d
 }
 
-// MaxGridLimit returns the value of the "maxGridLimit" field in the mutation.
-func (m *StrategyMutation) MaxGridLimit() (r int, exists bool) {
-	v := m.maxGridLimit
+// AdaptiveVolatility returns the value of the "adaptiveVolatility" field in the mutation.
+func (m *StrategyMutation) AdaptiveVolatility() (r decimal.Decimal, exists bool) {
+	v := m.adaptiveVolatility
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMaxGridLimit returns the old "maxGridLimit" field's value of the Strategy entity.
+// OldAdaptiveVolatility returns the old "adaptiveVolatility" field's value of the Strategy entity.
 // If the Strategy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldMaxGridLimit(ctx context.Context) (v *int, err error) {
+func (m *StrategyMutation) OldAdaptiveVolatility(ctx context.Context) (v *decimal.Decimal, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMaxGridLimit is only allowed on UpdateOne operations")
+		return v, errors.New("OldAdaptiveVolatility is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMaxGridLimit requires an ID field in the mutation")
+		return v, errors.New("OldAdaptiveVolatility requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMaxGridLimit: %w", err)
-	}
-	return oldValue.MaxGridLimit, nil
-}
-
-// AddMaxGridLimit adds i to the "maxGridLimit" field.
-func (m *StrategyMutation) AddMaxGridLimit(i int) {
-	if m.addmaxGridLimit != nil {
-		*m.addmaxGridLimit += i
-	} else {
-		m.addmaxGridLimit = &i
-	}
-}
-
-// AddedMaxGridLimit returns the value that was added to the "maxGridLimit" field in this mutation.
-func (m *StrategyMutation) AddedMaxGridLimit() (r int, exists bool) {
-	v := m.addmaxGridLimit
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldAdaptiveVolatility: %w", err)
 	}
-	return *v, true
+	return oldValue.AdaptiveVolatility, nil
 }
 
-// ClearMaxGridLimit clears the value of the "maxGridLimit" field.
-func (m *StrategyMutation) ClearMaxGridLimit() {
-	m.maxGridLimit = nil
-	m.addmaxGridLimit = nil
-	m.clearedFields[strategy.FieldMaxGridLimit] = struct{}{}
+// ClearAdaptiveVolatility clears the value of the "adaptiveVolatility" field.
+func (m *StrategyMutation) ClearAdaptiveVolatility() {
+	m.adaptiveVolatility = nil
+	m.clearedFields[strategy.FieldAdaptiveVolatility] = struct{}{}
 }
 
-// MaxGridLimitCleared returns if the "maxGridLimit" field was cleared in this mutation.
-func (m *StrategyMutation) MaxGridLimitCleared() bool {
-	_, ok := m.clearedFields[strategy.FieldMaxGridLimit]
+// AdaptiveVolatilityCleared returns if the "adaptiveVolatility" field was cleared in this mutation.
+func (m *StrategyMutation) AdaptiveVolatilityCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldAdaptiveVolatility]
 	return ok
 }
 
-// ResetMaxGridLimit resets all changes to the "maxGridLimit" field.
-func (m *StrategyMutation) ResetMaxGridLimit() {
-	m.maxGridLimit = nil
-	m.addmaxGridLimit = nil
-	delete(m.clearedFields, strategy.FieldMaxGridLimit)
+// ResetAdaptiveVolatility resets all changes to the "adaptiveVolatility" field.
+func (m *StrategyMutation) ResetAdaptiveVolatility() {
+	m.adaptiveVolatility = nil
+	delete(m.clearedFields, strategy.FieldAdaptiveVolatility)
 }
 
-// SetTakeProfitRatio sets the "takeProfitRatio" field.
-func (m *StrategyMutation) SetTakeProfitRatio(d decimal.Decimal) {
-	m.takeProfitRatio = &d
+// SetAdaptiveUpdateTime sets the "adaptiveUpdateTime" field.
+func (m *StrategyMutation) SetAdaptiveUpdateTime(t time.Time) {
+	m.adaptiveUpdateTime = &t
 }
 
-// TakeProfitRatio returns the value of the "takeProfitRatio" field in the mutation.
-func (m *StrategyMutation) TakeProfitRatio() (r decimal.Decimal, exists bool) {
-	v := m.takeProfitRatio
+// AdaptiveUpdateTime returns the value of the "adaptiveUpdateTime" field in the mutation.
+func (m *StrategyMutation) AdaptiveUpdateTime() (r time.Time, exists bool) {
+	v := m.adaptiveUpdateTime
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTakeProfitRatio returns the old "takeProfitRatio" field's value of the Strategy entity.
+// OldAdaptiveUpdateTime returns the old "adaptiveUpdateTime" field's value of the Strategy entity.
 // If the Strategy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldTakeProfitRatio(ctx context.Context) (v decimal.Decimal, err error) {
+func (m *StrategyMutation) OldAdaptiveUpdateTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTakeProfitRatio is only allowed on UpdateOne operations")
+		return v, errors.New("OldAdaptiveUpdateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTakeProfitRatio requires an ID field in the mutation")
+		return v, errors.New("OldAdaptiveUpdateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTakeProfitRatio: %w", err)
+		return v, fmt.Errorf("querying old value for OldAdaptiveUpdateTime: %w", err)
 	}
-	return oldValue.TakeProfitRatio, nil
+	return oldValue.AdaptiveUpdateTime, nil
 }
 
-// ResetTakeProfitRatio resets all changes to the "takeProfitRatio" field.
-func (m *StrategyMutation) ResetTakeProfitRatio() {
-	m.takeProfitRatio = nil
+// ClearAdaptiveUpdateTime clears the value of the "adaptiveUpdateTime" field.
+func (m *StrategyMutation) ClearAdaptiveUpdateTime() {
+	m.adaptiveUpdateTime = nil
+	m.clearedFields[strategy.FieldAdaptiveUpdateTime] = struct{}{}
+}
+
+// AdaptiveUpdateTimeCleared returns if the "adaptiveUpdateTime" field was cleared in this mutation.
+func (m *StrategyMutation) AdaptiveUpdateTimeCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldAdaptiveUpdateTime]
+	return ok
+}
+
+// ResetAdaptiveUpdateTime resets all changes to the "adaptiveUpdateTime" field.
+func (m *StrategyMutation) ResetAdaptiveUpdateTime() {
+	m.adaptiveUpdateTime = nil
+	delete(m.clearedFields, strategy.FieldAdaptiveUpdateTime)
 }
 
 // SetUpperPriceBound sets the "upperPriceBound" field.
@@ -4839,151 +6604,319 @@ func (m *StrategyMutation) ResetStopLossExit() {
 	delete(m.clearedFields, strategy.FieldStopLossExit)
 }
 
-// SetTakeProfitExit sets the "takeProfitExit" field.
-func (m *StrategyMutation) SetTakeProfitExit(d decimal.Decimal) {
-	m.takeProfitExit = &d
+// SetTakeProfitExit sets the "takeProfitExit" field.
+func (m *StrategyMutation) SetTakeProfitExit(d decimal.Decimal) {
+	m.takeProfitExit = &d
+}
+
+// TakeProfitExit returns the value of the "takeProfitExit" field in the mutation.
+func (m *StrategyMutation) TakeProfitExit() (r decimal.Decimal, exists bool) {
+	v := m.takeProfitExit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTakeProfitExit returns the old "takeProfitExit" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldTakeProfitExit(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTakeProfitExit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTakeProfitExit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTakeProfitExit: %w", err)
+	}
+	return oldValue.TakeProfitExit, nil
+}
+
+// ClearTakeProfitExit clears the value of the "takeProfitExit" field.
+func (m *StrategyMutation) ClearTakeProfitExit() {
+	m.takeProfitExit = nil
+	m.clearedFields[strategy.FieldTakeProfitExit] = struct{}{}
+}
+
+// TakeProfitExitCleared returns if the "takeProfitExit" field was cleared in this mutation.
+func (m *StrategyMutation) TakeProfitExitCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldTakeProfitExit]
+	return ok
+}
+
+// ResetTakeProfitExit resets all changes to the "takeProfitExit" field.
+func (m *StrategyMutation) ResetTakeProfitExit() {
+	m.takeProfitExit = nil
+	delete(m.clearedFields, strategy.FieldTakeProfitExit)
+}
+
+// SetGlobalTakeProfitRatio sets the "globalTakeProfitRatio" field.
+func (m *StrategyMutation) SetGlobalTakeProfitRatio(d decimal.Decimal) {
+	m.globalTakeProfitRatio = &d
+}
+
+// GlobalTakeProfitRatio returns the value of the "globalTakeProfitRatio" field in the mutation.
+func (m *StrategyMutation) GlobalTakeProfitRatio() (r decimal.Decimal, exists bool) {
+	v := m.globalTakeProfitRatio
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGlobalTakeProfitRatio returns the old "globalTakeProfitRatio" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldGlobalTakeProfitRatio(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGlobalTakeProfitRatio is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGlobalTakeProfitRatio requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGlobalTakeProfitRatio: %w", err)
+	}
+	return oldValue.GlobalTakeProfitRatio, nil
+}
+
+// ClearGlobalTakeProfitRatio clears the value of the "globalTakeProfitRatio" field.
+func (m *StrategyMutation) ClearGlobalTakeProfitRatio() {
+	m.globalTakeProfitRatio = nil
+	m.clearedFields[strategy.FieldGlobalTakeProfitRatio] = struct{}{}
+}
+
+// GlobalTakeProfitRatioCleared returns if the "globalTakeProfitRatio" field was cleared in this mutation.
+func (m *StrategyMutation) GlobalTakeProfitRatioCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldGlobalTakeProfitRatio]
+	return ok
+}
+
+// ResetGlobalTakeProfitRatio resets all changes to the "globalTakeProfitRatio" field.
+func (m *StrategyMutation) ResetGlobalTakeProfitRatio() {
+	m.globalTakeProfitRatio = nil
+	delete(m.clearedFields, strategy.FieldGlobalTakeProfitRatio)
+}
+
+// SetDynamicStopLoss sets the "dynamicStopLoss" field.
+func (m *StrategyMutation) SetDynamicStopLoss(b bool) {
+	m.dynamicStopLoss = &b
+}
+
+// DynamicStopLoss returns the value of the "dynamicStopLoss" field in the mutation.
+func (m *StrategyMutation) DynamicStopLoss() (r bool, exists bool) {
+	v := m.dynamicStopLoss
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDynamicStopLoss returns the old "dynamicStopLoss" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldDynamicStopLoss(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDynamicStopLoss is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDynamicStopLoss requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDynamicStopLoss: %w", err)
+	}
+	return oldValue.DynamicStopLoss, nil
+}
+
+// ClearDynamicStopLoss clears the value of the "dynamicStopLoss" field.
+func (m *StrategyMutation) ClearDynamicStopLoss() {
+	m.dynamicStopLoss = nil
+	m.clearedFields[strategy.FieldDynamicStopLoss] = struct{}{}
+}
+
+// DynamicStopLossCleared returns if the "dynamicStopLoss" field was cleared in this mutation.
+func (m *StrategyMutation) DynamicStopLossCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldDynamicStopLoss]
+	return ok
+}
+
+// ResetDynamicStopLoss resets all changes to the "dynamicStopLoss" field.
+func (m *StrategyMutation) ResetDynamicStopLoss() {
+	m.dynamicStopLoss = nil
+	delete(m.clearedFields, strategy.FieldDynamicStopLoss)
+}
+
+// SetTrailingUp sets the "trailingUp" field.
+func (m *StrategyMutation) SetTrailingUp(b bool) {
+	m.trailingUp = &b
 }
 
-// TakeProfitExit returns the value of the "takeProfitExit" field in the mutation.
-func (m *StrategyMutation) TakeProfitExit() (r decimal.Decimal, exists bool) {
-	v := m.takeProfitExit
+// TrailingUp returns the value of the "trailingUp" field in the mutation.
+func (m *StrategyMutation) TrailingUp() (r bool, exists bool) {
+	v := m.trailingUp
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTakeProfitExit returns the old "takeProfitExit" field's value of the Strategy entity.
+// OldTrailingUp returns the old "trailingUp" field's value of the Strategy entity.
 // If the Strategy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldTakeProfitExit(ctx context.Context) (v *decimal.Decimal, err error) {
+func (m *StrategyMutation) OldTrailingUp(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTakeProfitExit is only allowed on UpdateOne operations")
+		return v, errors.New("OldTrailingUp is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTakeProfitExit requires an ID field in the mutation")
+		return v, errors.New("OldTrailingUp requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTakeProfitExit: %w", err)
+		return v, fmt.Errorf("querying old value for OldTrailingUp: %w", err)
 	}
-	return oldValue.TakeProfitExit, nil
+	return oldValue.TrailingUp, nil
 }
 
-// ClearTakeProfitExit clears the value of the "takeProfitExit" field.
-func (m *StrategyMutation) ClearTakeProfitExit() {
-	m.takeProfitExit = nil
-	m.clearedFields[strategy.FieldTakeProfitExit] = struct{}{}
+// ClearTrailingUp clears the value of the "trailingUp" field.
+func (m *StrategyMutation) ClearTrailingUp() {
+	m.trailingUp = nil
+	m.clearedFields[strategy.FieldTrailingUp] = struct{}{}
 }
 
-// TakeProfitExitCleared returns if the "takeProfitExit" field was cleared in this mutation.
-func (m *StrategyMutation) TakeProfitExitCleared() bool {
-	_, ok := m.clearedFields[strategy.FieldTakeProfitExit]
+// TrailingUpCleared returns if the "trailingUp" field was cleared in this mutation.
+func (m *StrategyMutation) TrailingUpCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldTrailingUp]
 	return ok
 }
 
-// ResetTakeProfitExit resets all changes to the "takeProfitExit" field.
-func (m *StrategyMutation) ResetTakeProfitExit() {
-	m.takeProfitExit = nil
-	delete(m.clearedFields, strategy.FieldTakeProfitExit)
+// ResetTrailingUp resets all changes to the "trailingUp" field.
+func (m *StrategyMutation) ResetTrailingUp() {
+	m.trailingUp = nil
+	delete(m.clearedFields, strategy.FieldTrailingUp)
 }
 
-// SetGlobalTakeProfitRatio sets the "globalTakeProfitRatio" field.
-func (m *StrategyMutation) SetGlobalTakeProfitRatio(d decimal.Decimal) {
-	m.globalTakeProfitRatio = &d
+// SetTrailingDown sets the "trailingDown" field.
+func (m *StrategyMutation) SetTrailingDown(b bool) {
+	m.trailingDown = &b
 }
 
-// GlobalTakeProfitRatio returns the value of the "globalTakeProfitRatio" field in the mutation.
-func (m *StrategyMutation) GlobalTakeProfitRatio() (r decimal.Decimal, exists bool) {
-	v := m.globalTakeProfitRatio
+// TrailingDown returns the value of the "trailingDown" field in the mutation.
+func (m *StrategyMutation) TrailingDown() (r bool, exists bool) {
+	v := m.trailingDown
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGlobalTakeProfitRatio returns the old "globalTakeProfitRatio" field's value of the Strategy entity.
+// OldTrailingDown returns the old "trailingDown" field's value of the Strategy entity.
 // If the Strategy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldGlobalTakeProfitRatio(ctx context.Context) (v *decimal.Decimal, err error) {
+func (m *StrategyMutation) OldTrailingDown(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGlobalTakeProfitRatio is only allowed on UpdateOne operations")
+		return v, errors.New("OldTrailingDown is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGlobalTakeProfitRatio requires an ID field in the mutation")
+		return v, errors.New("OldTrailingDown requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGlobalTakeProfitRatio: %w", err)
+		return v, fmt.Errorf("querying old value for OldTrailingDown: %w", err)
 	}
-	return oldValue.GlobalTakeProfitRatio, nil
+	return oldValue.TrailingDown, nil
 }
 
-// ClearGlobalTakeProfitRatio clears the value of the "globalTakeProfitRatio" field.
-func (m *StrategyMutation) ClearGlobalTakeProfitRatio() {
-	m.globalTakeProfitRatio = nil
-	m.clearedFields[strategy.FieldGlobalTakeProfitRatio] = struct{}{}
+// ClearTrailingDown clears the value of the "trailingDown" field.
+func (m *StrategyMutation) ClearTrailingDown() {
+	m.trailingDown = nil
+	m.clearedFields[strategy.FieldTrailingDown] = struct{}{}
 }
 
-// GlobalTakeProfitRatioCleared returns if the "globalTakeProfitRatio" field was cleared in this mutation.
-func (m *StrategyMutation) GlobalTakeProfitRatioCleared() bool {
-	_, ok := m.clearedFields[strategy.FieldGlobalTakeProfitRatio]
+// TrailingDownCleared returns if the "trailingDown" field was cleared in this mutation.
+func (m *StrategyMutation) TrailingDownCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldTrailingDown]
 	return ok
 }
 
-// ResetGlobalTakeProfitRatio resets all changes to the "globalTakeProfitRatio" field.
-func (m *StrategyMutation) ResetGlobalTakeProfitRatio() {
-	m.globalTakeProfitRatio = nil
-	delete(m.clearedFields, strategy.FieldGlobalTakeProfitRatio)
+// ResetTrailingDown resets all changes to the "trailingDown" field.
+func (m *StrategyMutation) ResetTrailingDown() {
+	m.trailingDown = nil
+	delete(m.clearedFields, strategy.FieldTrailingDown)
 }
 
-// SetDynamicStopLoss sets the "dynamicStopLoss" field.
-func (m *StrategyMutation) SetDynamicStopLoss(b bool) {
-	m.dynamicStopLoss = &b
+// SetTrailingDwellMinutes sets the "trailingDwellMinutes" field.
+func (m *StrategyMutation) SetTrailingDwellMinutes(i int) {
+	m.trailingDwellMinutes = &i
+	m.addtrailingDwellMinutes = nil
 }
 
-// DynamicStopLoss returns the value of the "dynamicStopLoss" field in the mutation.
-func (m *StrategyMutation) DynamicStopLoss() (r bool, exists bool) {
-	v := m.dynamicStopLoss
+// TrailingDwellMinutes returns the value of the "trailingDwellMinutes" field in the mutation.
+func (m *StrategyMutation) TrailingDwellMinutes() (r int, exists bool) {
+	v := m.trailingDwellMinutes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDynamicStopLoss returns the old "dynamicStopLoss" field's value of the Strategy entity.
+// OldTrailingDwellMinutes returns the old "trailingDwellMinutes" field's value of the Strategy entity.
 // If the Strategy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldDynamicStopLoss(ctx context.Context) (v bool, err error) {
+func (m *StrategyMutation) OldTrailingDwellMinutes(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDynamicStopLoss is only allowed on UpdateOne operations")
+		return v, errors.New("OldTrailingDwellMinutes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDynamicStopLoss requires an ID field in the mutation")
+		return v, errors.New("OldTrailingDwellMinutes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDynamicStopLoss: %w", err)
+		return v, fmt.Errorf("querying old value for OldTrailingDwellMinutes: %w", err)
 	}
-	return oldValue.DynamicStopLoss, nil
+	return oldValue.TrailingDwellMinutes, nil
 }
 
-// ClearDynamicStopLoss clears the value of the "dynamicStopLoss" field.
-func (m *StrategyMutation) ClearDynamicStopLoss() {
-	m.dynamicStopLoss = nil
-	m.clearedFields[strategy.FieldDynamicStopLoss] = struct{}{}
+// AddTrailingDwellMinutes adds i to the "trailingDwellMinutes" field.
+func (m *StrategyMutation) AddTrailingDwellMinutes(i int) {
+	if m.addtrailingDwellMinutes != nil {
+		*m.addtrailingDwellMinutes += i
+	} else {
+		m.addtrailingDwellMinutes = &i
+	}
 }
 
-// DynamicStopLossCleared returns if the "dynamicStopLoss" field was cleared in this mutation.
-func (m *StrategyMutation) DynamicStopLossCleared() bool {
-	_, ok := m.clearedFields[strategy.FieldDynamicStopLoss]
+// AddedTrailingDwellMinutes returns the value that was added to the "trailingDwellMinutes" field in this mutation.
+func (m *StrategyMutation) AddedTrailingDwellMinutes() (r int, exists bool) {
+	v := m.addtrailingDwellMinutes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTrailingDwellMinutes clears the value of the "trailingDwellMinutes" field.
+func (m *StrategyMutation) ClearTrailingDwellMinutes() {
+	m.trailingDwellMinutes = nil
+	m.addtrailingDwellMinutes = nil
+	m.clearedFields[strategy.FieldTrailingDwellMinutes] = struct{}{}
+}
+
+// TrailingDwellMinutesCleared returns if the "trailingDwellMinutes" field was cleared in this mutation.
+func (m *StrategyMutation) TrailingDwellMinutesCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldTrailingDwellMinutes]
 	return ok
 }
 
-// ResetDynamicStopLoss resets all changes to the "dynamicStopLoss" field.
-func (m *StrategyMutation) ResetDynamicStopLoss() {
-	m.dynamicStopLoss = nil
-	delete(m.clearedFields, strategy.FieldDynamicStopLoss)
+// ResetTrailingDwellMinutes resets all changes to the "trailingDwellMinutes" field.
+func (m *StrategyMutation) ResetTrailingDwellMinutes() {
+	m.trailingDwellMinutes = nil
+	m.addtrailingDwellMinutes = nil
+	delete(m.clearedFields, strategy.FieldTrailingDwellMinutes)
 }
 
 // SetDropOn sets the "dropOn" field.
@@ -5154,6 +7087,210 @@ func (m *StrategyMutation) ResetDropThreshold() {
 	delete(m.clearedFields, strategy.FieldDropThreshold)
 }
 
+// SetRsiThreshold sets the "rsiThreshold" field.
+func (m *StrategyMutation) SetRsiThreshold(d decimal.Decimal) {
+	m.rsiThreshold = &d
+}
+
+// RsiThreshold returns the value of the "rsiThreshold" field in the mutation.
+func (m *StrategyMutation) RsiThreshold() (r decimal.Decimal, exists bool) {
+	v := m.rsiThreshold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRsiThreshold returns the old "rsiThreshold" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldRsiThreshold(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRsiThreshold is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRsiThreshold requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRsiThreshold: %w", err)
+	}
+	return oldValue.RsiThreshold, nil
+}
+
+// ClearRsiThreshold clears the value of the "rsiThreshold" field.
+func (m *StrategyMutation) ClearRsiThreshold() {
+	m.rsiThreshold = nil
+	m.clearedFields[strategy.FieldRsiThreshold] = struct{}{}
+}
+
+// RsiThresholdCleared returns if the "rsiThreshold" field was cleared in this mutation.
+func (m *StrategyMutation) RsiThresholdCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldRsiThreshold]
+	return ok
+}
+
+// ResetRsiThreshold resets all changes to the "rsiThreshold" field.
+func (m *StrategyMutation) ResetRsiThreshold() {
+	m.rsiThreshold = nil
+	delete(m.clearedFields, strategy.FieldRsiThreshold)
+}
+
+// SetEmaFilter sets the "emaFilter" field.
+func (m *StrategyMutation) SetEmaFilter(sf strategy.EmaFilter) {
+	m.emaFilter = &sf
+}
+
+// EmaFilter returns the value of the "emaFilter" field in the mutation.
+func (m *StrategyMutation) EmaFilter() (r strategy.EmaFilter, exists bool) {
+	v := m.emaFilter
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmaFilter returns the old "emaFilter" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldEmaFilter(ctx context.Context) (v strategy.EmaFilter, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmaFilter is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmaFilter requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmaFilter: %w", err)
+	}
+	return oldValue.EmaFilter, nil
+}
+
+// ResetEmaFilter resets all changes to the "emaFilter" field.
+func (m *StrategyMutation) ResetEmaFilter() {
+	m.emaFilter = nil
+}
+
+// SetEmaPeriod sets the "emaPeriod" field.
+func (m *StrategyMutation) SetEmaPeriod(i int) {
+	m.emaPeriod = &i
+	m.addemaPeriod = nil
+}
+
+// EmaPeriod returns the value of the "emaPeriod" field in the mutation.
+func (m *StrategyMutation) EmaPeriod() (r int, exists bool) {
+	v := m.emaPeriod
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmaPeriod returns the old "emaPeriod" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldEmaPeriod(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmaPeriod is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmaPeriod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmaPeriod: %w", err)
+	}
+	return oldValue.EmaPeriod, nil
+}
+
+// AddEmaPeriod adds i to the "emaPeriod" field.
+func (m *StrategyMutation) AddEmaPeriod(i int) {
+	if m.addemaPeriod != nil {
+		*m.addemaPeriod += i
+	} else {
+		m.addemaPeriod = &i
+	}
+}
+
+// AddedEmaPeriod returns the value that was added to the "emaPeriod" field in this mutation.
+func (m *StrategyMutation) AddedEmaPeriod() (r int, exists bool) {
+	v := m.addemaPeriod
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearEmaPeriod clears the value of the "emaPeriod" field.
+func (m *StrategyMutation) ClearEmaPeriod() {
+	m.emaPeriod = nil
+	m.addemaPeriod = nil
+	m.clearedFields[strategy.FieldEmaPeriod] = struct{}{}
+}
+
+// EmaPeriodCleared returns if the "emaPeriod" field was cleared in this mutation.
+func (m *StrategyMutation) EmaPeriodCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldEmaPeriod]
+	return ok
+}
+
+// ResetEmaPeriod resets all changes to the "emaPeriod" field.
+func (m *StrategyMutation) ResetEmaPeriod() {
+	m.emaPeriod = nil
+	m.addemaPeriod = nil
+	delete(m.clearedFields, strategy.FieldEmaPeriod)
+}
+
+// SetMacdFilter sets the "macdFilter" field.
+func (m *StrategyMutation) SetMacdFilter(b bool) {
+	m.macdFilter = &b
+}
+
+// MacdFilter returns the value of the "macdFilter" field in the mutation.
+func (m *StrategyMutation) MacdFilter() (r bool, exists bool) {
+	v := m.macdFilter
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMacdFilter returns the old "macdFilter" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldMacdFilter(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMacdFilter is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMacdFilter requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMacdFilter: %w", err)
+	}
+	return oldValue.MacdFilter, nil
+}
+
+// ClearMacdFilter clears the value of the "macdFilter" field.
+func (m *StrategyMutation) ClearMacdFilter() {
+	m.macdFilter = nil
+	m.clearedFields[strategy.FieldMacdFilter] = struct{}{}
+}
+
+// MacdFilterCleared returns if the "macdFilter" field was cleared in this mutation.
+func (m *StrategyMutation) MacdFilterCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldMacdFilter]
+	return ok
+}
+
+// ResetMacdFilter resets all changes to the "macdFilter" field.
+func (m *StrategyMutation) ResetMacdFilter() {
+	m.macdFilter = nil
+	delete(m.clearedFields, strategy.FieldMacdFilter)
+}
+
 // SetEnableAutoBuy sets the "enableAutoBuy" field.
 func (m *StrategyMutation) SetEnableAutoBuy(b bool) {
 	m.enableAutoBuy = &b
@@ -5298,6 +7435,55 @@ func (m *StrategyMutation) ResetEnablePushNotification() {
 	m.enablePushNotification = nil
 }
 
+// SetPaperTrading sets the "paperTrading" field.
+func (m *StrategyMutation) SetPaperTrading(b bool) {
+	m.paperTrading = &b
+}
+
+// PaperTrading returns the value of the "paperTrading" field in the mutation.
+func (m *StrategyMutation) PaperTrading() (r bool, exists bool) {
+	v := m.paperTrading
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPaperTrading returns the old "paperTrading" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldPaperTrading(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPaperTrading is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPaperTrading requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPaperTrading: %w", err)
+	}
+	return oldValue.PaperTrading, nil
+}
+
+// ClearPaperTrading clears the value of the "paperTrading" field.
+func (m *StrategyMutation) ClearPaperTrading() {
+	m.paperTrading = nil
+	m.clearedFields[strategy.FieldPaperTrading] = struct{}{}
+}
+
+// PaperTradingCleared returns if the "paperTrading" field was cleared in this mutation.
+func (m *StrategyMutation) PaperTradingCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldPaperTrading]
+	return ok
+}
+
+// ResetPaperTrading resets all changes to the "paperTrading" field.
+func (m *StrategyMutation) ResetPaperTrading() {
+	m.paperTrading = nil
+	delete(m.clearedFields, strategy.FieldPaperTrading)
+}
+
 // SetStatus sets the "status" field.
 func (m *StrategyMutation) SetStatus(s strategy.Status) {
 	m.status = &s
@@ -5356,31 +7542,80 @@ func (m *StrategyMutation) OldGridTrend(ctx context.Context) (v *string, err err
 		return v, errors.New("OldGridTrend is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGridTrend requires an ID field in the mutation")
+		return v, errors.New("OldGridTrend requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGridTrend: %w", err)
+	}
+	return oldValue.GridTrend, nil
+}
+
+// ClearGridTrend clears the value of the "gridTrend" field.
+func (m *StrategyMutation) ClearGridTrend() {
+	m.gridTrend = nil
+	m.clearedFields[strategy.FieldGridTrend] = struct{}{}
+}
+
+// GridTrendCleared returns if the "gridTrend" field was cleared in this mutation.
+func (m *StrategyMutation) GridTrendCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldGridTrend]
+	return ok
+}
+
+// ResetGridTrend resets all changes to the "gridTrend" field.
+func (m *StrategyMutation) ResetGridTrend() {
+	m.gridTrend = nil
+	delete(m.clearedFields, strategy.FieldGridTrend)
+}
+
+// SetBuyFilterReason sets the "buyFilterReason" field.
+func (m *StrategyMutation) SetBuyFilterReason(s string) {
+	m.buyFilterReason = &s
+}
+
+// BuyFilterReason returns the value of the "buyFilterReason" field in the mutation.
+func (m *StrategyMutation) BuyFilterReason() (r string, exists bool) {
+	v := m.buyFilterReason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuyFilterReason returns the old "buyFilterReason" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldBuyFilterReason(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuyFilterReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuyFilterReason requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGridTrend: %w", err)
+		return v, fmt.Errorf("querying old value for OldBuyFilterReason: %w", err)
 	}
-	return oldValue.GridTrend, nil
+	return oldValue.BuyFilterReason, nil
 }
 
-// ClearGridTrend clears the value of the "gridTrend" field.
-func (m *StrategyMutation) ClearGridTrend() {
-	m.gridTrend = nil
-	m.clearedFields[strategy.FieldGridTrend] = struct{}{}
+// ClearBuyFilterReason clears the value of the "buyFilterReason" field.
+func (m *StrategyMutation) ClearBuyFilterReason() {
+	m.buyFilterReason = nil
+	m.clearedFields[strategy.FieldBuyFilterReason] = struct{}{}
 }
 
-// GridTrendCleared returns if the "gridTrend" field was cleared in this mutation.
-func (m *StrategyMutation) GridTrendCleared() bool {
-	_, ok := m.clearedFields[strategy.FieldGridTrend]
+// BuyFilterReasonCleared returns if the "buyFilterReason" field was cleared in this mutation.
+func (m *StrategyMutation) BuyFilterReasonCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldBuyFilterReason]
 	return ok
 }
 
-// ResetGridTrend resets all changes to the "gridTrend" field.
-func (m *StrategyMutation) ResetGridTrend() {
-	m.gridTrend = nil
-	delete(m.clearedFields, strategy.FieldGridTrend)
+// ResetBuyFilterReason resets all changes to the "buyFilterReason" field.
+func (m *StrategyMutation) ResetBuyFilterReason() {
+	m.buyFilterReason = nil
+	delete(m.clearedFields, strategy.FieldBuyFilterReason)
 }
 
 // SetLastLowerThresholdAlertTime sets the "lastLowerThresholdAlertTime" field.
@@ -5481,6 +7716,55 @@ func (m *StrategyMutation) ResetLastUpperThresholdAlertTime() {
 	delete(m.clearedFields, strategy.FieldLastUpperThresholdAlertTime)
 }
 
+// SetOutOfRangeTime sets the "outOfRangeTime" field.
+func (m *StrategyMutation) SetOutOfRangeTime(t time.Time) {
+	m.outOfRangeTime = &t
+}
+
+// OutOfRangeTime returns the value of the "outOfRangeTime" field in the mutation.
+func (m *StrategyMutation) OutOfRangeTime() (r time.Time, exists bool) {
+	v := m.outOfRangeTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOutOfRangeTime returns the old "outOfRangeTime" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldOutOfRangeTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOutOfRangeTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOutOfRangeTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOutOfRangeTime: %w", err)
+	}
+	return oldValue.OutOfRangeTime, nil
+}
+
+// ClearOutOfRangeTime clears the value of the "outOfRangeTime" field.
+func (m *StrategyMutation) ClearOutOfRangeTime() {
+	m.outOfRangeTime = nil
+	m.clearedFields[strategy.FieldOutOfRangeTime] = struct{}{}
+}
+
+// OutOfRangeTimeCleared returns if the "outOfRangeTime" field was cleared in this mutation.
+func (m *StrategyMutation) OutOfRangeTimeCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldOutOfRangeTime]
+	return ok
+}
+
+// ResetOutOfRangeTime resets all changes to the "outOfRangeTime" field.
+func (m *StrategyMutation) ResetOutOfRangeTime() {
+	m.outOfRangeTime = nil
+	delete(m.clearedFields, strategy.FieldOutOfRangeTime)
+}
+
 // Where appends a list predicates to the StrategyMutation builder.
 func (m *StrategyMutation) Where(ps ...predicate.Strategy) {
 	m.predicates = append(m.predicates, ps...)
@@ -5515,7 +7799,7 @@ func (m *StrategyMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *StrategyMutation) Fields() []string {
-	fields := make([]string, 0, 31)
+	fields := make([]string, 0, 54)
 	if m.create_time != nil {
 		fields = append(fields, strategy.FieldCreateTime)
 	}
@@ -5540,9 +7824,48 @@ func (m *StrategyMutation) Fields() []string {
 	if m.maxGridLimit != nil {
 		fields = append(fields, strategy.FieldMaxGridLimit)
 	}
+	if m.gridMode != nil {
+		fields = append(fields, strategy.FieldGridMode)
+	}
+	if m.inventorySeeded != nil {
+		fields = append(fields, strategy.FieldInventorySeeded)
+	}
+	if m.gridType != nil {
+		fields = append(fields, strategy.FieldGridType)
+	}
+	if m.gridCount != nil {
+		fields = append(fields, strategy.FieldGridCount)
+	}
+	if m.priceStep != nil {
+		fields = append(fields, strategy.FieldPriceStep)
+	}
+	if m.gridLadder != nil {
+		fields = append(fields, strategy.FieldGridLadder)
+	}
 	if m.takeProfitRatio != nil {
 		fields = append(fields, strategy.FieldTakeProfitRatio)
 	}
+	if m.trailingTakeProfit != nil {
+		fields = append(fields, strategy.FieldTrailingTakeProfit)
+	}
+	if m.takeProfitPortion != nil {
+		fields = append(fields, strategy.FieldTakeProfitPortion)
+	}
+	if m.adaptiveSpacing != nil {
+		fields = append(fields, strategy.FieldAdaptiveSpacing)
+	}
+	if m.adaptiveMinRatio != nil {
+		fields = append(fields, strategy.FieldAdaptiveMinRatio)
+	}
+	if m.adaptiveMaxRatio != nil {
+		fields = append(fields, strategy.FieldAdaptiveMaxRatio)
+	}
+	if m.adaptiveVolatility != nil {
+		fields = append(fields, strategy.FieldAdaptiveVolatility)
+	}
+	if m.adaptiveUpdateTime != nil {
+		fields = append(fields, strategy.FieldAdaptiveUpdateTime)
+	}
 	if m.upperPriceBound != nil {
 		fields = append(fields, strategy.FieldUpperPriceBound)
 	}
@@ -5576,6 +7899,15 @@ func (m *StrategyMutation) Fields() []string {
 	if m.dynamicStopLoss != nil {
 		fields = append(fields, strategy.FieldDynamicStopLoss)
 	}
+	if m.trailingUp != nil {
+		fields = append(fields, strategy.FieldTrailingUp)
+	}
+	if m.trailingDown != nil {
+		fields = append(fields, strategy.FieldTrailingDown)
+	}
+	if m.trailingDwellMinutes != nil {
+		fields = append(fields, strategy.FieldTrailingDwellMinutes)
+	}
 	if m.dropOn != nil {
 		fields = append(fields, strategy.FieldDropOn)
 	}
@@ -5585,6 +7917,18 @@ func (m *StrategyMutation) Fields() []string {
 	if m.dropThreshold != nil {
 		fields = append(fields, strategy.FieldDropThreshold)
 	}
+	if m.rsiThreshold != nil {
+		fields = append(fields, strategy.FieldRsiThreshold)
+	}
+	if m.emaFilter != nil {
+		fields = append(fields, strategy.FieldEmaFilter)
+	}
+	if m.emaPeriod != nil {
+		fields = append(fields, strategy.FieldEmaPeriod)
+	}
+	if m.macdFilter != nil {
+		fields = append(fields, strategy.FieldMacdFilter)
+	}
 	if m.enableAutoBuy != nil {
 		fields = append(fields, strategy.FieldEnableAutoBuy)
 	}
@@ -5597,18 +7941,27 @@ func (m *StrategyMutation) Fields() []string {
 	if m.enablePushNotification != nil {
 		fields = append(fields, strategy.FieldEnablePushNotification)
 	}
+	if m.paperTrading != nil {
+		fields = append(fields, strategy.FieldPaperTrading)
+	}
 	if m.status != nil {
 		fields = append(fields, strategy.FieldStatus)
 	}
 	if m.gridTrend != nil {
 		fields = append(fields, strategy.FieldGridTrend)
 	}
+	if m.buyFilterReason != nil {
+		fields = append(fields, strategy.FieldBuyFilterReason)
+	}
 	if m.lastLowerThresholdAlertTime != nil {
 		fields = append(fields, strategy.FieldLastLowerThresholdAlertTime)
 	}
 	if m.lastUpperThresholdAlertTime != nil {
 		fields = append(fields, strategy.FieldLastUpperThresholdAlertTime)
 	}
+	if m.outOfRangeTime != nil {
+		fields = append(fields, strategy.FieldOutOfRangeTime)
+	}
 	return fields
 }
 
@@ -5633,8 +7986,34 @@ func (m *StrategyMutation) Field(name string) (ent.Value, bool) {
 		return m.MartinFactor()
 	case strategy.FieldMaxGridLimit:
 		return m.MaxGridLimit()
+	case strategy.FieldGridMode:
+		return m.GridMode()
+	case strategy.FieldInventorySeeded:
+		return m.InventorySeeded()
+	case strategy.FieldGridType:
+		return m.GridType()
+	case strategy.FieldGridCount:
+		return m.GridCount()
+	case strategy.FieldPriceStep:
+		return m.PriceStep()
+	case strategy.FieldGridLadder:
+		return m.GridLadder()
 	case strategy.FieldTakeProfitRatio:
 		return m.TakeProfitRatio()
+	case strategy.FieldTrailingTakeProfit:
+		return m.TrailingTakeProfit()
+	case strategy.FieldTakeProfitPortion:
+		return m.TakeProfitPortion()
+	case strategy.FieldAdaptiveSpacing:
+		return m.AdaptiveSpacing()
+	case strategy.FieldAdaptiveMinRatio:
+		return m.AdaptiveMinRatio()
+	case strategy.FieldAdaptiveMaxRatio:
+		return m.AdaptiveMaxRatio()
+	case strategy.FieldAdaptiveVolatility:
+		return m.AdaptiveVolatility()
+	case strategy.FieldAdaptiveUpdateTime:
+		return m.AdaptiveUpdateTime()
 	case strategy.FieldUpperPriceBound:
 		return m.UpperPriceBound()
 	case strategy.FieldLowerPriceBound:
@@ -5657,12 +8036,26 @@ func (m *StrategyMutation) Field(name string) (ent.Value, bool) {
 		return m.GlobalTakeProfitRatio()
 	case strategy.FieldDynamicStopLoss:
 		return m.DynamicStopLoss()
+	case strategy.FieldTrailingUp:
+		return m.TrailingUp()
+	case strategy.FieldTrailingDown:
+		return m.TrailingDown()
+	case strategy.FieldTrailingDwellMinutes:
+		return m.TrailingDwellMinutes()
 	case strategy.FieldDropOn:
 		return m.DropOn()
 	case strategy.FieldCandlesToCheck:
 		return m.CandlesToCheck()
 	case strategy.FieldDropThreshold:
 		return m.DropThreshold()
+	case strategy.FieldRsiThreshold:
+		return m.RsiThreshold()
+	case strategy.FieldEmaFilter:
+		return m.EmaFilter()
+	case strategy.FieldEmaPeriod:
+		return m.EmaPeriod()
+	case strategy.FieldMacdFilter:
+		return m.MacdFilter()
 	case strategy.FieldEnableAutoBuy:
 		return m.EnableAutoBuy()
 	case strategy.FieldEnableAutoSell:
@@ -5671,14 +8064,20 @@ func (m *StrategyMutation) Field(name string) (ent.Value, bool) {
 		return m.EnableAutoExit()
 	case strategy.FieldEnablePushNotification:
 		return m.EnablePushNotification()
+	case strategy.FieldPaperTrading:
+		return m.PaperTrading()
 	case strategy.FieldStatus:
 		return m.Status()
 	case strategy.FieldGridTrend:
 		return m.GridTrend()
+	case strategy.FieldBuyFilterReason:
+		return m.BuyFilterReason()
 	case strategy.FieldLastLowerThresholdAlertTime:
 		return m.LastLowerThresholdAlertTime()
 	case strategy.FieldLastUpperThresholdAlertTime:
 		return m.LastUpperThresholdAlertTime()
+	case strategy.FieldOutOfRangeTime:
+		return m.OutOfRangeTime()
 	}
 	return nil, false
 }
@@ -5704,8 +8103,34 @@ func (m *StrategyMutation) OldField(ctx context.Context, name string) (ent.Value
 		return m.OldMartinFactor(ctx)
 	case strategy.FieldMaxGridLimit:
 		return m.OldMaxGridLimit(ctx)
+	case strategy.FieldGridMode:
+		return m.OldGridMode(ctx)
+	case strategy.FieldInventorySeeded:
+		return m.OldInventorySeeded(ctx)
+	case strategy.FieldGridType:
+		return m.OldGridType(ctx)
+	case strategy.FieldGridCount:
+		return m.OldGridCount(ctx)
+	case strategy.FieldPriceStep:
+		return m.OldPriceStep(ctx)
+	case strategy.FieldGridLadder:
+		return m.OldGridLadder(ctx)
 	case strategy.FieldTakeProfitRatio:
 		return m.OldTakeProfitRatio(ctx)
+	case strategy.FieldTrailingTakeProfit:
+		return m.OldTrailingTakeProfit(ctx)
+	case strategy.FieldTakeProfitPortion:
+		return m.OldTakeProfitPortion(ctx)
+	case strategy.FieldAdaptiveSpacing:
+		return m.OldAdaptiveSpacing(ctx)
+	case strategy.FieldAdaptiveMinRatio:
+		return m.OldAdaptiveMinRatio(ctx)
+	case strategy.FieldAdaptiveMaxRatio:
+		return m.OldAdaptiveMaxRatio(ctx)
+	case strategy.FieldAdaptiveVolatility:
+		return m.OldAdaptiveVolatility(ctx)
+	case strategy.FieldAdaptiveUpdateTime:
+		return m.OldAdaptiveUpdateTime(ctx)
 	case strategy.FieldUpperPriceBound:
 		return m.OldUpperPriceBound(ctx)
 	case strategy.FieldLowerPriceBound:
@@ -5728,12 +8153,26 @@ func (m *StrategyMutation) OldField(ctx context.Context, name string) (ent.Value
 		return m.OldGlobalTakeProfitRatio(ctx)
 	case strategy.FieldDynamicStopLoss:
 		return m.OldDynamicStopLoss(ctx)
+	case strategy.FieldTrailingUp:
+		return m.OldTrailingUp(ctx)
+	case strategy.FieldTrailingDown:
+		return m.OldTrailingDown(ctx)
+	case strategy.FieldTrailingDwellMinutes:
+		return m.OldTrailingDwellMinutes(ctx)
 	case strategy.FieldDropOn:
 		return m.OldDropOn(ctx)
 	case strategy.FieldCandlesToCheck:
 		return m.OldCandlesToCheck(ctx)
 	case strategy.FieldDropThreshold:
 		return m.OldDropThreshold(ctx)
+	case strategy.FieldRsiThreshold:
+		return m.OldRsiThreshold(ctx)
+	case strategy.FieldEmaFilter:
+		return m.OldEmaFilter(ctx)
+	case strategy.FieldEmaPeriod:
+		return m.OldEmaPeriod(ctx)
+	case strategy.FieldMacdFilter:
+		return m.OldMacdFilter(ctx)
 	case strategy.FieldEnableAutoBuy:
 		return m.OldEnableAutoBuy(ctx)
 	case strategy.FieldEnableAutoSell:
@@ -5742,14 +8181,20 @@ func (m *StrategyMutation) OldField(ctx context.Context, name string) (ent.Value
 		return m.OldEnableAutoExit(ctx)
 	case strategy.FieldEnablePushNotification:
 		return m.OldEnablePushNotification(ctx)
+	case strategy.FieldPaperTrading:
+		return m.OldPaperTrading(ctx)
 	case strategy.FieldStatus:
 		return m.OldStatus(ctx)
 	case strategy.FieldGridTrend:
 		return m.OldGridTrend(ctx)
+	case strategy.FieldBuyFilterReason:
+		return m.OldBuyFilterReason(ctx)
 	case strategy.FieldLastLowerThresholdAlertTime:
 		return m.OldLastLowerThresholdAlertTime(ctx)
 	case strategy.FieldLastUpperThresholdAlertTime:
 		return m.OldLastUpperThresholdAlertTime(ctx)
+	case strategy.FieldOutOfRangeTime:
+		return m.OldOutOfRangeTime(ctx)
 	}
 	return nil, fmt.Errorf("unknown Strategy field %s", name)
 }
@@ -5815,6 +8260,48 @@ func (m *StrategyMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetMaxGridLimit(v)
 		return nil
+	case strategy.FieldGridMode:
+		v, ok := value.(strategy.GridMode)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGridMode(v)
+		return nil
+	case strategy.FieldInventorySeeded:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInventorySeeded(v)
+		return nil
+	case strategy.FieldGridType:
+		v, ok := value.(strategy.GridType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGridType(v)
+		return nil
+	case strategy.FieldGridCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGridCount(v)
+		return nil
+	case strategy.FieldPriceStep:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriceStep(v)
+		return nil
+	case strategy.FieldGridLadder:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGridLadder(v)
+		return nil
 	case strategy.FieldTakeProfitRatio:
 		v, ok := value.(decimal.Decimal)
 		if !ok {
@@ -5822,6 +8309,55 @@ func (m *StrategyMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetTakeProfitRatio(v)
 		return nil
+	case strategy.FieldTrailingTakeProfit:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrailingTakeProfit(v)
+		return nil
+	case strategy.FieldTakeProfitPortion:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTakeProfitPortion(v)
+		return nil
+	case strategy.FieldAdaptiveSpacing:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAdaptiveSpacing(v)
+		return nil
+	case strategy.FieldAdaptiveMinRatio:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAdaptiveMinRatio(v)
+		return nil
+	case strategy.FieldAdaptiveMaxRatio:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAdaptiveMaxRatio(v)
+		return nil
+	case strategy.FieldAdaptiveVolatility:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAdaptiveVolatility(v)
+		return nil
+	case strategy.FieldAdaptiveUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAdaptiveUpdateTime(v)
+		return nil
 	case strategy.FieldUpperPriceBound:
 		v, ok := value.(decimal.Decimal)
 		if !ok {
@@ -5899,6 +8435,27 @@ func (m *StrategyMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetDynamicStopLoss(v)
 		return nil
+	case strategy.FieldTrailingUp:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrailingUp(v)
+		return nil
+	case strategy.FieldTrailingDown:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrailingDown(v)
+		return nil
+	case strategy.FieldTrailingDwellMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrailingDwellMinutes(v)
+		return nil
 	case strategy.FieldDropOn:
 		v, ok := value.(bool)
 		if !ok {
@@ -5920,6 +8477,34 @@ func (m *StrategyMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetDropThreshold(v)
 		return nil
+	case strategy.FieldRsiThreshold:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRsiThreshold(v)
+		return nil
+	case strategy.FieldEmaFilter:
+		v, ok := value.(strategy.EmaFilter)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmaFilter(v)
+		return nil
+	case strategy.FieldEmaPeriod:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmaPeriod(v)
+		return nil
+	case strategy.FieldMacdFilter:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMacdFilter(v)
+		return nil
 	case strategy.FieldEnableAutoBuy:
 		v, ok := value.(bool)
 		if !ok {
@@ -5948,6 +8533,13 @@ func (m *StrategyMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetEnablePushNotification(v)
 		return nil
+	case strategy.FieldPaperTrading:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPaperTrading(v)
+		return nil
 	case strategy.FieldStatus:
 		v, ok := value.(strategy.Status)
 		if !ok {
@@ -5962,6 +8554,13 @@ func (m *StrategyMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetGridTrend(v)
 		return nil
+	case strategy.FieldBuyFilterReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuyFilterReason(v)
+		return nil
 	case strategy.FieldLastLowerThresholdAlertTime:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -5976,6 +8575,13 @@ func (m *StrategyMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetLastUpperThresholdAlertTime(v)
 		return nil
+	case strategy.FieldOutOfRangeTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOutOfRangeTime(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Strategy field %s", name)
 }
@@ -5993,12 +8599,21 @@ func (m *StrategyMutation) AddedFields() []string {
 	if m.addmaxGridLimit != nil {
 		fields = append(fields, strategy.FieldMaxGridLimit)
 	}
+	if m.addgridCount != nil {
+		fields = append(fields, strategy.FieldGridCount)
+	}
 	if m.addfirstOrderId != nil {
 		fields = append(fields, strategy.FieldFirstOrderId)
 	}
+	if m.addtrailingDwellMinutes != nil {
+		fields = append(fields, strategy.FieldTrailingDwellMinutes)
+	}
 	if m.addcandlesToCheck != nil {
 		fields = append(fields, strategy.FieldCandlesToCheck)
 	}
+	if m.addemaPeriod != nil {
+		fields = append(fields, strategy.FieldEmaPeriod)
+	}
 	return fields
 }
 
@@ -6013,10 +8628,16 @@ func (m *StrategyMutation) AddedField(name string) (ent.Value, bool) {
 		return m.AddedMartinFactor()
 	case strategy.FieldMaxGridLimit:
 		return m.AddedMaxGridLimit()
+	case strategy.FieldGridCount:
+		return m.AddedGridCount()
 	case strategy.FieldFirstOrderId:
 		return m.AddedFirstOrderId()
+	case strategy.FieldTrailingDwellMinutes:
+		return m.AddedTrailingDwellMinutes()
 	case strategy.FieldCandlesToCheck:
 		return m.AddedCandlesToCheck()
+	case strategy.FieldEmaPeriod:
+		return m.AddedEmaPeriod()
 	}
 	return nil, false
 }
@@ -6047,6 +8668,13 @@ func (m *StrategyMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddMaxGridLimit(v)
 		return nil
+	case strategy.FieldGridCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGridCount(v)
+		return nil
 	case strategy.FieldFirstOrderId:
 		v, ok := value.(int)
 		if !ok {
@@ -6054,6 +8682,13 @@ func (m *StrategyMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddFirstOrderId(v)
 		return nil
+	case strategy.FieldTrailingDwellMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTrailingDwellMinutes(v)
+		return nil
 	case strategy.FieldCandlesToCheck:
 		v, ok := value.(int)
 		if !ok {
@@ -6061,6 +8696,13 @@ func (m *StrategyMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddCandlesToCheck(v)
 		return nil
+	case strategy.FieldEmaPeriod:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEmaPeriod(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Strategy numeric field %s", name)
 }
@@ -6072,6 +8714,39 @@ func (m *StrategyMutation) ClearedFields() []string {
 	if m.FieldCleared(strategy.FieldMaxGridLimit) {
 		fields = append(fields, strategy.FieldMaxGridLimit)
 	}
+	if m.FieldCleared(strategy.FieldInventorySeeded) {
+		fields = append(fields, strategy.FieldInventorySeeded)
+	}
+	if m.FieldCleared(strategy.FieldGridCount) {
+		fields = append(fields, strategy.FieldGridCount)
+	}
+	if m.FieldCleared(strategy.FieldPriceStep) {
+		fields = append(fields, strategy.FieldPriceStep)
+	}
+	if m.FieldCleared(strategy.FieldGridLadder) {
+		fields = append(fields, strategy.FieldGridLadder)
+	}
+	if m.FieldCleared(strategy.FieldTrailingTakeProfit) {
+		fields = append(fields, strategy.FieldTrailingTakeProfit)
+	}
+	if m.FieldCleared(strategy.FieldTakeProfitPortion) {
+		fields = append(fields, strategy.FieldTakeProfitPortion)
+	}
+	if m.FieldCleared(strategy.FieldAdaptiveSpacing) {
+		fields = append(fields, strategy.FieldAdaptiveSpacing)
+	}
+	if m.FieldCleared(strategy.FieldAdaptiveMinRatio) {
+		fields = append(fields, strategy.FieldAdaptiveMinRatio)
+	}
+	if m.FieldCleared(strategy.FieldAdaptiveMaxRatio) {
+		fields = append(fields, strategy.FieldAdaptiveMaxRatio)
+	}
+	if m.FieldCleared(strategy.FieldAdaptiveVolatility) {
+		fields = append(fields, strategy.FieldAdaptiveVolatility)
+	}
+	if m.FieldCleared(strategy.FieldAdaptiveUpdateTime) {
+		fields = append(fields, strategy.FieldAdaptiveUpdateTime)
+	}
 	if m.FieldCleared(strategy.FieldLastKlineVolume) {
 		fields = append(fields, strategy.FieldLastKlineVolume)
 	}
@@ -6096,6 +8771,15 @@ func (m *StrategyMutation) ClearedFields() []string {
 	if m.FieldCleared(strategy.FieldDynamicStopLoss) {
 		fields = append(fields, strategy.FieldDynamicStopLoss)
 	}
+	if m.FieldCleared(strategy.FieldTrailingUp) {
+		fields = append(fields, strategy.FieldTrailingUp)
+	}
+	if m.FieldCleared(strategy.FieldTrailingDown) {
+		fields = append(fields, strategy.FieldTrailingDown)
+	}
+	if m.FieldCleared(strategy.FieldTrailingDwellMinutes) {
+		fields = append(fields, strategy.FieldTrailingDwellMinutes)
+	}
 	if m.FieldCleared(strategy.FieldDropOn) {
 		fields = append(fields, strategy.FieldDropOn)
 	}
@@ -6105,15 +8789,33 @@ func (m *StrategyMutation) ClearedFields() []string {
 	if m.FieldCleared(strategy.FieldDropThreshold) {
 		fields = append(fields, strategy.FieldDropThreshold)
 	}
+	if m.FieldCleared(strategy.FieldRsiThreshold) {
+		fields = append(fields, strategy.FieldRsiThreshold)
+	}
+	if m.FieldCleared(strategy.FieldEmaPeriod) {
+		fields = append(fields, strategy.FieldEmaPeriod)
+	}
+	if m.FieldCleared(strategy.FieldMacdFilter) {
+		fields = append(fields, strategy.FieldMacdFilter)
+	}
+	if m.FieldCleared(strategy.FieldPaperTrading) {
+		fields = append(fields, strategy.FieldPaperTrading)
+	}
 	if m.FieldCleared(strategy.FieldGridTrend) {
 		fields = append(fields, strategy.FieldGridTrend)
 	}
+	if m.FieldCleared(strategy.FieldBuyFilterReason) {
+		fields = append(fields, strategy.FieldBuyFilterReason)
+	}
 	if m.FieldCleared(strategy.FieldLastLowerThresholdAlertTime) {
 		fields = append(fields, strategy.FieldLastLowerThresholdAlertTime)
 	}
 	if m.FieldCleared(strategy.FieldLastUpperThresholdAlertTime) {
 		fields = append(fields, strategy.FieldLastUpperThresholdAlertTime)
 	}
+	if m.FieldCleared(strategy.FieldOutOfRangeTime) {
+		fields = append(fields, strategy.FieldOutOfRangeTime)
+	}
 	return fields
 }
 
@@ -6131,6 +8833,39 @@ func (m *StrategyMutation) ClearField(name string) error {
 	case strategy.FieldMaxGridLimit:
 		m.ClearMaxGridLimit()
 		return nil
+	case strategy.FieldInventorySeeded:
+		m.ClearInventorySeeded()
+		return nil
+	case strategy.FieldGridCount:
+		m.ClearGridCount()
+		return nil
+	case strategy.FieldPriceStep:
+		m.ClearPriceStep()
+		return nil
+	case strategy.FieldGridLadder:
+		m.ClearGridLadder()
+		return nil
+	case strategy.FieldTrailingTakeProfit:
+		m.ClearTrailingTakeProfit()
+		return nil
+	case strategy.FieldTakeProfitPortion:
+		m.ClearTakeProfitPortion()
+		return nil
+	case strategy.FieldAdaptiveSpacing:
+		m.ClearAdaptiveSpacing()
+		return nil
+	case strategy.FieldAdaptiveMinRatio:
+		m.ClearAdaptiveMinRatio()
+		return nil
+	case strategy.FieldAdaptiveMaxRatio:
+		m.ClearAdaptiveMaxRatio()
+		return nil
+	case strategy.FieldAdaptiveVolatility:
+		m.ClearAdaptiveVolatility()
+		return nil
+	case strategy.FieldAdaptiveUpdateTime:
+		m.ClearAdaptiveUpdateTime()
+		return nil
 	case strategy.FieldLastKlineVolume:
 		m.ClearLastKlineVolume()
 		return nil
@@ -6155,6 +8890,15 @@ func (m *StrategyMutation) ClearField(name string) error {
 	case strategy.FieldDynamicStopLoss:
 		m.ClearDynamicStopLoss()
 		return nil
+	case strategy.FieldTrailingUp:
+		m.ClearTrailingUp()
+		return nil
+	case strategy.FieldTrailingDown:
+		m.ClearTrailingDown()
+		return nil
+	case strategy.FieldTrailingDwellMinutes:
+		m.ClearTrailingDwellMinutes()
+		return nil
 	case strategy.FieldDropOn:
 		m.ClearDropOn()
 		return nil
@@ -6164,15 +8908,33 @@ func (m *StrategyMutation) ClearField(name string) error {
 	case strategy.FieldDropThreshold:
 		m.ClearDropThreshold()
 		return nil
+	case strategy.FieldRsiThreshold:
+		m.ClearRsiThreshold()
+		return nil
+	case strategy.FieldEmaPeriod:
+		m.ClearEmaPeriod()
+		return nil
+	case strategy.FieldMacdFilter:
+		m.ClearMacdFilter()
+		return nil
+	case strategy.FieldPaperTrading:
+		m.ClearPaperTrading()
+		return nil
 	case strategy.FieldGridTrend:
 		m.ClearGridTrend()
 		return nil
+	case strategy.FieldBuyFilterReason:
+		m.ClearBuyFilterReason()
+		return nil
 	case strategy.FieldLastLowerThresholdAlertTime:
 		m.ClearLastLowerThresholdAlertTime()
 		return nil
 	case strategy.FieldLastUpperThresholdAlertTime:
 		m.ClearLastUpperThresholdAlertTime()
 		return nil
+	case strategy.FieldOutOfRangeTime:
+		m.ClearOutOfRangeTime()
+		return nil
 	}
 	return fmt.Errorf("unknown Strategy nullable field %s", name)
 }
@@ -6205,9 +8967,48 @@ func (m *StrategyMutation) ResetField(name string) error {
 	case strategy.FieldMaxGridLimit:
 		m.ResetMaxGridLimit()
 		return nil
+	case strategy.FieldGridMode:
+		m.ResetGridMode()
+		return nil
+	case strategy.FieldInventorySeeded:
+		m.ResetInventorySeeded()
+		return nil
+	case strategy.FieldGridType:
+		m.ResetGridType()
+		return nil
+	case strategy.FieldGridCount:
+		m.ResetGridCount()
+		return nil
+	case strategy.FieldPriceStep:
+		m.ResetPriceStep()
+		return nil
+	case strategy.FieldGridLadder:
+		m.ResetGridLadder()
+		return nil
 	case strategy.FieldTakeProfitRatio:
 		m.ResetTakeProfitRatio()
 		return nil
+	case strategy.FieldTrailingTakeProfit:
+		m.ResetTrailingTakeProfit()
+		return nil
+	case strategy.FieldTakeProfitPortion:
+		m.ResetTakeProfitPortion()
+		return nil
+	case strategy.FieldAdaptiveSpacing:
+		m.ResetAdaptiveSpacing()
+		return nil
+	case strategy.FieldAdaptiveMinRatio:
+		m.ResetAdaptiveMinRatio()
+		return nil
+	case strategy.FieldAdaptiveMaxRatio:
+		m.ResetAdaptiveMaxRatio()
+		return nil
+	case strategy.FieldAdaptiveVolatility:
+		m.ResetAdaptiveVolatility()
+		return nil
+	case strategy.FieldAdaptiveUpdateTime:
+		m.ResetAdaptiveUpdateTime()
+		return nil
 	case strategy.FieldUpperPriceBound:
 		m.ResetUpperPriceBound()
 		return nil
@@ -6241,6 +9042,15 @@ func (m *StrategyMutation) ResetField(name string) error {
 	case strategy.FieldDynamicStopLoss:
 		m.ResetDynamicStopLoss()
 		return nil
+	case strategy.FieldTrailingUp:
+		m.ResetTrailingUp()
+		return nil
+	case strategy.FieldTrailingDown:
+		m.ResetTrailingDown()
+		return nil
+	case strategy.FieldTrailingDwellMinutes:
+		m.ResetTrailingDwellMinutes()
+		return nil
 	case strategy.FieldDropOn:
 		m.ResetDropOn()
 		return nil
@@ -6250,6 +9060,18 @@ func (m *StrategyMutation) ResetField(name string) error {
 	case strategy.FieldDropThreshold:
 		m.ResetDropThreshold()
 		return nil
+	case strategy.FieldRsiThreshold:
+		m.ResetRsiThreshold()
+		return nil
+	case strategy.FieldEmaFilter:
+		m.ResetEmaFilter()
+		return nil
+	case strategy.FieldEmaPeriod:
+		m.ResetEmaPeriod()
+		return nil
+	case strategy.FieldMacdFilter:
+		m.ResetMacdFilter()
+		return nil
 	case strategy.FieldEnableAutoBuy:
 		m.ResetEnableAutoBuy()
 		return nil
@@ -6262,18 +9084,27 @@ func (m *StrategyMutation) ResetField(name string) error {
 	case strategy.FieldEnablePushNotification:
 		m.ResetEnablePushNotification()
 		return nil
+	case strategy.FieldPaperTrading:
+		m.ResetPaperTrading()
+		return nil
 	case strategy.FieldStatus:
 		m.ResetStatus()
 		return nil
 	case strategy.FieldGridTrend:
 		m.ResetGridTrend()
 		return nil
+	case strategy.FieldBuyFilterReason:
+		m.ResetBuyFilterReason()
+		return nil
 	case strategy.FieldLastLowerThresholdAlertTime:
 		m.ResetLastLowerThresholdAlertTime()
 		return nil
 	case strategy.FieldLastUpperThresholdAlertTime:
 		m.ResetLastUpperThresholdAlertTime()
 		return nil
+	case strategy.FieldOutOfRangeTime:
+		m.ResetOutOfRangeTime()
+		return nil
 	}
 	return fmt.Errorf("unknown Strategy field %s", name)
 }
@@ -56,8 +56,30 @@ type Order struct {
 	// Reason holds the value of the "reason" field.
 	Reason string `json:"reason,omitempty"`
 	// Profit holds the value of the "profit" field.
-	Profit       *decimal.Decimal `json:"profit,omitempty"`
-	selectValues sql.SelectValues
+	Profit *decimal.Decimal `json:"profit,omitempty"`
+	// Paper holds the value of the "paper" field.
+	Paper bool `json:"paper,omitempty"`
+	// RawTx holds the value of the "rawTx" field.
+	RawTx *string `json:"rawTx,omitempty"`
+	// ReplacedTxHashes holds the value of the "replacedTxHashes" field.
+	ReplacedTxHashes *string `json:"replacedTxHashes,omitempty"`
+	// ReplaceCount holds the value of the "replaceCount" field.
+	ReplaceCount int `json:"replaceCount,omitempty"`
+	// ReplaceTime holds the value of the "replaceTime" field.
+	ReplaceTime *time.Time `json:"replaceTime,omitempty"`
+	// Cancelled holds the value of the "cancelled" field.
+	Cancelled bool `json:"cancelled,omitempty"`
+	// RetryCount holds the value of the "retryCount" field.
+	RetryCount int `json:"retryCount,omitempty"`
+	// NextRetryTime holds the value of the "nextRetryTime" field.
+	NextRetryTime *time.Time `json:"nextRetryTime,omitempty"`
+	// Route holds the value of the "route" field.
+	Route *string `json:"route,omitempty"`
+	// RunnerUpRoute holds the value of the "runnerUpRoute" field.
+	RunnerUpRoute *string `json:"runnerUpRoute,omitempty"`
+	// RouteSpreadUsd holds the value of the "routeSpreadUsd" field.
+	RouteSpreadUsd *decimal.Decimal `json:"routeSpreadUsd,omitempty"`
+	selectValues   sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -65,15 +87,17 @@ func (*Order) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case order.FieldGridBuyCost, order.FieldProfit:
+		case order.FieldGridBuyCost, order.FieldProfit, order.FieldRouteSpreadUsd:
 			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
 		case order.FieldPrice, order.FieldFinalPrice, order.FieldInAmount, order.FieldOutAmount:
 			values[i] = new(decimal.Decimal)
-		case order.FieldID, order.FieldGridNumber, order.FieldNonce:
+		case order.FieldPaper, order.FieldCancelled:
+			values[i] = new(sql.NullBool)
+		case order.FieldID, order.FieldGridNumber, order.FieldNonce, order.FieldReplaceCount, order.FieldRetryCount:
 			values[i] = new(sql.NullInt64)
-		case order.FieldAccount, order.FieldToken, order.FieldSymbol, order.FieldGridId, order.FieldStrategyId, order.FieldType, order.FieldStatus, order.FieldTxHash, order.FieldReason:
+		case order.FieldAccount, order.FieldToken, order.FieldSymbol, order.FieldGridId, order.FieldStrategyId, order.FieldType, order.FieldStatus, order.FieldTxHash, order.FieldReason, order.FieldRawTx, order.FieldReplacedTxHashes, order.FieldRoute, order.FieldRunnerUpRoute:
 			values[i] = new(sql.NullString)
-		case order.FieldCreateTime, order.FieldUpdateTime:
+		case order.FieldCreateTime, order.FieldUpdateTime, order.FieldReplaceTime, order.FieldNextRetryTime:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -214,6 +238,79 @@ func (_m *Order) assignValues(columns []string, values []any) error {
 				_m.Profit = new(decimal.Decimal)
 				*_m.Profit = *value.S.(*decimal.Decimal)
 			}
+		case order.FieldPaper:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field paper", values[i])
+			} else if value.Valid {
+				_m.Paper = value.Bool
+			}
+		case order.FieldRawTx:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rawTx", values[i])
+			} else if value.Valid {
+				_m.RawTx = new(string)
+				*_m.RawTx = value.String
+			}
+		case order.FieldReplacedTxHashes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field replacedTxHashes", values[i])
+			} else if value.Valid {
+				_m.ReplacedTxHashes = new(string)
+				*_m.ReplacedTxHashes = value.String
+			}
+		case order.FieldReplaceCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field replaceCount", values[i])
+			} else if value.Valid {
+				_m.ReplaceCount = int(value.Int64)
+			}
+		case order.FieldReplaceTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field replaceTime", values[i])
+			} else if value.Valid {
+				_m.ReplaceTime = new(time.Time)
+				*_m.ReplaceTime = value.Time
+			}
+		case order.FieldCancelled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field cancelled", values[i])
+			} else if value.Valid {
+				_m.Cancelled = value.Bool
+			}
+		case order.FieldRetryCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field retryCount", values[i])
+			} else if value.Valid {
+				_m.RetryCount = int(value.Int64)
+			}
+		case order.FieldNextRetryTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field nextRetryTime", values[i])
+			} else if value.Valid {
+				_m.NextRetryTime = new(time.Time)
+				*_m.NextRetryTime = value.Time
+			}
+		case order.FieldRoute:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field route", values[i])
+			} else if value.Valid {
+				_m.Route = new(string)
+				*_m.Route = value.String
+			}
+		case order.FieldRunnerUpRoute:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field runnerUpRoute", values[i])
+			} else if value.Valid {
+				_m.RunnerUpRoute = new(string)
+				*_m.RunnerUpRoute = value.String
+			}
+		case order.FieldRouteSpreadUsd:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field routeSpreadUsd", values[i])
+			} else if value.Valid {
+				_m.RouteSpreadUsd = new(decimal.Decimal)
+				*_m.RouteSpreadUsd = *value.S.(*decimal.Decimal)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -314,6 +411,53 @@ func (_m *Order) String() string {
 		builder.WriteString("profit=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("paper=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Paper))
+	builder.WriteString(", ")
+	if v := _m.RawTx; v != nil {
+		builder.WriteString("rawTx=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ReplacedTxHashes; v != nil {
+		builder.WriteString("replacedTxHashes=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("replaceCount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReplaceCount))
+	builder.WriteString(", ")
+	if v := _m.ReplaceTime; v != nil {
+		builder.WriteString("replaceTime=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("cancelled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Cancelled))
+	builder.WriteString(", ")
+	builder.WriteString("retryCount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RetryCount))
+	builder.WriteString(", ")
+	if v := _m.NextRetryTime; v != nil {
+		builder.WriteString("nextRetryTime=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.Route; v != nil {
+		builder.WriteString("route=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.RunnerUpRoute; v != nil {
+		builder.WriteString("runnerUpRoute=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.RouteSpreadUsd; v != nil {
+		builder.WriteString("routeSpreadUsd=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
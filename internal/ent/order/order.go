@@ -52,6 +52,28 @@ const (
 	FieldReason = "reason"
 	// FieldProfit holds the string denoting the profit field in the database.
 	FieldProfit = "profit"
+	// FieldPaper holds the string denoting the paper field in the database.
+	FieldPaper = "paper"
+	// FieldRawTx holds the string denoting the rawtx field in the database.
+	FieldRawTx = "raw_tx"
+	// FieldReplacedTxHashes holds the string denoting the replacedtxhashes field in the database.
+	FieldReplacedTxHashes = "replaced_tx_hashes"
+	// FieldReplaceCount holds the string denoting the replacecount field in the database.
+	FieldReplaceCount = "replace_count"
+	// FieldReplaceTime holds the string denoting the replacetime field in the database.
+	FieldReplaceTime = "replace_time"
+	// FieldCancelled holds the string denoting the cancelled field in the database.
+	FieldCancelled = "cancelled"
+	// FieldRetryCount holds the string denoting the retrycount field in the database.
+	FieldRetryCount = "retry_count"
+	// FieldNextRetryTime holds the string denoting the nextretrytime field in the database.
+	FieldNextRetryTime = "next_retry_time"
+	// FieldRoute holds the string denoting the route field in the database.
+	FieldRoute = "route"
+	// FieldRunnerUpRoute holds the string denoting the runneruproute field in the database.
+	FieldRunnerUpRoute = "runner_up_route"
+	// FieldRouteSpreadUsd holds the string denoting the routespreadusd field in the database.
+	FieldRouteSpreadUsd = "route_spread_usd"
 	// Table holds the table name of the order in the database.
 	Table = "orders"
 )
@@ -78,6 +100,17 @@ var Columns = []string{
 	FieldTxHash,
 	FieldReason,
 	FieldProfit,
+	FieldPaper,
+	FieldRawTx,
+	FieldReplacedTxHashes,
+	FieldReplaceCount,
+	FieldReplaceTime,
+	FieldCancelled,
+	FieldRetryCount,
+	FieldNextRetryTime,
+	FieldRoute,
+	FieldRunnerUpRoute,
+	FieldRouteSpreadUsd,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -111,6 +144,16 @@ var (
 	TxHashValidator func(string) error
 	// ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
 	ReasonValidator func(string) error
+	// DefaultReplaceCount holds the default value on creation for the "replaceCount" field.
+	DefaultReplaceCount int
+	// DefaultCancelled holds the default value on creation for the "cancelled" field.
+	DefaultCancelled bool
+	// DefaultRetryCount holds the default value on creation for the "retryCount" field.
+	DefaultRetryCount int
+	// RouteValidator is a validator for the "route" field. It is called by the builders before save.
+	RouteValidator func(string) error
+	// RunnerUpRouteValidator is a validator for the "runnerUpRoute" field. It is called by the builders before save.
+	RunnerUpRouteValidator func(string) error
 )
 
 // Type defines the type for the "type" enum field.
@@ -141,9 +184,14 @@ type Status string
 
 // Status values.
 const (
-	StatusPending  Status = "pending"
-	StatusClosed   Status = "closed"
-	StatusRejected Status = "rejected"
+	StatusSubmitting Status = "submitting"
+	StatusPending    Status = "pending"
+	StatusSubmitted  Status = "submitted"
+	StatusTimeout    Status = "timeout"
+	StatusReplaced   Status = "replaced"
+	StatusDropped    Status = "dropped"
+	StatusClosed     Status = "closed"
+	StatusRejected   Status = "rejected"
 )
 
 func (s Status) String() string {
@@ -153,7 +201,7 @@ func (s Status) String() string {
 // StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
 func StatusValidator(s Status) error {
 	switch s {
-	case StatusPending, StatusClosed, StatusRejected:
+	case StatusSubmitting, StatusPending, StatusSubmitted, StatusTimeout, StatusReplaced, StatusDropped, StatusClosed, StatusRejected:
 		return nil
 	default:
 		return fmt.Errorf("order: invalid enum value for status field: %q", s)
@@ -262,3 +310,58 @@ func ByReason(opts ...sql.OrderTermOption) OrderOption {
 func ByProfit(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldProfit, opts...).ToFunc()
 }
+
+// ByPaper orders the results by the paper field.
+func ByPaper(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaper, opts...).ToFunc()
+}
+
+// ByRawTx orders the results by the rawTx field.
+func ByRawTx(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRawTx, opts...).ToFunc()
+}
+
+// ByReplacedTxHashes orders the results by the replacedTxHashes field.
+func ByReplacedTxHashes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReplacedTxHashes, opts...).ToFunc()
+}
+
+// ByReplaceCount orders the results by the replaceCount field.
+func ByReplaceCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReplaceCount, opts...).ToFunc()
+}
+
+// ByReplaceTime orders the results by the replaceTime field.
+func ByReplaceTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReplaceTime, opts...).ToFunc()
+}
+
+// ByCancelled orders the results by the cancelled field.
+func ByCancelled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancelled, opts...).ToFunc()
+}
+
+// ByRetryCount orders the results by the retryCount field.
+func ByRetryCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRetryCount, opts...).ToFunc()
+}
+
+// ByNextRetryTime orders the results by the nextRetryTime field.
+func ByNextRetryTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextRetryTime, opts...).ToFunc()
+}
+
+// ByRoute orders the results by the route field.
+func ByRoute(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRoute, opts...).ToFunc()
+}
+
+// ByRunnerUpRoute orders the results by the runnerUpRoute field.
+func ByRunnerUpRoute(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRunnerUpRoute, opts...).ToFunc()
+}
+
+// ByRouteSpreadUsd orders the results by the routeSpreadUsd field.
+func ByRouteSpreadUsd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRouteSpreadUsd, opts...).ToFunc()
+}
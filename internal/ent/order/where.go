@@ -141,6 +141,61 @@ func Profit(v decimal.Decimal) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldProfit, v))
 }
 
+// Paper applies equality check predicate on the "paper" field. It's identical to PaperEQ.
+func Paper(v bool) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldPaper, v))
+}
+
+// RawTx applies equality check predicate on the "rawTx" field. It's identical to RawTxEQ.
+func RawTx(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldRawTx, v))
+}
+
+// ReplacedTxHashes applies equality check predicate on the "replacedTxHashes" field. It's identical to ReplacedTxHashesEQ.
+func ReplacedTxHashes(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldReplacedTxHashes, v))
+}
+
+// ReplaceCount applies equality check predicate on the "replaceCount" field. It's identical to ReplaceCountEQ.
+func ReplaceCount(v int) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldReplaceCount, v))
+}
+
+// ReplaceTime applies equality check predicate on the "replaceTime" field. It's identical to ReplaceTimeEQ.
+func ReplaceTime(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldReplaceTime, v))
+}
+
+// Cancelled applies equality check predicate on the "cancelled" field. It's identical to CancelledEQ.
+func Cancelled(v bool) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldCancelled, v))
+}
+
+// RetryCount applies equality check predicate on the "retryCount" field. It's identical to RetryCountEQ.
+func RetryCount(v int) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldRetryCount, v))
+}
+
+// NextRetryTime applies equality check predicate on the "nextRetryTime" field. It's identical to NextRetryTimeEQ.
+func NextRetryTime(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldNextRetryTime, v))
+}
+
+// Route applies equality check predicate on the "route" field. It's identical to RouteEQ.
+func Route(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldRoute, v))
+}
+
+// RunnerUpRoute applies equality check predicate on the "runnerUpRoute" field. It's identical to RunnerUpRouteEQ.
+func RunnerUpRoute(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldRunnerUpRoute, v))
+}
+
+// RouteSpreadUsd applies equality check predicate on the "routeSpreadUsd" field. It's identical to RouteSpreadUsdEQ.
+func RouteSpreadUsd(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldRouteSpreadUsd, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldCreateTime, v))
@@ -1256,6 +1311,596 @@ func ProfitContainsFold(v decimal.Decimal) predicate.Order {
 	return predicate.Order(sql.FieldContainsFold(FieldProfit, vc))
 }
 
+// PaperEQ applies the EQ predicate on the "paper" field.
+func PaperEQ(v bool) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldPaper, v))
+}
+
+// PaperNEQ applies the NEQ predicate on the "paper" field.
+func PaperNEQ(v bool) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldPaper, v))
+}
+
+// PaperIsNil applies the IsNil predicate on the "paper" field.
+func PaperIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldPaper))
+}
+
+// PaperNotNil applies the NotNil predicate on the "paper" field.
+func PaperNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldPaper))
+}
+
+// RawTxEQ applies the EQ predicate on the "rawTx" field.
+func RawTxEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldRawTx, v))
+}
+
+// RawTxNEQ applies the NEQ predicate on the "rawTx" field.
+func RawTxNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldRawTx, v))
+}
+
+// RawTxIn applies the In predicate on the "rawTx" field.
+func RawTxIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldRawTx, vs...))
+}
+
+// RawTxNotIn applies the NotIn predicate on the "rawTx" field.
+func RawTxNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldRawTx, vs...))
+}
+
+// RawTxGT applies the GT predicate on the "rawTx" field.
+func RawTxGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldRawTx, v))
+}
+
+// RawTxGTE applies the GTE predicate on the "rawTx" field.
+func RawTxGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldRawTx, v))
+}
+
+// RawTxLT applies the LT predicate on the "rawTx" field.
+func RawTxLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldRawTx, v))
+}
+
+// RawTxLTE applies the LTE predicate on the "rawTx" field.
+func RawTxLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldRawTx, v))
+}
+
+// RawTxContains applies the Contains predicate on the "rawTx" field.
+func RawTxContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldRawTx, v))
+}
+
+// RawTxHasPrefix applies the HasPrefix predicate on the "rawTx" field.
+func RawTxHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldRawTx, v))
+}
+
+// RawTxHasSuffix applies the HasSuffix predicate on the "rawTx" field.
+func RawTxHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldRawTx, v))
+}
+
+// RawTxIsNil applies the IsNil predicate on the "rawTx" field.
+func RawTxIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldRawTx))
+}
+
+// RawTxNotNil applies the NotNil predicate on the "rawTx" field.
+func RawTxNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldRawTx))
+}
+
+// RawTxEqualFold applies the EqualFold predicate on the "rawTx" field.
+func RawTxEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldRawTx, v))
+}
+
+// RawTxContainsFold applies the ContainsFold predicate on the "rawTx" field.
+func RawTxContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldRawTx, v))
+}
+
+// ReplacedTxHashesEQ applies the EQ predicate on the "replacedTxHashes" field.
+func ReplacedTxHashesEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldReplacedTxHashes, v))
+}
+
+// ReplacedTxHashesNEQ applies the NEQ predicate on the "replacedTxHashes" field.
+func ReplacedTxHashesNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldReplacedTxHashes, v))
+}
+
+// ReplacedTxHashesIn applies the In predicate on the "replacedTxHashes" field.
+func ReplacedTxHashesIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldReplacedTxHashes, vs...))
+}
+
+// ReplacedTxHashesNotIn applies the NotIn predicate on the "replacedTxHashes" field.
+func ReplacedTxHashesNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldReplacedTxHashes, vs...))
+}
+
+// ReplacedTxHashesGT applies the GT predicate on the "replacedTxHashes" field.
+func ReplacedTxHashesGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldReplacedTxHashes, v))
+}
+
+// ReplacedTxHashesGTE applies the GTE predicate on the "replacedTxHashes" field.
+func ReplacedTxHashesGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldReplacedTxHashes, v))
+}
+
+// ReplacedTxHashesLT applies the LT predicate on the "replacedTxHashes" field.
+func ReplacedTxHashesLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldReplacedTxHashes, v))
+}
+
+// ReplacedTxHashesLTE applies the LTE predicate on the "replacedTxHashes" field.
+func ReplacedTxHashesLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldReplacedTxHashes, v))
+}
+
+// ReplacedTxHashesContains applies the Contains predicate on the "replacedTxHashes" field.
+func ReplacedTxHashesContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldReplacedTxHashes, v))
+}
+
+// ReplacedTxHashesHasPrefix applies the HasPrefix predicate on the "replacedTxHashes" field.
+func ReplacedTxHashesHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldReplacedTxHashes, v))
+}
+
+// ReplacedTxHashesHasSuffix applies the HasSuffix predicate on the "replacedTxHashes" field.
+func ReplacedTxHashesHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldReplacedTxHashes, v))
+}
+
+// ReplacedTxHashesIsNil applies the IsNil predicate on the "replacedTxHashes" field.
+func ReplacedTxHashesIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldReplacedTxHashes))
+}
+
+// ReplacedTxHashesNotNil applies the NotNil predicate on the "replacedTxHashes" field.
+func ReplacedTxHashesNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldReplacedTxHashes))
+}
+
+// ReplacedTxHashesEqualFold applies the EqualFold predicate on the "replacedTxHashes" field.
+func ReplacedTxHashesEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldReplacedTxHashes, v))
+}
+
+// ReplacedTxHashesContainsFold applies the ContainsFold predicate on the "replacedTxHashes" field.
+func ReplacedTxHashesContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldReplacedTxHashes, v))
+}
+
+// ReplaceCountEQ applies the EQ predicate on the "replaceCount" field.
+func ReplaceCountEQ(v int) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldReplaceCount, v))
+}
+
+// ReplaceCountNEQ applies the NEQ predicate on the "replaceCount" field.
+func ReplaceCountNEQ(v int) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldReplaceCount, v))
+}
+
+// ReplaceCountIn applies the In predicate on the "replaceCount" field.
+func ReplaceCountIn(vs ...int) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldReplaceCount, vs...))
+}
+
+// ReplaceCountNotIn applies the NotIn predicate on the "replaceCount" field.
+func ReplaceCountNotIn(vs ...int) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldReplaceCount, vs...))
+}
+
+// ReplaceCountGT applies the GT predicate on the "replaceCount" field.
+func ReplaceCountGT(v int) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldReplaceCount, v))
+}
+
+// ReplaceCountGTE applies the GTE predicate on the "replaceCount" field.
+func ReplaceCountGTE(v int) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldReplaceCount, v))
+}
+
+// ReplaceCountLT applies the LT predicate on the "replaceCount" field.
+func ReplaceCountLT(v int) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldReplaceCount, v))
+}
+
+// ReplaceCountLTE applies the LTE predicate on the "replaceCount" field.
+func ReplaceCountLTE(v int) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldReplaceCount, v))
+}
+
+// ReplaceTimeEQ applies the EQ predicate on the "replaceTime" field.
+func ReplaceTimeEQ(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldReplaceTime, v))
+}
+
+// ReplaceTimeNEQ applies the NEQ predicate on the "replaceTime" field.
+func ReplaceTimeNEQ(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldReplaceTime, v))
+}
+
+// ReplaceTimeIn applies the In predicate on the "replaceTime" field.
+func ReplaceTimeIn(vs ...time.Time) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldReplaceTime, vs...))
+}
+
+// ReplaceTimeNotIn applies the NotIn predicate on the "replaceTime" field.
+func ReplaceTimeNotIn(vs ...time.Time) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldReplaceTime, vs...))
+}
+
+// ReplaceTimeGT applies the GT predicate on the "replaceTime" field.
+func ReplaceTimeGT(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldReplaceTime, v))
+}
+
+// ReplaceTimeGTE applies the GTE predicate on the "replaceTime" field.
+func ReplaceTimeGTE(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldReplaceTime, v))
+}
+
+// ReplaceTimeLT applies the LT predicate on the "replaceTime" field.
+func ReplaceTimeLT(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldReplaceTime, v))
+}
+
+// ReplaceTimeLTE applies the LTE predicate on the "replaceTime" field.
+func ReplaceTimeLTE(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldReplaceTime, v))
+}
+
+// ReplaceTimeIsNil applies the IsNil predicate on the "replaceTime" field.
+func ReplaceTimeIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldReplaceTime))
+}
+
+// ReplaceTimeNotNil applies the NotNil predicate on the "replaceTime" field.
+func ReplaceTimeNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldReplaceTime))
+}
+
+// CancelledEQ applies the EQ predicate on the "cancelled" field.
+func CancelledEQ(v bool) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldCancelled, v))
+}
+
+// CancelledNEQ applies the NEQ predicate on the "cancelled" field.
+func CancelledNEQ(v bool) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldCancelled, v))
+}
+
+// RetryCountEQ applies the EQ predicate on the "retryCount" field.
+func RetryCountEQ(v int) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldRetryCount, v))
+}
+
+// RetryCountNEQ applies the NEQ predicate on the "retryCount" field.
+func RetryCountNEQ(v int) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldRetryCount, v))
+}
+
+// RetryCountIn applies the In predicate on the "retryCount" field.
+func RetryCountIn(vs ...int) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldRetryCount, vs...))
+}
+
+// RetryCountNotIn applies the NotIn predicate on the "retryCount" field.
+func RetryCountNotIn(vs ...int) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldRetryCount, vs...))
+}
+
+// RetryCountGT applies the GT predicate on the "retryCount" field.
+func RetryCountGT(v int) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldRetryCount, v))
+}
+
+// RetryCountGTE applies the GTE predicate on the "retryCount" field.
+func RetryCountGTE(v int) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldRetryCount, v))
+}
+
+// RetryCountLT applies the LT predicate on the "retryCount" field.
+func RetryCountLT(v int) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldRetryCount, v))
+}
+
+// RetryCountLTE applies the LTE predicate on the "retryCount" field.
+func RetryCountLTE(v int) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldRetryCount, v))
+}
+
+// NextRetryTimeEQ applies the EQ predicate on the "nextRetryTime" field.
+func NextRetryTimeEQ(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldNextRetryTime, v))
+}
+
+// NextRetryTimeNEQ applies the NEQ predicate on the "nextRetryTime" field.
+func NextRetryTimeNEQ(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldNextRetryTime, v))
+}
+
+// NextRetryTimeIn applies the In predicate on the "nextRetryTime" field.
+func NextRetryTimeIn(vs ...time.Time) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldNextRetryTime, vs...))
+}
+
+// NextRetryTimeNotIn applies the NotIn predicate on the "nextRetryTime" field.
+func NextRetryTimeNotIn(vs ...time.Time) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldNextRetryTime, vs...))
+}
+
+// NextRetryTimeGT applies the GT predicate on the "nextRetryTime" field.
+func NextRetryTimeGT(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldNextRetryTime, v))
+}
+
+// NextRetryTimeGTE applies the GTE predicate on the "nextRetryTime" field.
+func NextRetryTimeGTE(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldNextRetryTime, v))
+}
+
+// NextRetryTimeLT applies the LT predicate on the "nextRetryTime" field.
+func NextRetryTimeLT(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldNextRetryTime, v))
+}
+
+// NextRetryTimeLTE applies the LTE predicate on the "nextRetryTime" field.
+func NextRetryTimeLTE(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldNextRetryTime, v))
+}
+
+// NextRetryTimeIsNil applies the IsNil predicate on the "nextRetryTime" field.
+func NextRetryTimeIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldNextRetryTime))
+}
+
+// NextRetryTimeNotNil applies the NotNil predicate on the "nextRetryTime" field.
+func NextRetryTimeNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldNextRetryTime))
+}
+
+// RouteEQ applies the EQ predicate on the "route" field.
+func RouteEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldRoute, v))
+}
+
+// RouteNEQ applies the NEQ predicate on the "route" field.
+func RouteNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldRoute, v))
+}
+
+// RouteIn applies the In predicate on the "route" field.
+func RouteIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldRoute, vs...))
+}
+
+// RouteNotIn applies the NotIn predicate on the "route" field.
+func RouteNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldRoute, vs...))
+}
+
+// RouteGT applies the GT predicate on the "route" field.
+func RouteGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldRoute, v))
+}
+
+// RouteGTE applies the GTE predicate on the "route" field.
+func RouteGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldRoute, v))
+}
+
+// RouteLT applies the LT predicate on the "route" field.
+func RouteLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldRoute, v))
+}
+
+// RouteLTE applies the LTE predicate on the "route" field.
+func RouteLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldRoute, v))
+}
+
+// RouteContains applies the Contains predicate on the "route" field.
+func RouteContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldRoute, v))
+}
+
+// RouteHasPrefix applies the HasPrefix predicate on the "route" field.
+func RouteHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldRoute, v))
+}
+
+// RouteHasSuffix applies the HasSuffix predicate on the "route" field.
+func RouteHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldRoute, v))
+}
+
+// RouteIsNil applies the IsNil predicate on the "route" field.
+func RouteIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldRoute))
+}
+
+// RouteNotNil applies the NotNil predicate on the "route" field.
+func RouteNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldRoute))
+}
+
+// RouteEqualFold applies the EqualFold predicate on the "route" field.
+func RouteEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldRoute, v))
+}
+
+// RouteContainsFold applies the ContainsFold predicate on the "route" field.
+func RouteContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldRoute, v))
+}
+
+// RunnerUpRouteEQ applies the EQ predicate on the "runnerUpRoute" field.
+func RunnerUpRouteEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldRunnerUpRoute, v))
+}
+
+// RunnerUpRouteNEQ applies the NEQ predicate on the "runnerUpRoute" field.
+func RunnerUpRouteNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldRunnerUpRoute, v))
+}
+
+// RunnerUpRouteIn applies the In predicate on the "runnerUpRoute" field.
+func RunnerUpRouteIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldRunnerUpRoute, vs...))
+}
+
+// RunnerUpRouteNotIn applies the NotIn predicate on the "runnerUpRoute" field.
+func RunnerUpRouteNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldRunnerUpRoute, vs...))
+}
+
+// RunnerUpRouteGT applies the GT predicate on the "runnerUpRoute" field.
+func RunnerUpRouteGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldRunnerUpRoute, v))
+}
+
+// RunnerUpRouteGTE applies the GTE predicate on the "runnerUpRoute" field.
+func RunnerUpRouteGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldRunnerUpRoute, v))
+}
+
+// RunnerUpRouteLT applies the LT predicate on the "runnerUpRoute" field.
+func RunnerUpRouteLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldRunnerUpRoute, v))
+}
+
+// RunnerUpRouteLTE applies the LTE predicate on the "runnerUpRoute" field.
+func RunnerUpRouteLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldRunnerUpRoute, v))
+}
+
+// RunnerUpRouteContains applies the Contains predicate on the "runnerUpRoute" field.
+func RunnerUpRouteContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldRunnerUpRoute, v))
+}
+
+// RunnerUpRouteHasPrefix applies the HasPrefix predicate on the "runnerUpRoute" field.
+func RunnerUpRouteHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldRunnerUpRoute, v))
+}
+
+// RunnerUpRouteHasSuffix applies the HasSuffix predicate on the "runnerUpRoute" field.
+func RunnerUpRouteHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldRunnerUpRoute, v))
+}
+
+// RunnerUpRouteIsNil applies the IsNil predicate on the "runnerUpRoute" field.
+func RunnerUpRouteIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldRunnerUpRoute))
+}
+
+// RunnerUpRouteNotNil applies the NotNil predicate on the "runnerUpRoute" field.
+func RunnerUpRouteNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldRunnerUpRoute))
+}
+
+// RunnerUpRouteEqualFold applies the EqualFold predicate on the "runnerUpRoute" field.
+func RunnerUpRouteEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldRunnerUpRoute, v))
+}
+
+// RunnerUpRouteContainsFold applies the ContainsFold predicate on the "runnerUpRoute" field.
+func RunnerUpRouteContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldRunnerUpRoute, v))
+}
+
+// RouteSpreadUsdEQ applies the EQ predicate on the "routeSpreadUsd" field.
+func RouteSpreadUsdEQ(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldRouteSpreadUsd, v))
+}
+
+// RouteSpreadUsdNEQ applies the NEQ predicate on the "routeSpreadUsd" field.
+func RouteSpreadUsdNEQ(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldRouteSpreadUsd, v))
+}
+
+// RouteSpreadUsdIn applies the In predicate on the "routeSpreadUsd" field.
+func RouteSpreadUsdIn(vs ...decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldRouteSpreadUsd, vs...))
+}
+
+// RouteSpreadUsdNotIn applies the NotIn predicate on the "routeSpreadUsd" field.
+func RouteSpreadUsdNotIn(vs ...decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldRouteSpreadUsd, vs...))
+}
+
+// RouteSpreadUsdGT applies the GT predicate on the "routeSpreadUsd" field.
+func RouteSpreadUsdGT(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldRouteSpreadUsd, v))
+}
+
+// RouteSpreadUsdGTE applies the GTE predicate on the "routeSpreadUsd" field.
+func RouteSpreadUsdGTE(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldRouteSpreadUsd, v))
+}
+
+// RouteSpreadUsdLT applies the LT predicate on the "routeSpreadUsd" field.
+func RouteSpreadUsdLT(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldRouteSpreadUsd, v))
+}
+
+// RouteSpreadUsdLTE applies the LTE predicate on the "routeSpreadUsd" field.
+func RouteSpreadUsdLTE(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldRouteSpreadUsd, v))
+}
+
+// RouteSpreadUsdContains applies the Contains predicate on the "routeSpreadUsd" field.
+func RouteSpreadUsdContains(v decimal.Decimal) predicate.Order {
+	vc := v.String()
+	return predicate.Order(sql.FieldContains(FieldRouteSpreadUsd, vc))
+}
+
+// RouteSpreadUsdHasPrefix applies the HasPrefix predicate on the "routeSpreadUsd" field.
+func RouteSpreadUsdHasPrefix(v decimal.Decimal) predicate.Order {
+	vc := v.String()
+	return predicate.Order(sql.FieldHasPrefix(FieldRouteSpreadUsd, vc))
+}
+
+// RouteSpreadUsdHasSuffix applies the HasSuffix predicate on the "routeSpreadUsd" field.
+func RouteSpreadUsdHasSuffix(v decimal.Decimal) predicate.Order {
+	vc := v.String()
+	return predicate.Order(sql.FieldHasSuffix(FieldRouteSpreadUsd, vc))
+}
+
+// RouteSpreadUsdIsNil applies the IsNil predicate on the "routeSpreadUsd" field.
+func RouteSpreadUsdIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldRouteSpreadUsd))
+}
+
+// RouteSpreadUsdNotNil applies the NotNil predicate on the "routeSpreadUsd" field.
+func RouteSpreadUsdNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldRouteSpreadUsd))
+}
+
+// RouteSpreadUsdEqualFold applies the EqualFold predicate on the "routeSpreadUsd" field.
+func RouteSpreadUsdEqualFold(v decimal.Decimal) predicate.Order {
+	vc := v.String()
+	return predicate.Order(sql.FieldEqualFold(FieldRouteSpreadUsd, vc))
+}
+
+// RouteSpreadUsdContainsFold applies the ContainsFold predicate on the "routeSpreadUsd" field.
+func RouteSpreadUsdContainsFold(v decimal.Decimal) predicate.Order {
+	vc := v.String()
+	return predicate.Order(sql.FieldContainsFold(FieldRouteSpreadUsd, vc))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Order) predicate.Order {
 	return predicate.Order(sql.AndPredicates(predicates...))
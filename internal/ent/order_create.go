@@ -184,6 +184,160 @@ func (_c *OrderCreate) SetNillableProfit(v *decimal.Decimal) *OrderCreate {
 	return _c
 }
 
+// SetPaper sets the "paper" field.
+func (_c *OrderCreate) SetPaper(v bool) *OrderCreate {
+	_c.mutation.SetPaper(v)
+	return _c
+}
+
+// SetNillablePaper sets the "paper" field if the given value is not nil.
+func (_c *OrderCreate) SetNillablePaper(v *bool) *OrderCreate {
+	if v != nil {
+		_c.SetPaper(*v)
+	}
+	return _c
+}
+
+// SetRawTx sets the "rawTx" field.
+func (_c *OrderCreate) SetRawTx(v string) *OrderCreate {
+	_c.mutation.SetRawTx(v)
+	return _c
+}
+
+// SetNillableRawTx sets the "rawTx" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableRawTx(v *string) *OrderCreate {
+	if v != nil {
+		_c.SetRawTx(*v)
+	}
+	return _c
+}
+
+// SetReplacedTxHashes sets the "replacedTxHashes" field.
+func (_c *OrderCreate) SetReplacedTxHashes(v string) *OrderCreate {
+	_c.mutation.SetReplacedTxHashes(v)
+	return _c
+}
+
+// SetNillableReplacedTxHashes sets the "replacedTxHashes" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableReplacedTxHashes(v *string) *OrderCreate {
+	if v != nil {
+		_c.SetReplacedTxHashes(*v)
+	}
+	return _c
+}
+
+// SetReplaceCount sets the "replaceCount" field.
+func (_c *OrderCreate) SetReplaceCount(v int) *OrderCreate {
+	_c.mutation.SetReplaceCount(v)
+	return _c
+}
+
+// SetNillableReplaceCount sets the "replaceCount" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableReplaceCount(v *int) *OrderCreate {
+	if v != nil {
+		_c.SetReplaceCount(*v)
+	}
+	return _c
+}
+
+// SetReplaceTime sets the "replaceTime" field.
+func (_c *OrderCreate) SetReplaceTime(v time.Time) *OrderCreate {
+	_c.mutation.SetReplaceTime(v)
+	return _c
+}
+
+// SetNillableReplaceTime sets the "replaceTime" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableReplaceTime(v *time.Time) *OrderCreate {
+	if v != nil {
+		_c.SetReplaceTime(*v)
+	}
+	return _c
+}
+
+// SetCancelled sets the "cancelled" field.
+func (_c *OrderCreate) SetCancelled(v bool) *OrderCreate {
+	_c.mutation.SetCancelled(v)
+	return _c
+}
+
+// SetNillableCancelled sets the "cancelled" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableCancelled(v *bool) *OrderCreate {
+	if v != nil {
+		_c.SetCancelled(*v)
+	}
+	return _c
+}
+
+// SetRetryCount sets the "retryCount" field.
+func (_c *OrderCreate) SetRetryCount(v int) *OrderCreate {
+	_c.mutation.SetRetryCount(v)
+	return _c
+}
+
+// SetNillableRetryCount sets the "retryCount" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableRetryCount(v *int) *OrderCreate {
+	if v != nil {
+		_c.SetRetryCount(*v)
+	}
+	return _c
+}
+
+// SetNextRetryTime sets the "nextRetryTime" field.
+func (_c *OrderCreate) SetNextRetryTime(v time.Time) *OrderCreate {
+	_c.mutation.SetNextRetryTime(v)
+	return _c
+}
+
+// SetNillableNextRetryTime sets the "nextRetryTime" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableNextRetryTime(v *time.Time) *OrderCreate {
+	if v != nil {
+		_c.SetNextRetryTime(*v)
+	}
+	return _c
+}
+
+// SetRoute sets the "route" field.
+func (_c *OrderCreate) SetRoute(v string) *OrderCreate {
+	_c.mutation.SetRoute(v)
+	return _c
+}
+
+// SetNillableRoute sets the "route" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableRoute(v *string) *OrderCreate {
+	if v != nil {
+		_c.SetRoute(*v)
+	}
+	return _c
+}
+
+// SetRunnerUpRoute sets the "runnerUpRoute" field.
+func (_c *OrderCreate) SetRunnerUpRoute(v string) *OrderCreate {
+	_c.mutation.SetRunnerUpRoute(v)
+	return _c
+}
+
+// SetNillableRunnerUpRoute sets the "runnerUpRoute" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableRunnerUpRoute(v *string) *OrderCreate {
+	if v != nil {
+		_c.SetRunnerUpRoute(*v)
+	}
+	return _c
+}
+
+// SetRouteSpreadUsd sets the "routeSpreadUsd" field.
+func (_c *OrderCreate) SetRouteSpreadUsd(v decimal.Decimal) *OrderCreate {
+	_c.mutation.SetRouteSpreadUsd(v)
+	return _c
+}
+
+// SetNillableRouteSpreadUsd sets the "routeSpreadUsd" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableRouteSpreadUsd(v *decimal.Decimal) *OrderCreate {
+	if v != nil {
+		_c.SetRouteSpreadUsd(*v)
+	}
+	return _c
+}
+
 // Mutation returns the OrderMutation object of the builder.
 func (_c *OrderCreate) Mutation() *OrderMutation {
 	return _c.mutation
@@ -227,6 +381,18 @@ func (_c *OrderCreate) defaults() {
 		v := order.DefaultUpdateTime()
 		_c.mutation.SetUpdateTime(v)
 	}
+	if _, ok := _c.mutation.ReplaceCount(); !ok {
+		v := order.DefaultReplaceCount
+		_c.mutation.SetReplaceCount(v)
+	}
+	if _, ok := _c.mutation.Cancelled(); !ok {
+		v := order.DefaultCancelled
+		_c.mutation.SetCancelled(v)
+	}
+	if _, ok := _c.mutation.RetryCount(); !ok {
+		v := order.DefaultRetryCount
+		_c.mutation.SetRetryCount(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -321,6 +487,25 @@ func (_c *OrderCreate) check() error {
 			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "Order.reason": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.ReplaceCount(); !ok {
+		return &ValidationError{Name: "replaceCount", err: errors.New(`ent: missing required field "Order.replaceCount"`)}
+	}
+	if _, ok := _c.mutation.Cancelled(); !ok {
+		return &ValidationError{Name: "cancelled", err: errors.New(`ent: missing required field "Order.cancelled"`)}
+	}
+	if _, ok := _c.mutation.RetryCount(); !ok {
+		return &ValidationError{Name: "retryCount", err: errors.New(`ent: missing required field "Order.retryCount"`)}
+	}
+	if v, ok := _c.mutation.Route(); ok {
+		if err := order.RouteValidator(v); err != nil {
+			return &ValidationError{Name: "route", err: fmt.Errorf(`ent: validator failed for field "Order.route": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.RunnerUpRoute(); ok {
+		if err := order.RunnerUpRouteValidator(v); err != nil {
+			return &ValidationError{Name: "runnerUpRoute", err: fmt.Errorf(`ent: validator failed for field "Order.runnerUpRoute": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -423,6 +608,50 @@ func (_c *OrderCreate) createSpec() (*Order, *sqlgraph.CreateSpec) {
 		_spec.SetField(order.FieldProfit, field.TypeString, value)
 		_node.Profit = &value
 	}
+	if value, ok := _c.mutation.Paper(); ok {
+		_spec.SetField(order.FieldPaper, field.TypeBool, value)
+		_node.Paper = value
+	}
+	if value, ok := _c.mutation.RawTx(); ok {
+		_spec.SetField(order.FieldRawTx, field.TypeString, value)
+		_node.RawTx = &value
+	}
+	if value, ok := _c.mutation.ReplacedTxHashes(); ok {
+		_spec.SetField(order.FieldReplacedTxHashes, field.TypeString, value)
+		_node.ReplacedTxHashes = &value
+	}
+	if value, ok := _c.mutation.ReplaceCount(); ok {
+		_spec.SetField(order.FieldReplaceCount, field.TypeInt, value)
+		_node.ReplaceCount = value
+	}
+	if value, ok := _c.mutation.ReplaceTime(); ok {
+		_spec.SetField(order.FieldReplaceTime, field.TypeTime, value)
+		_node.ReplaceTime = &value
+	}
+	if value, ok := _c.mutation.Cancelled(); ok {
+		_spec.SetField(order.FieldCancelled, field.TypeBool, value)
+		_node.Cancelled = value
+	}
+	if value, ok := _c.mutation.RetryCount(); ok {
+		_spec.SetField(order.FieldRetryCount, field.TypeInt, value)
+		_node.RetryCount = value
+	}
+	if value, ok := _c.mutation.NextRetryTime(); ok {
+		_spec.SetField(order.FieldNextRetryTime, field.TypeTime, value)
+		_node.NextRetryTime = &value
+	}
+	if value, ok := _c.mutation.Route(); ok {
+		_spec.SetField(order.FieldRoute, field.TypeString, value)
+		_node.Route = &value
+	}
+	if value, ok := _c.mutation.RunnerUpRoute(); ok {
+		_spec.SetField(order.FieldRunnerUpRoute, field.TypeString, value)
+		_node.RunnerUpRoute = &value
+	}
+	if value, ok := _c.mutation.RouteSpreadUsd(); ok {
+		_spec.SetField(order.FieldRouteSpreadUsd, field.TypeString, value)
+		_node.RouteSpreadUsd = &value
+	}
 	return _node, _spec
 }
 
@@ -312,6 +312,222 @@ func (_u *OrderUpdate) ClearProfit() *OrderUpdate {
 	return _u
 }
 
+// SetPaper sets the "paper" field.
+func (_u *OrderUpdate) SetPaper(v bool) *OrderUpdate {
+	_u.mutation.SetPaper(v)
+	return _u
+}
+
+// SetNillablePaper sets the "paper" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillablePaper(v *bool) *OrderUpdate {
+	if v != nil {
+		_u.SetPaper(*v)
+	}
+	return _u
+}
+
+// ClearPaper clears the value of the "paper" field.
+func (_u *OrderUpdate) ClearPaper() *OrderUpdate {
+	_u.mutation.ClearPaper()
+	return _u
+}
+
+// SetRawTx sets the "rawTx" field.
+func (_u *OrderUpdate) SetRawTx(v string) *OrderUpdate {
+	_u.mutation.SetRawTx(v)
+	return _u
+}
+
+// SetNillableRawTx sets the "rawTx" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableRawTx(v *string) *OrderUpdate {
+	if v != nil {
+		_u.SetRawTx(*v)
+	}
+	return _u
+}
+
+// ClearRawTx clears the value of the "rawTx" field.
+func (_u *OrderUpdate) ClearRawTx() *OrderUpdate {
+	_u.mutation.ClearRawTx()
+	return _u
+}
+
+// SetReplacedTxHashes sets the "replacedTxHashes" field.
+func (_u *OrderUpdate) SetReplacedTxHashes(v string) *OrderUpdate {
+	_u.mutation.SetReplacedTxHashes(v)
+	return _u
+}
+
+// SetNillableReplacedTxHashes sets the "replacedTxHashes" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableReplacedTxHashes(v *string) *OrderUpdate {
+	if v != nil {
+		_u.SetReplacedTxHashes(*v)
+	}
+	return _u
+}
+
+// ClearReplacedTxHashes clears the value of the "replacedTxHashes" field.
+func (_u *OrderUpdate) ClearReplacedTxHashes() *OrderUpdate {
+	_u.mutation.ClearReplacedTxHashes()
+	return _u
+}
+
+// SetReplaceCount sets the "replaceCount" field.
+func (_u *OrderUpdate) SetReplaceCount(v int) *OrderUpdate {
+	_u.mutation.ResetReplaceCount()
+	_u.mutation.SetReplaceCount(v)
+	return _u
+}
+
+// SetNillableReplaceCount sets the "replaceCount" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableReplaceCount(v *int) *OrderUpdate {
+	if v != nil {
+		_u.SetReplaceCount(*v)
+	}
+	return _u
+}
+
+// AddReplaceCount adds value to the "replaceCount" field.
+func (_u *OrderUpdate) AddReplaceCount(v int) *OrderUpdate {
+	_u.mutation.AddReplaceCount(v)
+	return _u
+}
+
+// SetReplaceTime sets the "replaceTime" field.
+func (_u *OrderUpdate) SetReplaceTime(v time.Time) *OrderUpdate {
+	_u.mutation.SetReplaceTime(v)
+	return _u
+}
+
+// SetNillableReplaceTime sets the "replaceTime" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableReplaceTime(v *time.Time) *OrderUpdate {
+	if v != nil {
+		_u.SetReplaceTime(*v)
+	}
+	return _u
+}
+
+// ClearReplaceTime clears the value of the "replaceTime" field.
+func (_u *OrderUpdate) ClearReplaceTime() *OrderUpdate {
+	_u.mutation.ClearReplaceTime()
+	return _u
+}
+
+// SetCancelled sets the "cancelled" field.
+func (_u *OrderUpdate) SetCancelled(v bool) *OrderUpdate {
+	_u.mutation.SetCancelled(v)
+	return _u
+}
+
+// SetNillableCancelled sets the "cancelled" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableCancelled(v *bool) *OrderUpdate {
+	if v != nil {
+		_u.SetCancelled(*v)
+	}
+	return _u
+}
+
+// SetRetryCount sets the "retryCount" field.
+func (_u *OrderUpdate) SetRetryCount(v int) *OrderUpdate {
+	_u.mutation.ResetRetryCount()
+	_u.mutation.SetRetryCount(v)
+	return _u
+}
+
+// SetNillableRetryCount sets the "retryCount" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableRetryCount(v *int) *OrderUpdate {
+	if v != nil {
+		_u.SetRetryCount(*v)
+	}
+	return _u
+}
+
+// AddRetryCount adds value to the "retryCount" field.
+func (_u *OrderUpdate) AddRetryCount(v int) *OrderUpdate {
+	_u.mutation.AddRetryCount(v)
+	return _u
+}
+
+// SetNextRetryTime sets the "nextRetryTime" field.
+func (_u *OrderUpdate) SetNextRetryTime(v time.Time) *OrderUpdate {
+	_u.mutation.SetNextRetryTime(v)
+	return _u
+}
+
+// SetNillableNextRetryTime sets the "nextRetryTime" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableNextRetryTime(v *time.Time) *OrderUpdate {
+	if v != nil {
+		_u.SetNextRetryTime(*v)
+	}
+	return _u
+}
+
+// ClearNextRetryTime clears the value of the "nextRetryTime" field.
+func (_u *OrderUpdate) ClearNextRetryTime() *OrderUpdate {
+	_u.mutation.ClearNextRetryTime()
+	return _u
+}
+
+// SetRoute sets the "route" field.
+func (_u *OrderUpdate) SetRoute(v string) *OrderUpdate {
+	_u.mutation.SetRoute(v)
+	return _u
+}
+
+// SetNillableRoute sets the "route" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableRoute(v *string) *OrderUpdate {
+	if v != nil {
+		_u.SetRoute(*v)
+	}
+	return _u
+}
+
+// ClearRoute clears the value of the "route" field.
+func (_u *OrderUpdate) ClearRoute() *OrderUpdate {
+	_u.mutation.ClearRoute()
+	return _u
+}
+
+// SetRunnerUpRoute sets the "runnerUpRoute" field.
+func (_u *OrderUpdate) SetRunnerUpRoute(v string) *OrderUpdate {
+	_u.mutation.SetRunnerUpRoute(v)
+	return _u
+}
+
+// SetNillableRunnerUpRoute sets the "runnerUpRoute" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableRunnerUpRoute(v *string) *OrderUpdate {
+	if v != nil {
+		_u.SetRunnerUpRoute(*v)
+	}
+	return _u
+}
+
+// ClearRunnerUpRoute clears the value of the "runnerUpRoute" field.
+func (_u *OrderUpdate) ClearRunnerUpRoute() *OrderUpdate {
+	_u.mutation.ClearRunnerUpRoute()
+	return _u
+}
+
+// SetRouteSpreadUsd sets the "routeSpreadUsd" field.
+func (_u *OrderUpdate) SetRouteSpreadUsd(v decimal.Decimal) *OrderUpdate {
+	_u.mutation.SetRouteSpreadUsd(v)
+	return _u
+}
+
+// SetNillableRouteSpreadUsd sets the "routeSpreadUsd" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableRouteSpreadUsd(v *decimal.Decimal) *OrderUpdate {
+	if v != nil {
+		_u.SetRouteSpreadUsd(*v)
+	}
+	return _u
+}
+
+// ClearRouteSpreadUsd clears the value of the "routeSpreadUsd" field.
+func (_u *OrderUpdate) ClearRouteSpreadUsd() *OrderUpdate {
+	_u.mutation.ClearRouteSpreadUsd()
+	return _u
+}
+
 // Mutation returns the OrderMutation object of the builder.
 func (_u *OrderUpdate) Mutation() *OrderMutation {
 	return _u.mutation
@@ -400,6 +616,16 @@ func (_u *OrderUpdate) check() error {
 			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "Order.reason": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Route(); ok {
+		if err := order.RouteValidator(v); err != nil {
+			return &ValidationError{Name: "route", err: fmt.Errorf(`ent: validator failed for field "Order.route": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RunnerUpRoute(); ok {
+		if err := order.RunnerUpRouteValidator(v); err != nil {
+			return &ValidationError{Name: "runnerUpRoute", err: fmt.Errorf(`ent: validator failed for field "Order.runnerUpRoute": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -487,6 +713,69 @@ func (_u *OrderUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.ProfitCleared() {
 		_spec.ClearField(order.FieldProfit, field.TypeString)
 	}
+	if value, ok := _u.mutation.Paper(); ok {
+		_spec.SetField(order.FieldPaper, field.TypeBool, value)
+	}
+	if _u.mutation.PaperCleared() {
+		_spec.ClearField(order.FieldPaper, field.TypeBool)
+	}
+	if value, ok := _u.mutation.RawTx(); ok {
+		_spec.SetField(order.FieldRawTx, field.TypeString, value)
+	}
+	if _u.mutation.RawTxCleared() {
+		_spec.ClearField(order.FieldRawTx, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReplacedTxHashes(); ok {
+		_spec.SetField(order.FieldReplacedTxHashes, field.TypeString, value)
+	}
+	if _u.mutation.ReplacedTxHashesCleared() {
+		_spec.ClearField(order.FieldReplacedTxHashes, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReplaceCount(); ok {
+		_spec.SetField(order.FieldReplaceCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReplaceCount(); ok {
+		_spec.AddField(order.FieldReplaceCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ReplaceTime(); ok {
+		_spec.SetField(order.FieldReplaceTime, field.TypeTime, value)
+	}
+	if _u.mutation.ReplaceTimeCleared() {
+		_spec.ClearField(order.FieldReplaceTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Cancelled(); ok {
+		_spec.SetField(order.FieldCancelled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.RetryCount(); ok {
+		_spec.SetField(order.FieldRetryCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRetryCount(); ok {
+		_spec.AddField(order.FieldRetryCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextRetryTime(); ok {
+		_spec.SetField(order.FieldNextRetryTime, field.TypeTime, value)
+	}
+	if _u.mutation.NextRetryTimeCleared() {
+		_spec.ClearField(order.FieldNextRetryTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Route(); ok {
+		_spec.SetField(order.FieldRoute, field.TypeString, value)
+	}
+	if _u.mutation.RouteCleared() {
+		_spec.ClearField(order.FieldRoute, field.TypeString)
+	}
+	if value, ok := _u.mutation.RunnerUpRoute(); ok {
+		_spec.SetField(order.FieldRunnerUpRoute, field.TypeString, value)
+	}
+	if _u.mutation.RunnerUpRouteCleared() {
+		_spec.ClearField(order.FieldRunnerUpRoute, field.TypeString)
+	}
+	if value, ok := _u.mutation.RouteSpreadUsd(); ok {
+		_spec.SetField(order.FieldRouteSpreadUsd, field.TypeString, value)
+	}
+	if _u.mutation.RouteSpreadUsdCleared() {
+		_spec.ClearField(order.FieldRouteSpreadUsd, field.TypeString)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{order.Label}
@@ -789,6 +1078,222 @@ func (_u *OrderUpdateOne) ClearProfit() *OrderUpdateOne {
 	return _u
 }
 
+// SetPaper sets the "paper" field.
+func (_u *OrderUpdateOne) SetPaper(v bool) *OrderUpdateOne {
+	_u.mutation.SetPaper(v)
+	return _u
+}
+
+// SetNillablePaper sets the "paper" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillablePaper(v *bool) *OrderUpdateOne {
+	if v != nil {
+		_u.SetPaper(*v)
+	}
+	return _u
+}
+
+// ClearPaper clears the value of the "paper" field.
+func (_u *OrderUpdateOne) ClearPaper() *OrderUpdateOne {
+	_u.mutation.ClearPaper()
+	return _u
+}
+
+// SetRawTx sets the "rawTx" field.
+func (_u *OrderUpdateOne) SetRawTx(v string) *OrderUpdateOne {
+	_u.mutation.SetRawTx(v)
+	return _u
+}
+
+// SetNillableRawTx sets the "rawTx" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableRawTx(v *string) *OrderUpdateOne {
+	if v != nil {
+		_u.SetRawTx(*v)
+	}
+	return _u
+}
+
+// ClearRawTx clears the value of the "rawTx" field.
+func (_u *OrderUpdateOne) ClearRawTx() *OrderUpdateOne {
+	_u.mutation.ClearRawTx()
+	return _u
+}
+
+// SetReplacedTxHashes sets the "replacedTxHashes" field.
+func (_u *OrderUpdateOne) SetReplacedTxHashes(v string) *OrderUpdateOne {
+	_u.mutation.SetReplacedTxHashes(v)
+	return _u
+}
+
+// SetNillableReplacedTxHashes sets the "replacedTxHashes" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableReplacedTxHashes(v *string) *OrderUpdateOne {
+	if v != nil {
+		_u.SetReplacedTxHashes(*v)
+	}
+	return _u
+}
+
+// ClearReplacedTxHashes clears the value of the "replacedTxHashes" field.
+func (_u *OrderUpdateOne) ClearReplacedTxHashes() *OrderUpdateOne {
+	_u.mutation.ClearReplacedTxHashes()
+	return _u
+}
+
+// SetReplaceCount sets the "replaceCount" field.
+func (_u *OrderUpdateOne) SetReplaceCount(v int) *OrderUpdateOne {
+	_u.mutation.ResetReplaceCount()
+	_u.mutation.SetReplaceCount(v)
+	return _u
+}
+
+// SetNillableReplaceCount sets the "replaceCount" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableReplaceCount(v *int) *OrderUpdateOne {
+	if v != nil {
+		_u.SetReplaceCount(*v)
+	}
+	return _u
+}
+
+// AddReplaceCount adds value to the "replaceCount" field.
+func (_u *OrderUpdateOne) AddReplaceCount(v int) *OrderUpdateOne {
+	_u.mutation.AddReplaceCount(v)
+	return _u
+}
+
+// SetReplaceTime sets the "replaceTime" field.
+func (_u *OrderUpdateOne) SetReplaceTime(v time.Time) *OrderUpdateOne {
+	_u.mutation.SetReplaceTime(v)
+	return _u
+}
+
+// SetNillableReplaceTime sets the "replaceTime" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableReplaceTime(v *time.Time) *OrderUpdateOne {
+	if v != nil {
+		_u.SetReplaceTime(*v)
+	}
+	return _u
+}
+
+// ClearReplaceTime clears the value of the "replaceTime" field.
+func (_u *OrderUpdateOne) ClearReplaceTime() *OrderUpdateOne {
+	_u.mutation.ClearReplaceTime()
+	return _u
+}
+
+// SetCancelled sets the "cancelled" field.
+func (_u *OrderUpdateOne) SetCancelled(v bool) *OrderUpdateOne {
+	_u.mutation.SetCancelled(v)
+	return _u
+}
+
+// SetNillableCancelled sets the "cancelled" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableCancelled(v *bool) *OrderUpdateOne {
+	if v != nil {
+		_u.SetCancelled(*v)
+	}
+	return _u
+}
+
+// SetRetryCount sets the "retryCount" field.
+func (_u *OrderUpdateOne) SetRetryCount(v int) *OrderUpdateOne {
+	_u.mutation.ResetRetryCount()
+	_u.mutation.SetRetryCount(v)
+	return _u
+}
+
+// SetNillableRetryCount sets the "retryCount" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableRetryCount(v *int) *OrderUpdateOne {
+	if v != nil {
+		_u.SetRetryCount(*v)
+	}
+	return _u
+}
+
+// AddRetryCount adds value to the "retryCount" field.
+func (_u *OrderUpdateOne) AddRetryCount(v int) *OrderUpdateOne {
+	_u.mutation.AddRetryCount(v)
+	return _u
+}
+
+// SetNextRetryTime sets the "nextRetryTime" field.
+func (_u *OrderUpdateOne) SetNextRetryTime(v time.Time) *OrderUpdateOne {
+	_u.mutation.SetNextRetryTime(v)
+	return _u
+}
+
+// SetNillableNextRetryTime sets the "nextRetryTime" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableNextRetryTime(v *time.Time) *OrderUpdateOne {
+	if v != nil {
+		_u.SetNextRetryTime(*v)
+	}
+	return _u
+}
+
+// ClearNextRetryTime clears the value of the "nextRetryTime" field.
+func (_u *OrderUpdateOne) ClearNextRetryTime() *OrderUpdateOne {
+	_u.mutation.ClearNextRetryTime()
+	return _u
+}
+
+// SetRoute sets the "route" field.
+func (_u *OrderUpdateOne) SetRoute(v string) *OrderUpdateOne {
+	_u.mutation.SetRoute(v)
+	return _u
+}
+
+// SetNillableRoute sets the "route" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableRoute(v *string) *OrderUpdateOne {
+	if v != nil {
+		_u.SetRoute(*v)
+	}
+	return _u
+}
+
+// ClearRoute clears the value of the "route" field.
+func (_u *OrderUpdateOne) ClearRoute() *OrderUpdateOne {
+	_u.mutation.ClearRoute()
+	return _u
+}
+
+// SetRunnerUpRoute sets the "runnerUpRoute" field.
+func (_u *OrderUpdateOne) SetRunnerUpRoute(v string) *OrderUpdateOne {
+	_u.mutation.SetRunnerUpRoute(v)
+	return _u
+}
+
+// SetNillableRunnerUpRoute sets the "runnerUpRoute" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableRunnerUpRoute(v *string) *OrderUpdateOne {
+	if v != nil {
+		_u.SetRunnerUpRoute(*v)
+	}
+	return _u
+}
+
+// ClearRunnerUpRoute clears the value of the "runnerUpRoute" field.
+func (_u *OrderUpdateOne) ClearRunnerUpRoute() *OrderUpdateOne {
+	_u.mutation.ClearRunnerUpRoute()
+	return _u
+}
+
+// SetRouteSpreadUsd sets the "routeSpreadUsd" field.
+func (_u *OrderUpdateOne) SetRouteSpreadUsd(v decimal.Decimal) *OrderUpdateOne {
+	_u.mutation.SetRouteSpreadUsd(v)
+	return _u
+}
+
+// SetNillableRouteSpreadUsd sets the "routeSpreadUsd" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableRouteSpreadUsd(v *decimal.Decimal) *OrderUpdateOne {
+	if v != nil {
+		_u.SetRouteSpreadUsd(*v)
+	}
+	return _u
+}
+
+// ClearRouteSpreadUsd clears the value of the "routeSpreadUsd" field.
+func (_u *OrderUpdateOne) ClearRouteSpreadUsd() *OrderUpdateOne {
+	_u.mutation.ClearRouteSpreadUsd()
+	return _u
+}
+
 // Mutation returns the OrderMutation object of the builder.
 func (_u *OrderUpdateOne) Mutation() *OrderMutation {
 	return _u.mutation
@@ -890,6 +1395,16 @@ func (_u *OrderUpdateOne) check() error {
 			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "Order.reason": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Route(); ok {
+		if err := order.RouteValidator(v); err != nil {
+			return &ValidationError{Name: "route", err: fmt.Errorf(`ent: validator failed for field "Order.route": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RunnerUpRoute(); ok {
+		if err := order.RunnerUpRouteValidator(v); err != nil {
+			return &ValidationError{Name: "runnerUpRoute", err: fmt.Errorf(`ent: validator failed for field "Order.runnerUpRoute": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -994,6 +1509,69 @@ func (_u *OrderUpdateOne) sqlSave(ctx context.Context) (_node *Order, err error)
 	if _u.mutation.ProfitCleared() {
 		_spec.ClearField(order.FieldProfit, field.TypeString)
 	}
+	if value, ok := _u.mutation.Paper(); ok {
+		_spec.SetField(order.FieldPaper, field.TypeBool, value)
+	}
+	if _u.mutation.PaperCleared() {
+		_spec.ClearField(order.FieldPaper, field.TypeBool)
+	}
+	if value, ok := _u.mutation.RawTx(); ok {
+		_spec.SetField(order.FieldRawTx, field.TypeString, value)
+	}
+	if _u.mutation.RawTxCleared() {
+		_spec.ClearField(order.FieldRawTx, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReplacedTxHashes(); ok {
+		_spec.SetField(order.FieldReplacedTxHashes, field.TypeString, value)
+	}
+	if _u.mutation.ReplacedTxHashesCleared() {
+		_spec.ClearField(order.FieldReplacedTxHashes, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReplaceCount(); ok {
+		_spec.SetField(order.FieldReplaceCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReplaceCount(); ok {
+		_spec.AddField(order.FieldReplaceCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ReplaceTime(); ok {
+		_spec.SetField(order.FieldReplaceTime, field.TypeTime, value)
+	}
+	if _u.mutation.ReplaceTimeCleared() {
+		_spec.ClearField(order.FieldReplaceTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Cancelled(); ok {
+		_spec.SetField(order.FieldCancelled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.RetryCount(); ok {
+		_spec.SetField(order.FieldRetryCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRetryCount(); ok {
+		_spec.AddField(order.FieldRetryCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextRetryTime(); ok {
+		_spec.SetField(order.FieldNextRetryTime, field.TypeTime, value)
+	}
+	if _u.mutation.NextRetryTimeCleared() {
+		_spec.ClearField(order.FieldNextRetryTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Route(); ok {
+		_spec.SetField(order.FieldRoute, field.TypeString, value)
+	}
+	if _u.mutation.RouteCleared() {
+		_spec.ClearField(order.FieldRoute, field.TypeString)
+	}
+	if value, ok := _u.mutation.RunnerUpRoute(); ok {
+		_spec.SetField(order.FieldRunnerUpRoute, field.TypeString, value)
+	}
+	if _u.mutation.RunnerUpRouteCleared() {
+		_spec.ClearField(order.FieldRunnerUpRoute, field.TypeString)
+	}
+	if value, ok := _u.mutation.RouteSpreadUsd(); ok {
+		_spec.SetField(order.FieldRouteSpreadUsd, field.TypeString, value)
+	}
+	if _u.mutation.RouteSpreadUsdCleared() {
+		_spec.ClearField(order.FieldRouteSpreadUsd, field.TypeString)
+	}
 	_node = &Order{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
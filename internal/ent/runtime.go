@@ -53,10 +53,6 @@ func init() {
 	gridDescStrategyId := gridFields[4].Descriptor()
 	// grid.StrategyIdValidator is a validator for the "strategyId" field. It is called by the builders before save.
 	grid.StrategyIdValidator = gridDescStrategyId.Validators[0].(func(string) error)
-	// gridDescGridNumber is the schema descriptor for gridNumber field.
-	gridDescGridNumber := gridFields[5].Descriptor()
-	// grid.GridNumberValidator is a validator for the "gridNumber" field. It is called by the builders before save.
-	grid.GridNumberValidator = gridDescGridNumber.Validators[0].(func(int) error)
 	nonceMixin := schema.Nonce{}.Mixin()
 	nonceMixinFields0 := nonceMixin[0].Fields()
 	_ = nonceMixinFields0
@@ -119,6 +115,26 @@ func init() {
 	orderDescReason := orderFields[15].Descriptor()
 	// order.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
 	order.ReasonValidator = orderDescReason.Validators[0].(func(string) error)
+	// orderDescReplaceCount is the schema descriptor for replaceCount field.
+	orderDescReplaceCount := orderFields[20].Descriptor()
+	// order.DefaultReplaceCount holds the default value on creation for the replaceCount field.
+	order.DefaultReplaceCount = orderDescReplaceCount.Default.(int)
+	// orderDescCancelled is the schema descriptor for cancelled field.
+	orderDescCancelled := orderFields[22].Descriptor()
+	// order.DefaultCancelled holds the default value on creation for the cancelled field.
+	order.DefaultCancelled = orderDescCancelled.Default.(bool)
+	// orderDescRetryCount is the schema descriptor for retryCount field.
+	orderDescRetryCount := orderFields[23].Descriptor()
+	// order.DefaultRetryCount holds the default value on creation for the retryCount field.
+	order.DefaultRetryCount = orderDescRetryCount.Default.(int)
+	// orderDescRoute is the schema descriptor for route field.
+	orderDescRoute := orderFields[25].Descriptor()
+	// order.RouteValidator is a validator for the "route" field. It is called by the builders before save.
+	order.RouteValidator = orderDescRoute.Validators[0].(func(string) error)
+	// orderDescRunnerUpRoute is the schema descriptor for runnerUpRoute field.
+	orderDescRunnerUpRoute := orderFields[26].Descriptor()
+	// order.RunnerUpRouteValidator is a validator for the "runnerUpRoute" field. It is called by the builders before save.
+	order.RunnerUpRouteValidator = orderDescRunnerUpRoute.Validators[0].(func(string) error)
 	settingsMixin := schema.Settings{}.Mixin()
 	settingsMixinFields0 := settingsMixin[0].Fields()
 	_ = settingsMixinFields0
@@ -181,10 +197,26 @@ func init() {
 	strategyDescMaxGridLimit := strategyFields[5].Descriptor()
 	// strategy.MaxGridLimitValidator is a validator for the "maxGridLimit" field. It is called by the builders before save.
 	strategy.MaxGridLimitValidator = strategyDescMaxGridLimit.Validators[0].(func(int) error)
+	// strategyDescGridCount is the schema descriptor for gridCount field.
+	strategyDescGridCount := strategyFields[9].Descriptor()
+	// strategy.GridCountValidator is a validator for the "gridCount" field. It is called by the builders before save.
+	strategy.GridCountValidator = strategyDescGridCount.Validators[0].(func(int) error)
+	// strategyDescTrailingDwellMinutes is the schema descriptor for trailingDwellMinutes field.
+	strategyDescTrailingDwellMinutes := strategyFields[33].Descriptor()
+	// strategy.DefaultTrailingDwellMinutes holds the default value on creation for the trailingDwellMinutes field.
+	strategy.DefaultTrailingDwellMinutes = strategyDescTrailingDwellMinutes.Default.(int)
 	// strategyDescCandlesToCheck is the schema descriptor for candlesToCheck field.
-	strategyDescCandlesToCheck := strategyFields[19].Descriptor()
+	strategyDescCandlesToCheck := strategyFields[35].Descriptor()
 	// strategy.DefaultCandlesToCheck holds the default value on creation for the candlesToCheck field.
 	strategy.DefaultCandlesToCheck = strategyDescCandlesToCheck.Default.(int)
+	// strategyDescEmaPeriod is the schema descriptor for emaPeriod field.
+	strategyDescEmaPeriod := strategyFields[39].Descriptor()
+	// strategy.DefaultEmaPeriod holds the default value on creation for the emaPeriod field.
+	strategy.DefaultEmaPeriod = strategyDescEmaPeriod.Default.(int)
+	// strategyDescBuyFilterReason is the schema descriptor for buyFilterReason field.
+	strategyDescBuyFilterReason := strategyFields[48].Descriptor()
+	// strategy.BuyFilterReasonValidator is a validator for the "buyFilterReason" field. It is called by the builders before save.
+	strategy.BuyFilterReasonValidator = strategyDescBuyFilterReason.Validators[0].(func(string) error)
 	walletMixin := schema.Wallet{}.Mixin()
 	walletMixinFields0 := walletMixin[0].Fields()
 	_ = walletMixinFields0
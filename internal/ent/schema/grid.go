@@ -27,11 +27,15 @@ func (Grid) Fields() []ent.Field {
 		field.String("token").MaxLen(50),
 		field.String("symbol").MaxLen(32),
 		field.String("strategyId").MaxLen(50),
-		field.Int("gridNumber").Min(0),
+		field.Int("gridNumber"),
 		field.String("orderPrice").GoType(decimal.Decimal{}),
 		field.String("finalPrice").GoType(decimal.Decimal{}),
 		field.String("amount").GoType(decimal.Decimal{}),
 		field.String("quantity").GoType(decimal.Decimal{}),
+		field.String("sellPrice").GoType(decimal.Decimal{}).Nillable().Optional(),
+		field.String("takeProfitPrice").GoType(decimal.Decimal{}).Nillable().Optional(),
+		field.String("peakPrice").GoType(decimal.Decimal{}).Nillable().Optional(),
+		field.Bool("runner").Optional(),
 		field.Enum("status").Values("buying", "selling", "bought"),
 	}
 }
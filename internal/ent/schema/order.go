@@ -34,11 +34,22 @@ func (Order) Fields() []ent.Field {
 		field.String("finalPrice").GoType(decimal.Decimal{}),
 		field.String("inAmount").GoType(decimal.Decimal{}),
 		field.String("outAmount").GoType(decimal.Decimal{}),
-		field.Enum("status").Values("pending", "closed", "rejected"),
+		field.Enum("status").Values("submitting", "pending", "submitted", "timeout", "replaced", "dropped", "closed", "rejected"),
 		field.Uint64("nonce"),
 		field.String("txHash").MaxLen(100),
 		field.String("reason").MaxLen(500),
 		field.String("profit").GoType(decimal.Decimal{}).Nillable().Optional(),
+		field.Bool("paper").Optional(),
+		field.Text("rawTx").Nillable().Optional(),
+		field.Text("replacedTxHashes").Nillable().Optional(),
+		field.Int("replaceCount").Default(0),
+		field.Time("replaceTime").Nillable().Optional(),
+		field.Bool("cancelled").Default(false),
+		field.Int("retryCount").Default(0),
+		field.Time("nextRetryTime").Nillable().Optional(),
+		field.String("route").MaxLen(100).Nillable().Optional(),
+		field.String("runnerUpRoute").MaxLen(100).Nillable().Optional(),
+		field.String("routeSpreadUsd").GoType(decimal.Decimal{}).Nillable().Optional(),
 	}
 }
 
@@ -25,7 +25,7 @@ func (Settings) Fields() []ent.Field {
 		field.Int("slippageBps").Min(0),
 		field.Int("sellSlippageBps").Min(0).Nillable().Optional(),
 		field.Int("exitSlippageBps").Min(0).Nillable().Optional(),
-		field.Enum("dexAggregator").Values("relay"),
+		field.Enum("dexAggregator").Values("relay", "okx", "onchain", "best"),
 		field.Bool("enableInfiniteApproval").Nillable().Optional(),
 	}
 }
@@ -28,7 +28,20 @@ func (Strategy) Fields() []ent.Field {
 		field.String("symbol").MaxLen(32),
 		field.Float("martinFactor").Min(1),
 		field.Int("maxGridLimit").Min(1).Nillable().Optional(),
+		field.Enum("gridMode").Values("long", "neutral").Default("long"),
+		field.Bool("inventorySeeded").Optional(),
+		field.Enum("gridType").Values("geometric", "arithmetic", "custom").Default("geometric"),
+		field.Int("gridCount").Min(1).Nillable().Optional(),
+		field.String("priceStep").GoType(decimal.Decimal{}).Nillable().Optional(),
+		field.Text("gridLadder").Nillable().Optional(),
 		field.String("takeProfitRatio").GoType(decimal.Decimal{}),
+		field.String("trailingTakeProfit").GoType(decimal.Decimal{}).Nillable().Optional(),
+		field.String("takeProfitPortion").GoType(decimal.Decimal{}).Nillable().Optional(),
+		field.Bool("adaptiveSpacing").Optional(),
+		field.String("adaptiveMinRatio").GoType(decimal.Decimal{}).Nillable().Optional(),
+		field.String("adaptiveMaxRatio").GoType(decimal.Decimal{}).Nillable().Optional(),
+		field.String("adaptiveVolatility").GoType(decimal.Decimal{}).Nillable().Optional(),
+		field.Time("adaptiveUpdateTime").Nillable().Optional(),
 		field.String("upperPriceBound").GoType(decimal.Decimal{}),
 		field.String("lowerPriceBound").GoType(decimal.Decimal{}),
 		field.String("initialOrderSize").GoType(decimal.Decimal{}),
@@ -40,17 +53,27 @@ func (Strategy) Fields() []ent.Field {
 		field.String("takeProfitExit").GoType(decimal.Decimal{}).Nillable().Optional(),
 		field.String("globalTakeProfitRatio").GoType(decimal.Decimal{}).Nillable().Optional(),
 		field.Bool("dynamicStopLoss").Optional(),
+		field.Bool("trailingUp").Optional(),
+		field.Bool("trailingDown").Optional(),
+		field.Int("trailingDwellMinutes").Optional().Default(0),
 		field.Bool("dropOn").Optional(),
 		field.Int("candlesToCheck").Optional().Default(0),
 		field.String("dropThreshold").GoType(decimal.Decimal{}).Nillable().Optional(),
+		field.String("rsiThreshold").GoType(decimal.Decimal{}).Nillable().Optional(),
+		field.Enum("emaFilter").Values("off", "above", "below").Default("off"),
+		field.Int("emaPeriod").Optional().Default(0),
+		field.Bool("macdFilter").Optional(),
 		field.Bool("enableAutoBuy"),
 		field.Bool("enableAutoSell"),
 		field.Bool("enableAutoExit"),
 		field.Bool("enablePushNotification"),
+		field.Bool("paperTrading").Optional(),
 		field.Enum("status").Values("active", "inactive"),
 		field.String("gridTrend").Nillable().Optional(),
+		field.String("buyFilterReason").MaxLen(200).Nillable().Optional(),
 		field.Time("lastLowerThresholdAlertTime").Nillable().Optional(),
 		field.Time("lastUpperThresholdAlertTime").Nillable().Optional(),
+		field.Time("outOfRangeTime").Nillable().Optional(),
 	}
 }
 
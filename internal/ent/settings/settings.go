@@ -77,7 +77,10 @@ type DexAggregator string
 
 // DexAggregator values.
 const (
-	DexAggregatorRelay DexAggregator = "relay"
+	DexAggregatorRelay   DexAggregator = "relay"
+	DexAggregatorOkx     DexAggregator = "okx"
+	DexAggregatorOnchain DexAggregator = "onchain"
+	DexAggregatorBest    DexAggregator = "best"
 )
 
 func (da DexAggregator) String() string {
@@ -87,7 +90,7 @@ func (da DexAggregator) String() string {
 // DexAggregatorValidator is a validator for the "dexAggregator" field enum values. It is called by the builders before save.
 func DexAggregatorValidator(da DexAggregator) error {
 	switch da {
-	case DexAggregatorRelay:
+	case DexAggregatorRelay, DexAggregatorOkx, DexAggregatorOnchain, DexAggregatorBest:
 		return nil
 	default:
 		return fmt.Errorf("settings: invalid enum value for dexAggregator field: %q", da)
@@ -35,8 +35,34 @@ type Strategy struct {
 	MartinFactor float64 `json:"martinFactor,omitempty"`
 	// MaxGridLimit holds the value of the "maxGridLimit" field.
 	MaxGridLimit *int `json:"maxGridLimit,omitempty"`
+	// GridMode holds the value of the "gridMode" field.
+	GridMode strategy.GridMode `json:"gridMode,omitempty"`
+	// InventorySeeded holds the value of the "inventorySeeded" field.
+	InventorySeeded bool `json:"inventorySeeded,omitempty"`
+	// GridType holds the value of the "gridType" field.
+	GridType strategy.GridType `json:"gridType,omitempty"`
+	// GridCount holds the value of the "gridCount" field.
+	GridCount *int `json:"gridCount,omitempty"`
+	// PriceStep holds the value of the "priceStep" field.
+	PriceStep *decimal.Decimal `json:"priceStep,omitempty"`
+	// GridLadder holds the value of the "gridLadder" field.
+	GridLadder *string `json:"gridLadder,omitempty"`
 	// TakeProfitRatio holds the value of the "takeProfitRatio" field.
 	TakeProfitRatio decimal.Decimal `json:"takeProfitRatio,omitempty"`
+	// TrailingTakeProfit holds the value of the "trailingTakeProfit" field.
+	TrailingTakeProfit *decimal.Decimal `json:"trailingTakeProfit,omitempty"`
+	// TakeProfitPortion holds the value of the "takeProfitPortion" field.
+	TakeProfitPortion *decimal.Decimal `json:"takeProfitPortion,omitempty"`
+	// AdaptiveSpacing holds the value of the "adaptiveSpacing" field.
+	AdaptiveSpacing bool `json:"adaptiveSpacing,omitempty"`
+	// AdaptiveMinRatio holds the value of the "adaptiveMinRatio" field.
+	AdaptiveMinRatio *decimal.Decimal `json:"adaptiveMinRatio,omitempty"`
+	// AdaptiveMaxRatio holds the value of the "adaptiveMaxRatio" field.
+	AdaptiveMaxRatio *decimal.Decimal `json:"adaptiveMaxRatio,omitempty"`
+	// AdaptiveVolatility holds the value of the "adaptiveVolatility" field.
+	AdaptiveVolatility *decimal.Decimal `json:"adaptiveVolatility,omitempty"`
+	// AdaptiveUpdateTime holds the value of the "adaptiveUpdateTime" field.
+	AdaptiveUpdateTime *time.Time `json:"adaptiveUpdateTime,omitempty"`
 	// UpperPriceBound holds the value of the "upperPriceBound" field.
 	UpperPriceBound decimal.Decimal `json:"upperPriceBound,omitempty"`
 	// LowerPriceBound holds the value of the "lowerPriceBound" field.
@@ -59,12 +85,26 @@ type Strategy struct {
 	GlobalTakeProfitRatio *decimal.Decimal `json:"globalTakeProfitRatio,omitempty"`
 	// DynamicStopLoss holds the value of the "dynamicStopLoss" field.
 	DynamicStopLoss bool `json:"dynamicStopLoss,omitempty"`
+	// TrailingUp holds the value of the "trailingUp" field.
+	TrailingUp bool `json:"trailingUp,omitempty"`
+	// TrailingDown holds the value of the "trailingDown" field.
+	TrailingDown bool `json:"trailingDown,omitempty"`
+	// TrailingDwellMinutes holds the value of the "trailingDwellMinutes" field.
+	TrailingDwellMinutes int `json:"trailingDwellMinutes,omitempty"`
 	// DropOn holds the value of the "dropOn" field.
 	DropOn bool `json:"dropOn,omitempty"`
 	// CandlesToCheck holds the value of the "candlesToCheck" field.
 	CandlesToCheck int `json:"candlesToCheck,omitempty"`
 	// DropThreshold holds the value of the "dropThreshold" field.
 	DropThreshold *decimal.Decimal `json:"dropThreshold,omitempty"`
+	// RsiThreshold holds the value of the "rsiThreshold" field.
+	RsiThreshold *decimal.Decimal `json:"rsiThreshold,omitempty"`
+	// EmaFilter holds the value of the "emaFilter" field.
+	EmaFilter strategy.EmaFilter `json:"emaFilter,omitempty"`
+	// EmaPeriod holds the value of the "emaPeriod" field.
+	EmaPeriod int `json:"emaPeriod,omitempty"`
+	// MacdFilter holds the value of the "macdFilter" field.
+	MacdFilter bool `json:"macdFilter,omitempty"`
 	// EnableAutoBuy holds the value of the "enableAutoBuy" field.
 	EnableAutoBuy bool `json:"enableAutoBuy,omitempty"`
 	// EnableAutoSell holds the value of the "enableAutoSell" field.
@@ -73,15 +113,21 @@ type Strategy struct {
 	EnableAutoExit bool `json:"enableAutoExit,omitempty"`
 	// EnablePushNotification holds the value of the "enablePushNotification" field.
 	EnablePushNotification bool `json:"enablePushNotification,omitempty"`
+	// PaperTrading holds the value of the "paperTrading" field.
+	PaperTrading bool `json:"paperTrading,omitempty"`
 	// Status holds the value of the "status" field.
 	Status strategy.Status `json:"status,omitempty"`
 	// GridTrend holds the value of the "gridTrend" field.
 	GridTrend *string `json:"gridTrend,omitempty"`
+	// BuyFilterReason holds the value of the "buyFilterReason" field.
+	BuyFilterReason *string `json:"buyFilterReason,omitempty"`
 	// LastLowerThresholdAlertTime holds the value of the "lastLowerThresholdAlertTime" field.
 	LastLowerThresholdAlertTime *time.Time `json:"lastLowerThresholdAlertTime,omitempty"`
 	// LastUpperThresholdAlertTime holds the value of the "lastUpperThresholdAlertTime" field.
 	LastUpperThresholdAlertTime *time.Time `json:"lastUpperThresholdAlertTime,omitempty"`
-	selectValues                sql.SelectValues
+	// OutOfRangeTime holds the value of the "outOfRangeTime" field.
+	OutOfRangeTime *time.Time `json:"outOfRangeTime,omitempty"`
+	selectValues   sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -89,19 +135,19 @@ func (*Strategy) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case strategy.FieldLastKlineVolume, strategy.FieldFiveKlineVolume, strategy.FieldUpperBoundExit, strategy.FieldStopLossExit, strategy.FieldTakeProfitExit, strategy.FieldGlobalTakeProfitRatio, strategy.FieldDropThreshold:
+		case strategy.FieldPriceStep, strategy.FieldTrailingTakeProfit, strategy.FieldTakeProfitPortion, strategy.FieldAdaptiveMinRatio, strategy.FieldAdaptiveMaxRatio, strategy.FieldAdaptiveVolatility, strategy.FieldLastKlineVolume, strategy.FieldFiveKlineVolume, strategy.FieldUpperBoundExit, strategy.FieldStopLossExit, strategy.FieldTakeProfitExit, strategy.FieldGlobalTakeProfitRatio, strategy.FieldDropThreshold, strategy.FieldRsiThreshold:
 			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
 		case strategy.FieldTakeProfitRatio, strategy.FieldUpperPriceBound, strategy.FieldLowerPriceBound, strategy.FieldInitialOrderSize:
 			values[i] = new(decimal.Decimal)
-		case strategy.FieldDynamicStopLoss, strategy.FieldDropOn, strategy.FieldEnableAutoBuy, strategy.FieldEnableAutoSell, strategy.FieldEnableAutoExit, strategy.FieldEnablePushNotification:
+		case strategy.FieldInventorySeeded, strategy.FieldAdaptiveSpacing, strategy.FieldDynamicStopLoss, strategy.FieldTrailingUp, strategy.FieldTrailingDown, strategy.FieldDropOn, strategy.FieldMacdFilter, strategy.FieldEnableAutoBuy, strategy.FieldEnableAutoSell, strategy.FieldEnableAutoExit, strategy.FieldEnablePushNotification, strategy.FieldPaperTrading:
 			values[i] = new(sql.NullBool)
 		case strategy.FieldMartinFactor:
 			values[i] = new(sql.NullFloat64)
-		case strategy.FieldID, strategy.FieldUserId, strategy.FieldMaxGridLimit, strategy.FieldFirstOrderId, strategy.FieldCandlesToCheck:
+		case strategy.FieldID, strategy.FieldUserId, strategy.FieldMaxGridLimit, strategy.FieldGridCount, strategy.FieldFirstOrderId, strategy.FieldTrailingDwellMinutes, strategy.FieldCandlesToCheck, strategy.FieldEmaPeriod:
 			values[i] = new(sql.NullInt64)
-		case strategy.FieldGUID, strategy.FieldToken, strategy.FieldSymbol, strategy.FieldStatus, strategy.FieldGridTrend:
+		case strategy.FieldGUID, strategy.FieldToken, strategy.FieldSymbol, strategy.FieldGridMode, strategy.FieldGridType, strategy.FieldGridLadder, strategy.FieldEmaFilter, strategy.FieldStatus, strategy.FieldGridTrend, strategy.FieldBuyFilterReason:
 			values[i] = new(sql.NullString)
-		case strategy.FieldCreateTime, strategy.FieldUpdateTime, strategy.FieldLastLowerThresholdAlertTime, strategy.FieldLastUpperThresholdAlertTime:
+		case strategy.FieldCreateTime, strategy.FieldUpdateTime, strategy.FieldAdaptiveUpdateTime, strategy.FieldLastLowerThresholdAlertTime, strategy.FieldLastUpperThresholdAlertTime, strategy.FieldOutOfRangeTime:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -173,12 +219,99 @@ func (_m *Strategy) assignValues(columns []string, values []any) error {
 				_m.MaxGridLimit = new(int)
 				*_m.MaxGridLimit = int(value.Int64)
 			}
+		case strategy.FieldGridMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field gridMode", values[i])
+			} else if value.Valid {
+				_m.GridMode = strategy.GridMode(value.String)
+			}
+		case strategy.FieldInventorySeeded:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field inventorySeeded", values[i])
+			} else if value.Valid {
+				_m.InventorySeeded = value.Bool
+			}
+		case strategy.FieldGridType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field gridType", values[i])
+			} else if value.Valid {
+				_m.GridType = strategy.GridType(value.String)
+			}
+		case strategy.FieldGridCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field gridCount", values[i])
+			} else if value.Valid {
+				_m.GridCount = new(int)
+				*_m.GridCount = int(value.Int64)
+			}
+		case strategy.FieldPriceStep:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field priceStep", values[i])
+			} else if value.Valid {
+				_m.PriceStep = new(decimal.Decimal)
+				*_m.PriceStep = *value.S.(*decimal.Decimal)
+			}
+		case strategy.FieldGridLadder:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field gridLadder", values[i])
+			} else if value.Valid {
+				_m.GridLadder = new(string)
+				*_m.GridLadder = value.String
+			}
 		case strategy.FieldTakeProfitRatio:
 			if value, ok := values[i].(*decimal.Decimal); !ok {
 				return fmt.Errorf("unexpected type %T for field takeProfitRatio", values[i])
 			} else if value != nil {
 				_m.TakeProfitRatio = *value
 			}
+		case strategy.FieldTrailingTakeProfit:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field trailingTakeProfit", values[i])
+			} else if value.Valid {
+				_m.TrailingTakeProfit = new(decimal.Decimal)
+				*_m.TrailingTakeProfit = *value.S.(*decimal.Decimal)
+			}
+		case strategy.FieldTakeProfitPortion:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field takeProfitPortion", values[i])
+			} else if value.Valid {
+				_m.TakeProfitPortion = new(decimal.Decimal)
+				*_m.TakeProfitPortion = *value.S.(*decimal.Decimal)
+			}
+		case strategy.FieldAdaptiveSpacing:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field adaptiveSpacing", values[i])
+			} else if value.Valid {
+				_m.AdaptiveSpacing = value.Bool
+			}
+		case strategy.FieldAdaptiveMinRatio:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field adaptiveMinRatio", values[i])
+			} else if value.Valid {
+				_m.AdaptiveMinRatio = new(decimal.Decimal)
+				*_m.AdaptiveMinRatio = *value.S.(*decimal.Decimal)
+			}
+		case strategy.FieldAdaptiveMaxRatio:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field adaptiveMaxRatio", values[i])
+			} else if value.Valid {
+				_m.AdaptiveMaxRatio = new(decimal.Decimal)
+				*_m.AdaptiveMaxRatio = *value.S.(*decimal.Decimal)
+			}
+		case strategy.FieldAdaptiveVolatility:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field adaptiveVolatility", values[i])
+			} else if value.Valid {
+				_m.AdaptiveVolatility = new(decimal.Decimal)
+				*_m.AdaptiveVolatility = *value.S.(*decimal.Decimal)
+			}
+		case strategy.FieldAdaptiveUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field adaptiveUpdateTime", values[i])
+			} else if value.Valid {
+				_m.AdaptiveUpdateTime = new(time.Time)
+				*_m.AdaptiveUpdateTime = value.Time
+			}
 		case strategy.FieldUpperPriceBound:
 			if value, ok := values[i].(*decimal.Decimal); !ok {
 				return fmt.Errorf("unexpected type %T for field upperPriceBound", values[i])
@@ -252,6 +385,24 @@ func (_m *Strategy) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.DynamicStopLoss = value.Bool
 			}
+		case strategy.FieldTrailingUp:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field trailingUp", values[i])
+			} else if value.Valid {
+				_m.TrailingUp = value.Bool
+			}
+		case strategy.FieldTrailingDown:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field trailingDown", values[i])
+			} else if value.Valid {
+				_m.TrailingDown = value.Bool
+			}
+		case strategy.FieldTrailingDwellMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field trailingDwellMinutes", values[i])
+			} else if value.Valid {
+				_m.TrailingDwellMinutes = int(value.Int64)
+			}
 		case strategy.FieldDropOn:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field dropOn", values[i])
@@ -271,6 +422,31 @@ func (_m *Strategy) assignValues(columns []string, values []any) error {
 				_m.DropThreshold = new(decimal.Decimal)
 				*_m.DropThreshold = *value.S.(*decimal.Decimal)
 			}
+		case strategy.FieldRsiThreshold:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field rsiThreshold", values[i])
+			} else if value.Valid {
+				_m.RsiThreshold = new(decimal.Decimal)
+				*_m.RsiThreshold = *value.S.(*decimal.Decimal)
+			}
+		case strategy.FieldEmaFilter:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field emaFilter", values[i])
+			} else if value.Valid {
+				_m.EmaFilter = strategy.EmaFilter(value.String)
+			}
+		case strategy.FieldEmaPeriod:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field emaPeriod", values[i])
+			} else if value.Valid {
+				_m.EmaPeriod = int(value.Int64)
+			}
+		case strategy.FieldMacdFilter:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field macdFilter", values[i])
+			} else if value.Valid {
+				_m.MacdFilter = value.Bool
+			}
 		case strategy.FieldEnableAutoBuy:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field enableAutoBuy", values[i])
@@ -295,6 +471,12 @@ func (_m *Strategy) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.EnablePushNotification = value.Bool
 			}
+		case strategy.FieldPaperTrading:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field paperTrading", values[i])
+			} else if value.Valid {
+				_m.PaperTrading = value.Bool
+			}
 		case strategy.FieldStatus:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
@@ -308,6 +490,13 @@ func (_m *Strategy) assignValues(columns []string, values []any) error {
 				_m.GridTrend = new(string)
 				*_m.GridTrend = value.String
 			}
+		case strategy.FieldBuyFilterReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field buyFilterReason", values[i])
+			} else if value.Valid {
+				_m.BuyFilterReason = new(string)
+				*_m.BuyFilterReason = value.String
+			}
 		case strategy.FieldLastLowerThresholdAlertTime:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field lastLowerThresholdAlertTime", values[i])
@@ -322,6 +511,13 @@ func (_m *Strategy) assignValues(columns []string, values []any) error {
 				_m.LastUpperThresholdAlertTime = new(time.Time)
 				*_m.LastUpperThresholdAlertTime = value.Time
 			}
+		case strategy.FieldOutOfRangeTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field outOfRangeTime", values[i])
+			} else if value.Valid {
+				_m.OutOfRangeTime = new(time.Time)
+				*_m.OutOfRangeTime = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -384,9 +580,66 @@ func (_m *Strategy) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	builder.WriteString("gridMode=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GridMode))
+	builder.WriteString(", ")
+	builder.WriteString("inventorySeeded=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InventorySeeded))
+	builder.WriteString(", ")
+	builder.WriteString("gridType=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GridType))
+	builder.WriteString(", ")
+	if v := _m.GridCount; v != nil {
+		builder.WriteString("gridCount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.PriceStep; v != nil {
+		builder.WriteString("priceStep=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.GridLadder; v != nil {
+		builder.WriteString("gridLadder=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("takeProfitRatio=")
 	builder.WriteString(fmt.Sprintf("%v", _m.TakeProfitRatio))
 	builder.WriteString(", ")
+	if v := _m.TrailingTakeProfit; v != nil {
+		builder.WriteString("trailingTakeProfit=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.TakeProfitPortion; v != nil {
+		builder.WriteString("takeProfitPortion=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("adaptiveSpacing=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AdaptiveSpacing))
+	builder.WriteString(", ")
+	if v := _m.AdaptiveMinRatio; v != nil {
+		builder.WriteString("adaptiveMinRatio=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.AdaptiveMaxRatio; v != nil {
+		builder.WriteString("adaptiveMaxRatio=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.AdaptiveVolatility; v != nil {
+		builder.WriteString("adaptiveVolatility=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.AdaptiveUpdateTime; v != nil {
+		builder.WriteString("adaptiveUpdateTime=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("upperPriceBound=")
 	builder.WriteString(fmt.Sprintf("%v", _m.UpperPriceBound))
 	builder.WriteString(", ")
@@ -434,6 +687,15 @@ func (_m *Strategy) String() string {
 	builder.WriteString("dynamicStopLoss=")
 	builder.WriteString(fmt.Sprintf("%v", _m.DynamicStopLoss))
 	builder.WriteString(", ")
+	builder.WriteString("trailingUp=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TrailingUp))
+	builder.WriteString(", ")
+	builder.WriteString("trailingDown=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TrailingDown))
+	builder.WriteString(", ")
+	builder.WriteString("trailingDwellMinutes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TrailingDwellMinutes))
+	builder.WriteString(", ")
 	builder.WriteString("dropOn=")
 	builder.WriteString(fmt.Sprintf("%v", _m.DropOn))
 	builder.WriteString(", ")
@@ -445,6 +707,20 @@ func (_m *Strategy) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	if v := _m.RsiThreshold; v != nil {
+		builder.WriteString("rsiThreshold=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("emaFilter=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EmaFilter))
+	builder.WriteString(", ")
+	builder.WriteString("emaPeriod=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EmaPeriod))
+	builder.WriteString(", ")
+	builder.WriteString("macdFilter=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MacdFilter))
+	builder.WriteString(", ")
 	builder.WriteString("enableAutoBuy=")
 	builder.WriteString(fmt.Sprintf("%v", _m.EnableAutoBuy))
 	builder.WriteString(", ")
@@ -457,6 +733,9 @@ func (_m *Strategy) String() string {
 	builder.WriteString("enablePushNotification=")
 	builder.WriteString(fmt.Sprintf("%v", _m.EnablePushNotification))
 	builder.WriteString(", ")
+	builder.WriteString("paperTrading=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PaperTrading))
+	builder.WriteString(", ")
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteString(", ")
@@ -465,6 +744,11 @@ func (_m *Strategy) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	if v := _m.BuyFilterReason; v != nil {
+		builder.WriteString("buyFilterReason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	if v := _m.LastLowerThresholdAlertTime; v != nil {
 		builder.WriteString("lastLowerThresholdAlertTime=")
 		builder.WriteString(v.Format(time.ANSIC))
@@ -474,6 +758,11 @@ func (_m *Strategy) String() string {
 		builder.WriteString("lastUpperThresholdAlertTime=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	if v := _m.OutOfRangeTime; v != nil {
+		builder.WriteString("outOfRangeTime=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
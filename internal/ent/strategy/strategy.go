@@ -30,8 +30,34 @@ const (
 	FieldMartinFactor = "martin_factor"
 	// FieldMaxGridLimit holds the string denoting the maxgridlimit field in the database.
 	FieldMaxGridLimit = "max_grid_limit"
+	// FieldGridMode holds the string denoting the gridmode field in the database.
+	FieldGridMode = "grid_mode"
+	// FieldInventorySeeded holds the string denoting the inventoryseeded field in the database.
+	FieldInventorySeeded = "inventory_seeded"
+	// FieldGridType holds the string denoting the gridtype field in the database.
+	FieldGridType = "grid_type"
+	// FieldGridCount holds the string denoting the gridcount field in the database.
+	FieldGridCount = "grid_count"
+	// FieldPriceStep holds the string denoting the pricestep field in the database.
+	FieldPriceStep = "price_step"
+	// FieldGridLadder holds the string denoting the gridladder field in the database.
+	FieldGridLadder = "grid_ladder"
 	// FieldTakeProfitRatio holds the string denoting the takeprofitratio field in the database.
 	FieldTakeProfitRatio = "take_profit_ratio"
+	// FieldTrailingTakeProfit holds the string denoting the trailingtakeprofit field in the database.
+	FieldTrailingTakeProfit = "trailing_take_profit"
+	// FieldTakeProfitPortion holds the string denoting the takeprofitportion field in the database.
+	FieldTakeProfitPortion = "take_profit_portion"
+	// FieldAdaptiveSpacing holds the string denoting the adaptivespacing field in the database.
+	FieldAdaptiveSpacing = "adaptive_spacing"
+	// FieldAdaptiveMinRatio holds the string denoting the adaptiveminratio field in the database.
+	FieldAdaptiveMinRatio = "adaptive_min_ratio"
+	// FieldAdaptiveMaxRatio holds the string denoting the adaptivemaxratio field in the database.
+	FieldAdaptiveMaxRatio = "adaptive_max_ratio"
+	// FieldAdaptiveVolatility holds the string denoting the adaptivevolatility field in the database.
+	FieldAdaptiveVolatility = "adaptive_volatility"
+	// FieldAdaptiveUpdateTime holds the string denoting the adaptiveupdatetime field in the database.
+	FieldAdaptiveUpdateTime = "adaptive_update_time"
 	// FieldUpperPriceBound holds the string denoting the upperpricebound field in the database.
 	FieldUpperPriceBound = "upper_price_bound"
 	// FieldLowerPriceBound holds the string denoting the lowerpricebound field in the database.
@@ -54,12 +80,26 @@ const (
 	FieldGlobalTakeProfitRatio = "global_take_profit_ratio"
 	// FieldDynamicStopLoss holds the string denoting the dynamicstoploss field in the database.
 	FieldDynamicStopLoss = "dynamic_stop_loss"
+	// FieldTrailingUp holds the string denoting the trailingup field in the database.
+	FieldTrailingUp = "trailing_up"
+	// FieldTrailingDown holds the string denoting the trailingdown field in the database.
+	FieldTrailingDown = "trailing_down"
+	// FieldTrailingDwellMinutes holds the string denoting the trailingdwellminutes field in the database.
+	FieldTrailingDwellMinutes = "trailing_dwell_minutes"
 	// FieldDropOn holds the string denoting the dropon field in the database.
 	FieldDropOn = "drop_on"
 	// FieldCandlesToCheck holds the string denoting the candlestocheck field in the database.
 	FieldCandlesToCheck = "candles_to_check"
 	// FieldDropThreshold holds the string denoting the dropthreshold field in the database.
 	FieldDropThreshold = "drop_threshold"
+	// FieldRsiThreshold holds the string denoting the rsithreshold field in the database.
+	FieldRsiThreshold = "rsi_threshold"
+	// FieldEmaFilter holds the string denoting the emafilter field in the database.
+	FieldEmaFilter = "ema_filter"
+	// FieldEmaPeriod holds the string denoting the emaperiod field in the database.
+	FieldEmaPeriod = "ema_period"
+	// FieldMacdFilter holds the string denoting the macdfilter field in the database.
+	FieldMacdFilter = "macd_filter"
 	// FieldEnableAutoBuy holds the string denoting the enableautobuy field in the database.
 	FieldEnableAutoBuy = "enable_auto_buy"
 	// FieldEnableAutoSell holds the string denoting the enableautosell field in the database.
@@ -68,14 +108,20 @@ const (
 	FieldEnableAutoExit = "enable_auto_exit"
 	// FieldEnablePushNotification holds the string denoting the enablepushnotification field in the database.
 	FieldEnablePushNotification = "enable_push_notification"
+	// FieldPaperTrading holds the string denoting the papertrading field in the database.
+	FieldPaperTrading = "paper_trading"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
 	// FieldGridTrend holds the string denoting the gridtrend field in the database.
 	FieldGridTrend = "grid_trend"
+	// FieldBuyFilterReason holds the string denoting the buyfilterreason field in the database.
+	FieldBuyFilterReason = "buy_filter_reason"
 	// FieldLastLowerThresholdAlertTime holds the string denoting the lastlowerthresholdalerttime field in the database.
 	FieldLastLowerThresholdAlertTime = "last_lower_threshold_alert_time"
 	// FieldLastUpperThresholdAlertTime holds the string denoting the lastupperthresholdalerttime field in the database.
 	FieldLastUpperThresholdAlertTime = "last_upper_threshold_alert_time"
+	// FieldOutOfRangeTime holds the string denoting the outofrangetime field in the database.
+	FieldOutOfRangeTime = "out_of_range_time"
 	// Table holds the table name of the strategy in the database.
 	Table = "strategies"
 )
@@ -91,7 +137,20 @@ var Columns = []string{
 	FieldSymbol,
 	FieldMartinFactor,
 	FieldMaxGridLimit,
+	FieldGridMode,
+	FieldInventorySeeded,
+	FieldGridType,
+	FieldGridCount,
+	FieldPriceStep,
+	FieldGridLadder,
 	FieldTakeProfitRatio,
+	FieldTrailingTakeProfit,
+	FieldTakeProfitPortion,
+	FieldAdaptiveSpacing,
+	FieldAdaptiveMinRatio,
+	FieldAdaptiveMaxRatio,
+	FieldAdaptiveVolatility,
+	FieldAdaptiveUpdateTime,
 	FieldUpperPriceBound,
 	FieldLowerPriceBound,
 	FieldInitialOrderSize,
@@ -103,17 +162,27 @@ var Columns = []string{
 	FieldTakeProfitExit,
 	FieldGlobalTakeProfitRatio,
 	FieldDynamicStopLoss,
+	FieldTrailingUp,
+	FieldTrailingDown,
+	FieldTrailingDwellMinutes,
 	FieldDropOn,
 	FieldCandlesToCheck,
 	FieldDropThreshold,
+	FieldRsiThreshold,
+	FieldEmaFilter,
+	FieldEmaPeriod,
+	FieldMacdFilter,
 	FieldEnableAutoBuy,
 	FieldEnableAutoSell,
 	FieldEnableAutoExit,
 	FieldEnablePushNotification,
+	FieldPaperTrading,
 	FieldStatus,
 	FieldGridTrend,
+	FieldBuyFilterReason,
 	FieldLastLowerThresholdAlertTime,
 	FieldLastUpperThresholdAlertTime,
+	FieldOutOfRangeTime,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -143,10 +212,98 @@ var (
 	MartinFactorValidator func(float64) error
 	// MaxGridLimitValidator is a validator for the "maxGridLimit" field. It is called by the builders before save.
 	MaxGridLimitValidator func(int) error
+	// GridCountValidator is a validator for the "gridCount" field. It is called by the builders before save.
+	GridCountValidator func(int) error
+	// DefaultTrailingDwellMinutes holds the default value on creation for the "trailingDwellMinutes" field.
+	DefaultTrailingDwellMinutes int
 	// DefaultCandlesToCheck holds the default value on creation for the "candlesToCheck" field.
 	DefaultCandlesToCheck int
+	// DefaultEmaPeriod holds the default value on creation for the "emaPeriod" field.
+	DefaultEmaPeriod int
+	// BuyFilterReasonValidator is a validator for the "buyFilterReason" field. It is called by the builders before save.
+	BuyFilterReasonValidator func(string) error
 )
 
+// GridMode defines the type for the "gridMode" enum field.
+type GridMode string
+
+// GridModeLong is the default value of the GridMode enum.
+const DefaultGridMode = GridModeLong
+
+// GridMode values.
+const (
+	GridModeLong    GridMode = "long"
+	GridModeNeutral GridMode = "neutral"
+)
+
+func (gm GridMode) String() string {
+	return string(gm)
+}
+
+// GridModeValidator is a validator for the "gridMode" field enum values. It is called by the builders before save.
+func GridModeValidator(gm GridMode) error {
+	switch gm {
+	case GridModeLong, GridModeNeutral:
+		return nil
+	default:
+		return fmt.Errorf("strategy: invalid enum value for gridMode field: %q", gm)
+	}
+}
+
+// GridType defines the type for the "gridType" enum field.
+type GridType string
+
+// GridTypeGeometric is the default value of the GridType enum.
+const DefaultGridType = GridTypeGeometric
+
+// GridType values.
+const (
+	GridTypeGeometric  GridType = "geometric"
+	GridTypeArithmetic GridType = "arithmetic"
+	GridTypeCustom     GridType = "custom"
+)
+
+func (gt GridType) String() string {
+	return string(gt)
+}
+
+// GridTypeValidator is a validator for the "gridType" field enum values. It is called by the builders before save.
+func GridTypeValidator(gt GridType) error {
+	switch gt {
+	case GridTypeGeometric, GridTypeArithmetic, GridTypeCustom:
+		return nil
+	default:
+		return fmt.Errorf("strategy: invalid enum value for gridType field: %q", gt)
+	}
+}
+
+// EmaFilter defines the type for the "emaFilter" enum field.
+type EmaFilter string
+
+// EmaFilterOff is the default value of the EmaFilter enum.
+const DefaultEmaFilter = EmaFilterOff
+
+// EmaFilter values.
+const (
+	EmaFilterOff   EmaFilter = "off"
+	EmaFilterAbove EmaFilter = "above"
+	EmaFilterBelow EmaFilter = "below"
+)
+
+func (ef EmaFilter) String() string {
+	return string(ef)
+}
+
+// EmaFilterValidator is a validator for the "emaFilter" field enum values. It is called by the builders before save.
+func EmaFilterValidator(ef EmaFilter) error {
+	switch ef {
+	case EmaFilterOff, EmaFilterAbove, EmaFilterBelow:
+		return nil
+	default:
+		return fmt.Errorf("strategy: invalid enum value for emaFilter field: %q", ef)
+	}
+}
+
 // Status defines the type for the "status" enum field.
 type Status string
 
@@ -218,11 +375,76 @@ func ByMaxGridLimit(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMaxGridLimit, opts...).ToFunc()
 }
 
+// ByGridMode orders the results by the gridMode field.
+func ByGridMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGridMode, opts...).ToFunc()
+}
+
+// ByInventorySeeded orders the results by the inventorySeeded field.
+func ByInventorySeeded(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInventorySeeded, opts...).ToFunc()
+}
+
+// ByGridType orders the results by the gridType field.
+func ByGridType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGridType, opts...).ToFunc()
+}
+
+// ByGridCount orders the results by the gridCount field.
+func ByGridCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGridCount, opts...).ToFunc()
+}
+
+// ByPriceStep orders the results by the priceStep field.
+func ByPriceStep(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriceStep, opts...).ToFunc()
+}
+
+// ByGridLadder orders the results by the gridLadder field.
+func ByGridLadder(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGridLadder, opts...).ToFunc()
+}
+
 // ByTakeProfitRatio orders the results by the takeProfitRatio field.
 func ByTakeProfitRatio(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTakeProfitRatio, opts...).ToFunc()
 }
 
+// ByTrailingTakeProfit orders the results by the trailingTakeProfit field.
+func ByTrailingTakeProfit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrailingTakeProfit, opts...).ToFunc()
+}
+
+// ByTakeProfitPortion orders the results by the takeProfitPortion field.
+func ByTakeProfitPortion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTakeProfitPortion, opts...).ToFunc()
+}
+
+// ByAdaptiveSpacing orders the results by the adaptiveSpacing field.
+func ByAdaptiveSpacing(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAdaptiveSpacing, opts...).ToFunc()
+}
+
+// ByAdaptiveMinRatio orders the results by the adaptiveMinRatio field.
+func ByAdaptiveMinRatio(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAdaptiveMinRatio, opts...).ToFunc()
+}
+
+// ByAdaptiveMaxRatio orders the results by the adaptiveMaxRatio field.
+func ByAdaptiveMaxRatio(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAdaptiveMaxRatio, opts...).ToFunc()
+}
+
+// ByAdaptiveVolatility orders the results by the adaptiveVolatility field.
+func ByAdaptiveVolatility(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAdaptiveVolatility, opts...).ToFunc()
+}
+
+// ByAdaptiveUpdateTime orders the results by the adaptiveUpdateTime field.
+func ByAdaptiveUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAdaptiveUpdateTime, opts...).ToFunc()
+}
+
 // ByUpperPriceBound orders the results by the upperPriceBound field.
 func ByUpperPriceBound(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpperPriceBound, opts...).ToFunc()
@@ -278,6 +500,21 @@ func ByDynamicStopLoss(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDynamicStopLoss, opts...).ToFunc()
 }
 
+// ByTrailingUp orders the results by the trailingUp field.
+func ByTrailingUp(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrailingUp, opts...).ToFunc()
+}
+
+// ByTrailingDown orders the results by the trailingDown field.
+func ByTrailingDown(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrailingDown, opts...).ToFunc()
+}
+
+// ByTrailingDwellMinutes orders the results by the trailingDwellMinutes field.
+func ByTrailingDwellMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrailingDwellMinutes, opts...).ToFunc()
+}
+
 // ByDropOn orders the results by the dropOn field.
 func ByDropOn(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDropOn, opts...).ToFunc()
@@ -293,6 +530,26 @@ func ByDropThreshold(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDropThreshold, opts...).ToFunc()
 }
 
+// ByRsiThreshold orders the results by the rsiThreshold field.
+func ByRsiThreshold(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRsiThreshold, opts...).ToFunc()
+}
+
+// ByEmaFilter orders the results by the emaFilter field.
+func ByEmaFilter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmaFilter, opts...).ToFunc()
+}
+
+// ByEmaPeriod orders the results by the emaPeriod field.
+func ByEmaPeriod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmaPeriod, opts...).ToFunc()
+}
+
+// ByMacdFilter orders the results by the macdFilter field.
+func ByMacdFilter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMacdFilter, opts...).ToFunc()
+}
+
 // ByEnableAutoBuy orders the results by the enableAutoBuy field.
 func ByEnableAutoBuy(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEnableAutoBuy, opts...).ToFunc()
@@ -313,6 +570,11 @@ func ByEnablePushNotification(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEnablePushNotification, opts...).ToFunc()
 }
 
+// ByPaperTrading orders the results by the paperTrading field.
+func ByPaperTrading(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaperTrading, opts...).ToFunc()
+}
+
 // ByStatus orders the results by the status field.
 func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
@@ -323,6 +585,11 @@ func ByGridTrend(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldGridTrend, opts...).ToFunc()
 }
 
+// ByBuyFilterReason orders the results by the buyFilterReason field.
+func ByBuyFilterReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBuyFilterReason, opts...).ToFunc()
+}
+
 // ByLastLowerThresholdAlertTime orders the results by the lastLowerThresholdAlertTime field.
 func ByLastLowerThresholdAlertTime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastLowerThresholdAlertTime, opts...).ToFunc()
@@ -332,3 +599,8 @@ func ByLastLowerThresholdAlertTime(opts ...sql.OrderTermOption) OrderOption {
 func ByLastUpperThresholdAlertTime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastUpperThresholdAlertTime, opts...).ToFunc()
 }
+
+// ByOutOfRangeTime orders the results by the outOfRangeTime field.
+func ByOutOfRangeTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOutOfRangeTime, opts...).ToFunc()
+}
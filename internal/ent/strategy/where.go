@@ -96,11 +96,66 @@ func MaxGridLimit(v int) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldMaxGridLimit, v))
 }
 
+// InventorySeeded applies equality check predicate on the "inventorySeeded" field. It's identical to InventorySeededEQ.
+func InventorySeeded(v bool) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldInventorySeeded, v))
+}
+
+// GridCount applies equality check predicate on the "gridCount" field. It's identical to GridCountEQ.
+func GridCount(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldGridCount, v))
+}
+
+// PriceStep applies equality check predicate on the "priceStep" field. It's identical to PriceStepEQ.
+func PriceStep(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldPriceStep, v))
+}
+
+// GridLadder applies equality check predicate on the "gridLadder" field. It's identical to GridLadderEQ.
+func GridLadder(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldGridLadder, v))
+}
+
 // TakeProfitRatio applies equality check predicate on the "takeProfitRatio" field. It's identical to TakeProfitRatioEQ.
 func TakeProfitRatio(v decimal.Decimal) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldTakeProfitRatio, v))
 }
 
+// TrailingTakeProfit applies equality check predicate on the "trailingTakeProfit" field. It's identical to TrailingTakeProfitEQ.
+func TrailingTakeProfit(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldTrailingTakeProfit, v))
+}
+
+// TakeProfitPortion applies equality check predicate on the "takeProfitPortion" field. It's identical to TakeProfitPortionEQ.
+func TakeProfitPortion(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldTakeProfitPortion, v))
+}
+
+// AdaptiveSpacing applies equality check predicate on the "adaptiveSpacing" field. It's identical to AdaptiveSpacingEQ.
+func AdaptiveSpacing(v bool) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldAdaptiveSpacing, v))
+}
+
+// AdaptiveMinRatio applies equality check predicate on the "adaptiveMinRatio" field. It's identical to AdaptiveMinRatioEQ.
+func AdaptiveMinRatio(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldAdaptiveMinRatio, v))
+}
+
+// AdaptiveMaxRatio applies equality check predicate on the "adaptiveMaxRatio" field. It's identical to AdaptiveMaxRatioEQ.
+func AdaptiveMaxRatio(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldAdaptiveMaxRatio, v))
+}
+
+// AdaptiveVolatility applies equality check predicate on the "adaptiveVolatility" field. It's identical to AdaptiveVolatilityEQ.
+func AdaptiveVolatility(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldAdaptiveVolatility, v))
+}
+
+// AdaptiveUpdateTime applies equality check predicate on the "adaptiveUpdateTime" field. It's identical to AdaptiveUpdateTimeEQ.
+func AdaptiveUpdateTime(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldAdaptiveUpdateTime, v))
+}
+
 // UpperPriceBound applies equality check predicate on the "upperPriceBound" field. It's identical to UpperPriceBoundEQ.
 func UpperPriceBound(v decimal.Decimal) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldUpperPriceBound, v))
@@ -156,6 +211,21 @@ func DynamicStopLoss(v bool) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldDynamicStopLoss, v))
 }
 
+// TrailingUp applies equality check predicate on the "trailingUp" field. It's identical to TrailingUpEQ.
+func TrailingUp(v bool) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldTrailingUp, v))
+}
+
+// TrailingDown applies equality check predicate on the "trailingDown" field. It's identical to TrailingDownEQ.
+func TrailingDown(v bool) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldTrailingDown, v))
+}
+
+// TrailingDwellMinutes applies equality check predicate on the "trailingDwellMinutes" field. It's identical to TrailingDwellMinutesEQ.
+func TrailingDwellMinutes(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldTrailingDwellMinutes, v))
+}
+
 // DropOn applies equality check predicate on the "dropOn" field. It's identical to DropOnEQ.
 func DropOn(v bool) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldDropOn, v))
@@ -171,6 +241,21 @@ func DropThreshold(v decimal.Decimal) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldDropThreshold, v))
 }
 
+// RsiThreshold applies equality check predicate on the "rsiThreshold" field. It's identical to RsiThresholdEQ.
+func RsiThreshold(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldRsiThreshold, v))
+}
+
+// EmaPeriod applies equality check predicate on the "emaPeriod" field. It's identical to EmaPeriodEQ.
+func EmaPeriod(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldEmaPeriod, v))
+}
+
+// MacdFilter applies equality check predicate on the "macdFilter" field. It's identical to MacdFilterEQ.
+func MacdFilter(v bool) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldMacdFilter, v))
+}
+
 // EnableAutoBuy applies equality check predicate on the "enableAutoBuy" field. It's identical to EnableAutoBuyEQ.
 func EnableAutoBuy(v bool) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldEnableAutoBuy, v))
@@ -191,11 +276,21 @@ func EnablePushNotification(v bool) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldEnablePushNotification, v))
 }
 
+// PaperTrading applies equality check predicate on the "paperTrading" field. It's identical to PaperTradingEQ.
+func PaperTrading(v bool) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldPaperTrading, v))
+}
+
 // GridTrend applies equality check predicate on the "gridTrend" field. It's identical to GridTrendEQ.
 func GridTrend(v string) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldGridTrend, v))
 }
 
+// BuyFilterReason applies equality check predicate on the "buyFilterReason" field. It's identical to BuyFilterReasonEQ.
+func BuyFilterReason(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldBuyFilterReason, v))
+}
+
 // LastLowerThresholdAlertTime applies equality check predicate on the "lastLowerThresholdAlertTime" field. It's identical to LastLowerThresholdAlertTimeEQ.
 func LastLowerThresholdAlertTime(v time.Time) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldLastLowerThresholdAlertTime, v))
@@ -206,6 +301,11 @@ func LastUpperThresholdAlertTime(v time.Time) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldLastUpperThresholdAlertTime, v))
 }
 
+// OutOfRangeTime applies equality check predicate on the "outOfRangeTime" field. It's identical to OutOfRangeTimeEQ.
+func OutOfRangeTime(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldOutOfRangeTime, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldCreateTime, v))
@@ -546,139 +646,874 @@ func MartinFactorGT(v float64) predicate.Strategy {
 	return predicate.Strategy(sql.FieldGT(FieldMartinFactor, v))
 }
 
-// MartinFactorGTE applies the GTE predicate on the "martinFactor" field.
-func MartinFactorGTE(v float64) predicate.Strategy {
-	return predicate.Strategy(sql.FieldGTE(FieldMartinFactor, v))
+// MartinFactorGTE applies the GTE predicate on the "martinFactor" field.
+func MartinFactorGTE(v float64) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldMartinFactor, v))
+}
+
+// MartinFactorLT applies the LT predicate on the "martinFactor" field.
+func MartinFactorLT(v float64) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldMartinFactor, v))
+}
+
+// MartinFactorLTE applies the LTE predicate on the "martinFactor" field.
+func MartinFactorLTE(v float64) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldMartinFactor, v))
+}
+
+// MaxGridLimitEQ applies the EQ predicate on the "maxGridLimit" field.
+func MaxGridLimitEQ(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldMaxGridLimit, v))
+}
+
+// MaxGridLimitNEQ applies the NEQ predicate on the "maxGridLimit" field.
+func MaxGridLimitNEQ(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldMaxGridLimit, v))
+}
+
+// MaxGridLimitIn applies the In predicate on the "maxGridLimit" field.
+func MaxGridLimitIn(vs ...int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldMaxGridLimit, vs...))
+}
+
+// MaxGridLimitNotIn applies the NotIn predicate on the "maxGridLimit" field.
+func MaxGridLimitNotIn(vs ...int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldMaxGridLimit, vs...))
+}
+
+// MaxGridLimitGT applies the GT predicate on the "maxGridLimit" field.
+func MaxGridLimitGT(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldMaxGridLimit, v))
+}
+
+// MaxGridLimitGTE applies the GTE predicate on the "maxGridLimit" field.
+func MaxGridLimitGTE(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldMaxGridLimit, v))
+}
+
+// MaxGridLimitLT applies the LT predicate on the "maxGridLimit" field.
+func MaxGridLimitLT(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldMaxGridLimit, v))
+}
+
+// MaxGridLimitLTE applies the LTE predicate on the "maxGridLimit" field.
+func MaxGridLimitLTE(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldMaxGridLimit, v))
+}
+
+// MaxGridLimitIsNil applies the IsNil predicate on the "maxGridLimit" field.
+func MaxGridLimitIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldMaxGridLimit))
+}
+
+// MaxGridLimitNotNil applies the NotNil predicate on the "maxGridLimit" field.
+func MaxGridLimitNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldMaxGridLimit))
+}
+
+// GridModeEQ applies the EQ predicate on the "gridMode" field.
+func GridModeEQ(v GridMode) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldGridMode, v))
+}
+
+// GridModeNEQ applies the NEQ predicate on the "gridMode" field.
+func GridModeNEQ(v GridMode) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldGridMode, v))
+}
+
+// GridModeIn applies the In predicate on the "gridMode" field.
+func GridModeIn(vs ...GridMode) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldGridMode, vs...))
+}
+
+// GridModeNotIn applies the NotIn predicate on the "gridMode" field.
+func GridModeNotIn(vs ...GridMode) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldGridMode, vs...))
+}
+
+// InventorySeededEQ applies the EQ predicate on the "inventorySeeded" field.
+func InventorySeededEQ(v bool) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldInventorySeeded, v))
+}
+
+// InventorySeededNEQ applies the NEQ predicate on the "inventorySeeded" field.
+func InventorySeededNEQ(v bool) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldInventorySeeded, v))
+}
+
+// InventorySeededIsNil applies the IsNil predicate on the "inventorySeeded" field.
+func InventorySeededIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldInventorySeeded))
+}
+
+// InventorySeededNotNil applies the NotNil predicate on the "inventorySeeded" field.
+func InventorySeededNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldInventorySeeded))
+}
+
+// GridTypeEQ applies the EQ predicate on the "gridType" field.
+func GridTypeEQ(v GridType) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldGridType, v))
+}
+
+// GridTypeNEQ applies the NEQ predicate on the "gridType" field.
+func GridTypeNEQ(v GridType) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldGridType, v))
+}
+
+// GridTypeIn applies the In predicate on the "gridType" field.
+func GridTypeIn(vs ...GridType) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldGridType, vs...))
+}
+
+// GridTypeNotIn applies the NotIn predicate on the "gridType" field.
+func GridTypeNotIn(vs ...GridType) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldGridType, vs...))
+}
+
+// GridCountEQ applies the EQ predicate on the "gridCount" field.
+func GridCountEQ(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldGridCount, v))
+}
+
+// GridCountNEQ applies the NEQ predicate on the "gridCount" field.
+func GridCountNEQ(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldGridCount, v))
+}
+
+// GridCountIn applies the In predicate on the "gridCount" field.
+func GridCountIn(vs ...int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldGridCount, vs...))
+}
+
+// GridCountNotIn applies the NotIn predicate on the "gridCount" field.
+func GridCountNotIn(vs ...int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldGridCount, vs...))
+}
+
+// GridCountGT applies the GT predicate on the "gridCount" field.
+func GridCountGT(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldGridCount, v))
+}
+
+// GridCountGTE applies the GTE predicate on the "gridCount" field.
+func GridCountGTE(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldGridCount, v))
+}
+
+// GridCountLT applies the LT predicate on the "gridCount" field.
+func GridCountLT(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldGridCount, v))
+}
+
+// GridCountLTE applies the LTE predicate on the "gridCount" field.
+func GridCountLTE(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldGridCount, v))
+}
+
+// GridCountIsNil applies the IsNil predicate on the "gridCount" field.
+func GridCountIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldGridCount))
+}
+
+// GridCountNotNil applies the NotNil predicate on the "gridCount" field.
+func GridCountNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldGridCount))
+}
+
+// PriceStepEQ applies the EQ predicate on the "priceStep" field.
+func PriceStepEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldPriceStep, v))
+}
+
+// PriceStepNEQ applies the NEQ predicate on the "priceStep" field.
+func PriceStepNEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldPriceStep, v))
+}
+
+// PriceStepIn applies the In predicate on the "priceStep" field.
+func PriceStepIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldPriceStep, vs...))
+}
+
+// PriceStepNotIn applies the NotIn predicate on the "priceStep" field.
+func PriceStepNotIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldPriceStep, vs...))
+}
+
+// PriceStepGT applies the GT predicate on the "priceStep" field.
+func PriceStepGT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldPriceStep, v))
+}
+
+// PriceStepGTE applies the GTE predicate on the "priceStep" field.
+func PriceStepGTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldPriceStep, v))
+}
+
+// PriceStepLT applies the LT predicate on the "priceStep" field.
+func PriceStepLT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldPriceStep, v))
+}
+
+// PriceStepLTE applies the LTE predicate on the "priceStep" field.
+func PriceStepLTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldPriceStep, v))
+}
+
+// PriceStepContains applies the Contains predicate on the "priceStep" field.
+func PriceStepContains(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContains(FieldPriceStep, vc))
+}
+
+// PriceStepHasPrefix applies the HasPrefix predicate on the "priceStep" field.
+func PriceStepHasPrefix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasPrefix(FieldPriceStep, vc))
+}
+
+// PriceStepHasSuffix applies the HasSuffix predicate on the "priceStep" field.
+func PriceStepHasSuffix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasSuffix(FieldPriceStep, vc))
+}
+
+// PriceStepIsNil applies the IsNil predicate on the "priceStep" field.
+func PriceStepIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldPriceStep))
+}
+
+// PriceStepNotNil applies the NotNil predicate on the "priceStep" field.
+func PriceStepNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldPriceStep))
+}
+
+// PriceStepEqualFold applies the EqualFold predicate on the "priceStep" field.
+func PriceStepEqualFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldEqualFold(FieldPriceStep, vc))
+}
+
+// PriceStepContainsFold applies the ContainsFold predicate on the "priceStep" field.
+func PriceStepContainsFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContainsFold(FieldPriceStep, vc))
+}
+
+// GridLadderEQ applies the EQ predicate on the "gridLadder" field.
+func GridLadderEQ(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldGridLadder, v))
+}
+
+// GridLadderNEQ applies the NEQ predicate on the "gridLadder" field.
+func GridLadderNEQ(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldGridLadder, v))
+}
+
+// GridLadderIn applies the In predicate on the "gridLadder" field.
+func GridLadderIn(vs ...string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldGridLadder, vs...))
+}
+
+// GridLadderNotIn applies the NotIn predicate on the "gridLadder" field.
+func GridLadderNotIn(vs ...string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldGridLadder, vs...))
+}
+
+// GridLadderGT applies the GT predicate on the "gridLadder" field.
+func GridLadderGT(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldGridLadder, v))
+}
+
+// GridLadderGTE applies the GTE predicate on the "gridLadder" field.
+func GridLadderGTE(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldGridLadder, v))
+}
+
+// GridLadderLT applies the LT predicate on the "gridLadder" field.
+func GridLadderLT(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldGridLadder, v))
+}
+
+// GridLadderLTE applies the LTE predicate on the "gridLadder" field.
+func GridLadderLTE(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldGridLadder, v))
+}
+
+// GridLadderContains applies the Contains predicate on the "gridLadder" field.
+func GridLadderContains(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldContains(FieldGridLadder, v))
+}
+
+// GridLadderHasPrefix applies the HasPrefix predicate on the "gridLadder" field.
+func GridLadderHasPrefix(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldHasPrefix(FieldGridLadder, v))
+}
+
+// GridLadderHasSuffix applies the HasSuffix predicate on the "gridLadder" field.
+func GridLadderHasSuffix(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldHasSuffix(FieldGridLadder, v))
+}
+
+// GridLadderIsNil applies the IsNil predicate on the "gridLadder" field.
+func GridLadderIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldGridLadder))
+}
+
+// GridLadderNotNil applies the NotNil predicate on the "gridLadder" field.
+func GridLadderNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldGridLadder))
+}
+
+// GridLadderEqualFold applies the EqualFold predicate on the "gridLadder" field.
+func GridLadderEqualFold(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEqualFold(FieldGridLadder, v))
+}
+
+// GridLadderContainsFold applies the ContainsFold predicate on the "gridLadder" field.
+func GridLadderContainsFold(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldContainsFold(FieldGridLadder, v))
+}
+
+// TakeProfitRatioEQ applies the EQ predicate on the "takeProfitRatio" field.
+func TakeProfitRatioEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldTakeProfitRatio, v))
+}
+
+// TakeProfitRatioNEQ applies the NEQ predicate on the "takeProfitRatio" field.
+func TakeProfitRatioNEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldTakeProfitRatio, v))
+}
+
+// TakeProfitRatioIn applies the In predicate on the "takeProfitRatio" field.
+func TakeProfitRatioIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldTakeProfitRatio, vs...))
+}
+
+// TakeProfitRatioNotIn applies the NotIn predicate on the "takeProfitRatio" field.
+func TakeProfitRatioNotIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldTakeProfitRatio, vs...))
+}
+
+// TakeProfitRatioGT applies the GT predicate on the "takeProfitRatio" field.
+func TakeProfitRatioGT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldTakeProfitRatio, v))
+}
+
+// TakeProfitRatioGTE applies the GTE predicate on the "takeProfitRatio" field.
+func TakeProfitRatioGTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldTakeProfitRatio, v))
+}
+
+// TakeProfitRatioLT applies the LT predicate on the "takeProfitRatio" field.
+func TakeProfitRatioLT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldTakeProfitRatio, v))
+}
+
+// TakeProfitRatioLTE applies the LTE predicate on the "takeProfitRatio" field.
+func TakeProfitRatioLTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldTakeProfitRatio, v))
+}
+
+// TakeProfitRatioContains applies the Contains predicate on the "takeProfitRatio" field.
+func TakeProfitRatioContains(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContains(FieldTakeProfitRatio, vc))
+}
+
+// TakeProfitRatioHasPrefix applies the HasPrefix predicate on the "takeProfitRatio" field.
+func TakeProfitRatioHasPrefix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasPrefix(FieldTakeProfitRatio, vc))
+}
+
+// TakeProfitRatioHasSuffix applies the HasSuffix predicate on the "takeProfitRatio" field.
+func TakeProfitRatioHasSuffix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasSuffix(FieldTakeProfitRatio, vc))
+}
+
+// TakeProfitRatioEqualFold applies the EqualFold predicate on the "takeProfitRatio" field.
+func TakeProfitRatioEqualFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldEqualFold(FieldTakeProfitRatio, vc))
+}
+
+// TakeProfitRatioContainsFold applies the ContainsFold predicate on the "takeProfitRatio" field.
+func TakeProfitRatioContainsFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContainsFold(FieldTakeProfitRatio, vc))
+}
+
+// TrailingTakeProfitEQ applies the EQ predicate on the "trailingTakeProfit" field.
+func TrailingTakeProfitEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldTrailingTakeProfit, v))
+}
+
+// TrailingTakeProfitNEQ applies the NEQ predicate on the "trailingTakeProfit" field.
+func TrailingTakeProfitNEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldTrailingTakeProfit, v))
+}
+
+// TrailingTakeProfitIn applies the In predicate on the "trailingTakeProfit" field.
+func TrailingTakeProfitIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldTrailingTakeProfit, vs...))
+}
+
+// TrailingTakeProfitNotIn applies the NotIn predicate on the "trailingTakeProfit" field.
+func TrailingTakeProfitNotIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldTrailingTakeProfit, vs...))
+}
+
+// TrailingTakeProfitGT applies the GT predicate on the "trailingTakeProfit" field.
+func TrailingTakeProfitGT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldTrailingTakeProfit, v))
+}
+
+// TrailingTakeProfitGTE applies the GTE predicate on the "trailingTakeProfit" field.
+func TrailingTakeProfitGTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldTrailingTakeProfit, v))
+}
+
+// TrailingTakeProfitLT applies the LT predicate on the "trailingTakeProfit" field.
+func TrailingTakeProfitLT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldTrailingTakeProfit, v))
+}
+
+// TrailingTakeProfitLTE applies the LTE predicate on the "trailingTakeProfit" field.
+func TrailingTakeProfitLTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldTrailingTakeProfit, v))
+}
+
+// TrailingTakeProfitContains applies the Contains predicate on the "trailingTakeProfit" field.
+func TrailingTakeProfitContains(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContains(FieldTrailingTakeProfit, vc))
+}
+
+// TrailingTakeProfitHasPrefix applies the HasPrefix predicate on the "trailingTakeProfit" field.
+func TrailingTakeProfitHasPrefix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasPrefix(FieldTrailingTakeProfit, vc))
+}
+
+// TrailingTakeProfitHasSuffix applies the HasSuffix predicate on the "trailingTakeProfit" field.
+func TrailingTakeProfitHasSuffix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasSuffix(FieldTrailingTakeProfit, vc))
+}
+
+// TrailingTakeProfitIsNil applies the IsNil predicate on the "trailingTakeProfit" field.
+func TrailingTakeProfitIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldTrailingTakeProfit))
+}
+
+// TrailingTakeProfitNotNil applies the NotNil predicate on the "trailingTakeProfit" field.
+func TrailingTakeProfitNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldTrailingTakeProfit))
+}
+
+// TrailingTakeProfitEqualFold applies the EqualFold predicate on the "trailingTakeProfit" field.
+func TrailingTakeProfitEqualFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldEqualFold(FieldTrailingTakeProfit, vc))
+}
+
+// TrailingTakeProfitContainsFold applies the ContainsFold predicate on the "trailingTakeProfit" field.
+func TrailingTakeProfitContainsFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContainsFold(FieldTrailingTakeProfit, vc))
+}
+
+// TakeProfitPortionEQ applies the EQ predicate on the "takeProfitPortion" field.
+func TakeProfitPortionEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldTakeProfitPortion, v))
+}
+
+// TakeProfitPortionNEQ applies the NEQ predicate on the "takeProfitPortion" field.
+func TakeProfitPortionNEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldTakeProfitPortion, v))
+}
+
+// TakeProfitPortionIn applies the In predicate on the "takeProfitPortion" field.
+func TakeProfitPortionIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldTakeProfitPortion, vs...))
+}
+
+// TakeProfitPortionNotIn applies the NotIn predicate on the "takeProfitPortion" field.
+func TakeProfitPortionNotIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldTakeProfitPortion, vs...))
+}
+
+// TakeProfitPortionGT applies the GT predicate on the "takeProfitPortion" field.
+func TakeProfitPortionGT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldTakeProfitPortion, v))
+}
+
+// TakeProfitPortionGTE applies the GTE predicate on the "takeProfitPortion" field.
+func TakeProfitPortionGTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldTakeProfitPortion, v))
+}
+
+// TakeProfitPortionLT applies the LT predicate on the "takeProfitPortion" field.
+func TakeProfitPortionLT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldTakeProfitPortion, v))
+}
+
+// TakeProfitPortionLTE applies the LTE predicate on the "takeProfitPortion" field.
+func TakeProfitPortionLTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldTakeProfitPortion, v))
+}
+
+// TakeProfitPortionContains applies the Contains predicate on the "takeProfitPortion" field.
+func TakeProfitPortionContains(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContains(FieldTakeProfitPortion, vc))
+}
+
+// TakeProfitPortionHasPrefix applies the HasPrefix predicate on the "takeProfitPortion" field.
+func TakeProfitPortionHasPrefix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasPrefix(FieldTakeProfitPortion, vc))
+}
+
+// TakeProfitPortionHasSuffix applies the HasSuffix predicate on the "takeProfitPortion" field.
+func TakeProfitPortionHasSuffix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasSuffix(FieldTakeProfitPortion, vc))
+}
+
+// TakeProfitPortionIsNil applies the IsNil predicate on the "takeProfitPortion" field.
+func TakeProfitPortionIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldTakeProfitPortion))
+}
+
+// TakeProfitPortionNotNil applies the NotNil predicate on the "takeProfitPortion" field.
+func TakeProfitPortionNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldTakeProfitPortion))
+}
+
+// TakeProfitPortionEqualFold applies the EqualFold predicate on the "takeProfitPortion" field.
+func TakeProfitPortionEqualFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldEqualFold(FieldTakeProfitPortion, vc))
+}
+
+// TakeProfitPortionContainsFold applies the ContainsFold predicate on the "takeProfitPortion" field.
+func TakeProfitPortionContainsFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContainsFold(FieldTakeProfitPortion, vc))
+}
+
+// AdaptiveSpacingEQ applies the EQ predicate on the "adaptiveSpacing" field.
+func AdaptiveSpacingEQ(v bool) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldAdaptiveSpacing, v))
+}
+
+// AdaptiveSpacingNEQ applies the NEQ predicate on the "adaptiveSpacing" field.
+func AdaptiveSpacingNEQ(v bool) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldAdaptiveSpacing, v))
+}
+
+// AdaptiveSpacingIsNil applies the IsNil predicate on the "adaptiveSpacing" field.
+func AdaptiveSpacingIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldAdaptiveSpacing))
+}
+
+// AdaptiveSpacingNotNil applies the NotNil predicate on the "adaptiveSpacing" field.
+func AdaptiveSpacingNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldAdaptiveSpacing))
+}
+
+// AdaptiveMinRatioEQ applies the EQ predicate on the "adaptiveMinRatio" field.
+func AdaptiveMinRatioEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldAdaptiveMinRatio, v))
+}
+
+// AdaptiveMinRatioNEQ applies the NEQ predicate on the "adaptiveMinRatio" field.
+func AdaptiveMinRatioNEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldAdaptiveMinRatio, v))
+}
+
+// AdaptiveMinRatioIn applies the In predicate on the "adaptiveMinRatio" field.
+func AdaptiveMinRatioIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldAdaptiveMinRatio, vs...))
+}
+
+// AdaptiveMinRatioNotIn applies the NotIn predicate on the "adaptiveMinRatio" field.
+func AdaptiveMinRatioNotIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldAdaptiveMinRatio, vs...))
+}
+
+// AdaptiveMinRatioGT applies the GT predicate on the "adaptiveMinRatio" field.
+func AdaptiveMinRatioGT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldAdaptiveMinRatio, v))
+}
+
+// AdaptiveMinRatioGTE applies the GTE predicate on the "adaptiveMinRatio" field.
+func AdaptiveMinRatioGTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldAdaptiveMinRatio, v))
+}
+
+// AdaptiveMinRatioLT applies the LT predicate on the "adaptiveMinRatio" field.
+func AdaptiveMinRatioLT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldAdaptiveMinRatio, v))
+}
+
+// AdaptiveMinRatioLTE applies the LTE predicate on the "adaptiveMinRatio" field.
+func AdaptiveMinRatioLTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldAdaptiveMinRatio, v))
+}
+
+// AdaptiveMinRatioContains applies the Contains predicate on the "adaptiveMinRatio" field.
+func AdaptiveMinRatioContains(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContains(FieldAdaptiveMinRatio, vc))
+}
+
+// AdaptiveMinRatioHasPrefix applies the HasPrefix predicate on the "adaptiveMinRatio" field.
+func AdaptiveMinRatioHasPrefix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasPrefix(FieldAdaptiveMinRatio, vc))
+}
+
+// AdaptiveMinRatioHasSuffix applies the HasSuffix predicate on the "adaptiveMinRatio" field.
+func AdaptiveMinRatioHasSuffix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasSuffix(FieldAdaptiveMinRatio, vc))
+}
+
+// AdaptiveMinRatioIsNil applies the IsNil predicate on the "adaptiveMinRatio" field.
+func AdaptiveMinRatioIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldAdaptiveMinRatio))
+}
+
+// AdaptiveMinRatioNotNil applies the NotNil predicate on the "adaptiveMinRatio" field.
+func AdaptiveMinRatioNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldAdaptiveMinRatio))
+}
+
+// AdaptiveMinRatioEqualFold applies the EqualFold predicate on the "adaptiveMinRatio" field.
+func AdaptiveMinRatioEqualFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldEqualFold(FieldAdaptiveMinRatio, vc))
+}
+
+// AdaptiveMinRatioContainsFold applies the ContainsFold predicate on the "adaptiveMinRatio" field.
+func AdaptiveMinRatioContainsFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContainsFold(FieldAdaptiveMinRatio, vc))
+}
+
+// AdaptiveMaxRatioEQ applies the EQ predicate on the "adaptiveMaxRatio" field.
+func AdaptiveMaxRatioEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldAdaptiveMaxRatio, v))
+}
+
+// AdaptiveMaxRatioNEQ applies the NEQ predicate on the "adaptiveMaxRatio" field.
+func AdaptiveMaxRatioNEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldAdaptiveMaxRatio, v))
+}
+
+// AdaptiveMaxRatioIn applies the In predicate on the "adaptiveMaxRatio" field.
+func AdaptiveMaxRatioIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldAdaptiveMaxRatio, vs...))
+}
+
+// AdaptiveMaxRatioNotIn applies the NotIn predicate on the "adaptiveMaxRatio" field.
+func AdaptiveMaxRatioNotIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldAdaptiveMaxRatio, vs...))
+}
+
+// AdaptiveMaxRatioGT applies the GT predicate on the "adaptiveMaxRatio" field.
+func AdaptiveMaxRatioGT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldAdaptiveMaxRatio, v))
+}
+
+// AdaptiveMaxRatioGTE applies the GTE predicate on the "adaptiveMaxRatio" field.
+func AdaptiveMaxRatioGTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldAdaptiveMaxRatio, v))
+}
+
+// AdaptiveMaxRatioLT applies the LT predicate on the "adaptiveMaxRatio" field.
+func AdaptiveMaxRatioLT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldAdaptiveMaxRatio, v))
+}
+
+// AdaptiveMaxRatioLTE applies the LTE predicate on the "adaptiveMaxRatio" field.
+func AdaptiveMaxRatioLTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldAdaptiveMaxRatio, v))
+}
+
+// AdaptiveMaxRatioContains applies the Contains predicate on the "adaptiveMaxRatio" field.
+func AdaptiveMaxRatioContains(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContains(FieldAdaptiveMaxRatio, vc))
+}
+
+// AdaptiveMaxRatioHasPrefix applies the HasPrefix predicate on the "adaptiveMaxRatio" field.
+func AdaptiveMaxRatioHasPrefix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasPrefix(FieldAdaptiveMaxRatio, vc))
+}
+
+// AdaptiveMaxRatioHasSuffix applies the HasSuffix predicate on the "adaptiveMaxRatio" field.
+func AdaptiveMaxRatioHasSuffix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasSuffix(FieldAdaptiveMaxRatio, vc))
+}
+
+// AdaptiveMaxRatioIsNil applies the IsNil predicate on the "adaptiveMaxRatio" field.
+func AdaptiveMaxRatioIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldAdaptiveMaxRatio))
+}
+
+// AdaptiveMaxRatioNotNil applies the NotNil predicate on the "adaptiveMaxRatio" field.
+func AdaptiveMaxRatioNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldAdaptiveMaxRatio))
+}
+
+// AdaptiveMaxRatioEqualFold applies the EqualFold predicate on the "adaptiveMaxRatio" field.
+func AdaptiveMaxRatioEqualFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldEqualFold(FieldAdaptiveMaxRatio, vc))
+}
+
+// AdaptiveMaxRatioContainsFold applies the ContainsFold predicate on the "adaptiveMaxRatio" field.
+func AdaptiveMaxRatioContainsFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContainsFold(FieldAdaptiveMaxRatio, vc))
 }
 
-// MartinFactorLT applies the LT predicate on the "martinFactor" field.
-func MartinFactorLT(v float64) predicate.Strategy {
-	return predicate.Strategy(sql.FieldLT(FieldMartinFactor, v))
+// AdaptiveVolatilityEQ applies the EQ predicate on the "adaptiveVolatility" field.
+func AdaptiveVolatilityEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldAdaptiveVolatility, v))
 }
 
-// MartinFactorLTE applies the LTE predicate on the "martinFactor" field.
-func MartinFactorLTE(v float64) predicate.Strategy {
-	return predicate.Strategy(sql.FieldLTE(FieldMartinFactor, v))
+// AdaptiveVolatilityNEQ applies the NEQ predicate on the "adaptiveVolatility" field.
+func AdaptiveVolatilityNEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldAdaptiveVolatility, v))
 }
 
-// MaxGridLimitEQ applies the EQ predicate on the "maxGridLimit" field.
-func MaxGridLimitEQ(v int) predicate.Strategy {
-	return predicate.Strategy(sql.FieldEQ(FieldMaxGridLimit, v))
+// AdaptiveVolatilityIn applies the In predicate on the "adaptiveVolatility" field.
+func AdaptiveVolatilityIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldAdaptiveVolatility, vs...))
 }
 
-// MaxGridLimitNEQ applies the NEQ predicate on the "maxGridLimit" field.
-func MaxGridLimitNEQ(v int) predicate.Strategy {
-	return predicate.Strategy(sql.FieldNEQ(FieldMaxGridLimit, v))
+// AdaptiveVolatilityNotIn applies the NotIn predicate on the "adaptiveVolatility" field.
+func AdaptiveVolatilityNotIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldAdaptiveVolatility, vs...))
 }
 
-// MaxGridLimitIn applies the In predicate on the "maxGridLimit" field.
-func MaxGridLimitIn(vs ...int) predicate.Strategy {
-	return predicate.Strategy(sql.FieldIn(FieldMaxGridLimit, vs...))
+// AdaptiveVolatilityGT applies the GT predicate on the "adaptiveVolatility" field.
+func AdaptiveVolatilityGT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldAdaptiveVolatility, v))
 }
 
-// MaxGridLimitNotIn applies the NotIn predicate on the "maxGridLimit" field.
-func MaxGridLimitNotIn(vs ...int) predicate.Strategy {
-	return predicate.Strategy(sql.FieldNotIn(FieldMaxGridLimit, vs...))
+// AdaptiveVolatilityGTE applies the GTE predicate on the "adaptiveVolatility" field.
+func AdaptiveVolatilityGTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldAdaptiveVolatility, v))
 }
 
-// MaxGridLimitGT applies the GT predicate on the "maxGridLimit" field.
-func MaxGridLimitGT(v int) predicate.Strategy {
-	return predicate.Strategy(sql.FieldGT(FieldMaxGridLimit, v))
+// AdaptiveVolatilityLT applies the LT predicate on the "adaptiveVolatility" field.
+func AdaptiveVolatilityLT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldAdaptiveVolatility, v))
 }
 
-// MaxGridLimitGTE applies the GTE predicate on the "maxGridLimit" field.
-func MaxGridLimitGTE(v int) predicate.Strategy {
-	return predicate.Strategy(sql.FieldGTE(FieldMaxGridLimit, v))
+// AdaptiveVolatilityLTE applies the LTE predicate on the "adaptiveVolatility" field.
+func AdaptiveVolatilityLTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldAdaptiveVolatility, v))
 }
 
-// MaxGridLimitLT applies the LT predicate on the "maxGridLimit" field.
-func MaxGridLimitLT(v int) predicate.Strategy {
-	return predicate.Strategy(sql.FieldLT(FieldMaxGridLimit, v))
+// AdaptiveVolatilityContains applies the Contains predicate on the "adaptiveVolatility" field.
+func AdaptiveVolatilityContains(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContains(FieldAdaptiveVolatility, vc))
 }
 
-// MaxGridLimitLTE applies the LTE predicate on the "maxGridLimit" field.
-func MaxGridLimitLTE(v int) predicate.Strategy {
-	return predicate.Strategy(sql.FieldLTE(FieldMaxGridLimit, v))
+// AdaptiveVolatilityHasPrefix applies the HasPrefix predicate on the "adaptiveVolatility" field.
+func AdaptiveVolatilityHasPrefix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasPrefix(FieldAdaptiveVolatility, vc))
 }
 
-// MaxGridLimitIsNil applies the IsNil predicate on the "maxGridLimit" field.
-func MaxGridLimitIsNil() predicate.Strategy {
-	return predicate.Strategy(sql.FieldIsNull(FieldMaxGridLimit))
+// AdaptiveVolatilityHasSuffix applies the HasSuffix predicate on the "adaptiveVolatility" field.
+func AdaptiveVolatilityHasSuffix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasSuffix(FieldAdaptiveVolatility, vc))
 }
 
-// MaxGridLimitNotNil applies the NotNil predicate on the "maxGridLimit" field.
-func MaxGridLimitNotNil() predicate.Strategy {
-	return predicate.Strategy(sql.FieldNotNull(FieldMaxGridLimit))
+// AdaptiveVolatilityIsNil applies the IsNil predicate on the "adaptiveVolatility" field.
+func AdaptiveVolatilityIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldAdaptiveVolatility))
 }
 
-// TakeProfitRatioEQ applies the EQ predicate on the "takeProfitRatio" field.
-func TakeProfitRatioEQ(v decimal.Decimal) predicate.Strategy {
-	return predicate.Strategy(sql.FieldEQ(FieldTakeProfitRatio, v))
+// AdaptiveVolatilityNotNil applies the NotNil predicate on the "adaptiveVolatility" field.
+func AdaptiveVolatilityNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldAdaptiveVolatility))
 }
 
-// TakeProfitRatioNEQ applies the NEQ predicate on the "takeProfitRatio" field.
-func TakeProfitRatioNEQ(v decimal.Decimal) predicate.Strategy {
-	return predicate.Strategy(sql.FieldNEQ(FieldTakeProfitRatio, v))
+// AdaptiveVolatilityEqualFold applies the EqualFold predicate on the "adaptiveVolatility" field.
+func AdaptiveVolatilityEqualFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldEqualFold(FieldAdaptiveVolatility, vc))
 }
 
-// TakeProfitRatioIn applies the In predicate on the "takeProfitRatio" field.
-func TakeProfitRatioIn(vs ...decimal.Decimal) predicate.Strategy {
-	return predicate.Strategy(sql.FieldIn(FieldTakeProfitRatio, vs...))
+// AdaptiveVolatilityContainsFold applies the ContainsFold predicate on the "adaptiveVolatility" field.
+func AdaptiveVolatilityContainsFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContainsFold(FieldAdaptiveVolatility, vc))
 }
 
-// TakeProfitRatioNotIn applies the NotIn predicate on the "takeProfitRatio" field.
-func TakeProfitRatioNotIn(vs ...decimal.Decimal) predicate.Strategy {
-	return predicate.Strategy(sql.FieldNotIn(FieldTakeProfitRatio, vs...))
+// AdaptiveUpdateTimeEQ applies the EQ predicate on the "adaptiveUpdateTime" field.
+func AdaptiveUpdateTimeEQ(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldAdaptiveUpdateTime, v))
 }
 
-// TakeProfitRatioGT applies the GT predicate on the "takeProfitRatio" field.
-func TakeProfitRatioGT(v decimal.Decimal) predicate.Strategy {
-	return predicate.Strategy(sql.FieldGT(FieldTakeProfitRatio, v))
+// AdaptiveUpdateTimeNEQ applies the NEQ predicate on the "adaptiveUpdateTime" field.
+func AdaptiveUpdateTimeNEQ(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldAdaptiveUpdateTime, v))
 }
 
-// TakeProfitRatioGTE applies the GTE predicate on the "takeProfitRatio" field.
-func TakeProfitRatioGTE(v decimal.Decimal) predicate.Strategy {
-	return predicate.Strategy(sql.FieldGTE(FieldTakeProfitRatio, v))
+// AdaptiveUpdateTimeIn applies the In predicate on the "adaptiveUpdateTime" field.
+func AdaptiveUpdateTimeIn(vs ...time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldAdaptiveUpdateTime, vs...))
 }
 
-// TakeProfitRatioLT applies the LT predicate on the "takeProfitRatio" field.
-func TakeProfitRatioLT(v decimal.Decimal) predicate.Strategy {
-	return predicate.Strategy(sql.FieldLT(FieldTakeProfitRatio, v))
+// AdaptiveUpdateTimeNotIn applies the NotIn predicate on the "adaptiveUpdateTime" field.
+func AdaptiveUpdateTimeNotIn(vs ...time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldAdaptiveUpdateTime, vs...))
 }
 
-// TakeProfitRatioLTE applies the LTE predicate on the "takeProfitRatio" field.
-func TakeProfitRatioLTE(v decimal.Decimal) predicate.Strategy {
-	return predicate.Strategy(sql.FieldLTE(FieldTakeProfitRatio, v))
+// AdaptiveUpdateTimeGT applies the GT predicate on the "adaptiveUpdateTime" field.
+func AdaptiveUpdateTimeGT(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldAdaptiveUpdateTime, v))
 }
 
-// TakeProfitRatioContains applies the Contains predicate on the "takeProfitRatio" field.
-func TakeProfitRatioContains(v decimal.Decimal) predicate.Strategy {
-	vc := v.String()
-	return predicate.Strategy(sql.FieldContains(FieldTakeProfitRatio, vc))
+// AdaptiveUpdateTimeGTE applies the GTE predicate on the "adaptiveUpdateTime" field.
+func AdaptiveUpdateTimeGTE(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldAdaptiveUpdateTime, v))
 }
 
-// TakeProfitRatioHasPrefix applies the HasPrefix predicate on the "takeProfitRatio" field.
-func TakeProfitRatioHasPrefix(v decimal.Decimal) predicate.Strategy {
-	vc := v.String()
-	return predicate.Strategy(sql.FieldHasPrefix(FieldTakeProfitRatio, vc))
+// AdaptiveUpdateTimeLT applies the LT predicate on the "adaptiveUpdateTime" field.
+func AdaptiveUpdateTimeLT(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldAdaptiveUpdateTime, v))
 }
 
-// TakeProfitRatioHasSuffix applies the HasSuffix predicate on the "takeProfitRatio" field.
-func TakeProfitRatioHasSuffix(v decimal.Decimal) predicate.Strategy {
-	vc := v.String()
-	return predicate.Strategy(sql.FieldHasSuffix(FieldTakeProfitRatio, vc))
+// AdaptiveUpdateTimeLTE applies the LTE predicate on the "adaptiveUpdateTime" field.
+func AdaptiveUpdateTimeLTE(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldAdaptiveUpdateTime, v))
 }
 
-// TakeProfitRatioEqualFold applies the EqualFold predicate on the "takeProfitRatio" field.
-func TakeProfitRatioEqualFold(v decimal.Decimal) predicate.Strategy {
-	vc := v.String()
-	return predicate.Strategy(sql.FieldEqualFold(FieldTakeProfitRatio, vc))
+// AdaptiveUpdateTimeIsNil applies the IsNil predicate on the "adaptiveUpdateTime" field.
+func AdaptiveUpdateTimeIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldAdaptiveUpdateTime))
 }
 
-// TakeProfitRatioContainsFold applies the ContainsFold predicate on the "takeProfitRatio" field.
-func TakeProfitRatioContainsFold(v decimal.Decimal) predicate.Strategy {
-	vc := v.String()
-	return predicate.Strategy(sql.FieldContainsFold(FieldTakeProfitRatio, vc))
+// AdaptiveUpdateTimeNotNil applies the NotNil predicate on the "adaptiveUpdateTime" field.
+func AdaptiveUpdateTimeNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldAdaptiveUpdateTime))
 }
 
 // UpperPriceBoundEQ applies the EQ predicate on the "upperPriceBound" field.
@@ -1441,6 +2276,96 @@ func DynamicStopLossNotNil() predicate.Strategy {
 	return predicate.Strategy(sql.FieldNotNull(FieldDynamicStopLoss))
 }
 
+// TrailingUpEQ applies the EQ predicate on the "trailingUp" field.
+func TrailingUpEQ(v bool) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldTrailingUp, v))
+}
+
+// TrailingUpNEQ applies the NEQ predicate on the "trailingUp" field.
+func TrailingUpNEQ(v bool) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldTrailingUp, v))
+}
+
+// TrailingUpIsNil applies the IsNil predicate on the "trailingUp" field.
+func TrailingUpIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldTrailingUp))
+}
+
+// TrailingUpNotNil applies the NotNil predicate on the "trailingUp" field.
+func TrailingUpNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldTrailingUp))
+}
+
+// TrailingDownEQ applies the EQ predicate on the "trailingDown" field.
+func TrailingDownEQ(v bool) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldTrailingDown, v))
+}
+
+// TrailingDownNEQ applies the NEQ predicate on the "trailingDown" field.
+func TrailingDownNEQ(v bool) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldTrailingDown, v))
+}
+
+// TrailingDownIsNil applies the IsNil predicate on the "trailingDown" field.
+func TrailingDownIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldTrailingDown))
+}
+
+// TrailingDownNotNil applies the NotNil predicate on the "trailingDown" field.
+func TrailingDownNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldTrailingDown))
+}
+
+// TrailingDwellMinutesEQ applies the EQ predicate on the "trailingDwellMinutes" field.
+func TrailingDwellMinutesEQ(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldTrailingDwellMinutes, v))
+}
+
+// TrailingDwellMinutesNEQ applies the NEQ predicate on the "trailingDwellMinutes" field.
+func TrailingDwellMinutesNEQ(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldTrailingDwellMinutes, v))
+}
+
+// TrailingDwellMinutesIn applies the In predicate on the "trailingDwellMinutes" field.
+func TrailingDwellMinutesIn(vs ...int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldTrailingDwellMinutes, vs...))
+}
+
+// TrailingDwellMinutesNotIn applies the NotIn predicate on the "trailingDwellMinutes" field.
+func TrailingDwellMinutesNotIn(vs ...int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldTrailingDwellMinutes, vs...))
+}
+
+// TrailingDwellMinutesGT applies the GT predicate on the "trailingDwellMinutes" field.
+func TrailingDwellMinutesGT(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldTrailingDwellMinutes, v))
+}
+
+// TrailingDwellMinutesGTE applies the GTE predicate on the "trailingDwellMinutes" field.
+func TrailingDwellMinutesGTE(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldTrailingDwellMinutes, v))
+}
+
+// TrailingDwellMinutesLT applies the LT predicate on the "trailingDwellMinutes" field.
+func TrailingDwellMinutesLT(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldTrailingDwellMinutes, v))
+}
+
+// TrailingDwellMinutesLTE applies the LTE predicate on the "trailingDwellMinutes" field.
+func TrailingDwellMinutesLTE(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldTrailingDwellMinutes, v))
+}
+
+// TrailingDwellMinutesIsNil applies the IsNil predicate on the "trailingDwellMinutes" field.
+func TrailingDwellMinutesIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldTrailingDwellMinutes))
+}
+
+// TrailingDwellMinutesNotNil applies the NotNil predicate on the "trailingDwellMinutes" field.
+func TrailingDwellMinutesNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldTrailingDwellMinutes))
+}
+
 // DropOnEQ applies the EQ predicate on the "dropOn" field.
 func DropOnEQ(v bool) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldDropOn, v))
@@ -1591,6 +2516,176 @@ func DropThresholdContainsFold(v decimal.Decimal) predicate.Strategy {
 	return predicate.Strategy(sql.FieldContainsFold(FieldDropThreshold, vc))
 }
 
+// RsiThresholdEQ applies the EQ predicate on the "rsiThreshold" field.
+func RsiThresholdEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldRsiThreshold, v))
+}
+
+// RsiThresholdNEQ applies the NEQ predicate on the "rsiThreshold" field.
+func RsiThresholdNEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldRsiThreshold, v))
+}
+
+// RsiThresholdIn applies the In predicate on the "rsiThreshold" field.
+func RsiThresholdIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldRsiThreshold, vs...))
+}
+
+// RsiThresholdNotIn applies the NotIn predicate on the "rsiThreshold" field.
+func RsiThresholdNotIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldRsiThreshold, vs...))
+}
+
+// RsiThresholdGT applies the GT predicate on the "rsiThreshold" field.
+func RsiThresholdGT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldRsiThreshold, v))
+}
+
+// RsiThresholdGTE applies the GTE predicate on the "rsiThreshold" field.
+func RsiThresholdGTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldRsiThreshold, v))
+}
+
+// RsiThresholdLT applies the LT predicate on the "rsiThreshold" field.
+func RsiThresholdLT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldRsiThreshold, v))
+}
+
+// RsiThresholdLTE applies the LTE predicate on the "rsiThreshold" field.
+func RsiThresholdLTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldRsiThreshold, v))
+}
+
+// RsiThresholdContains applies the Contains predicate on the "rsiThreshold" field.
+func RsiThresholdContains(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContains(FieldRsiThreshold, vc))
+}
+
+// RsiThresholdHasPrefix applies the HasPrefix predicate on the "rsiThreshold" field.
+func RsiThresholdHasPrefix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasPrefix(FieldRsiThreshold, vc))
+}
+
+// RsiThresholdHasSuffix applies the HasSuffix predicate on the "rsiThreshold" field.
+func RsiThresholdHasSuffix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasSuffix(FieldRsiThreshold, vc))
+}
+
+// RsiThresholdIsNil applies the IsNil predicate on the "rsiThreshold" field.
+func RsiThresholdIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldRsiThreshold))
+}
+
+// RsiThresholdNotNil applies the NotNil predicate on the "rsiThreshold" field.
+func RsiThresholdNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldRsiThreshold))
+}
+
+// RsiThresholdEqualFold applies the EqualFold predicate on the "rsiThreshold" field.
+func RsiThresholdEqualFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldEqualFold(FieldRsiThreshold, vc))
+}
+
+// RsiThresholdContainsFold applies the ContainsFold predicate on the "rsiThreshold" field.
+func RsiThresholdContainsFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContainsFold(FieldRsiThreshold, vc))
+}
+
+// EmaFilterEQ applies the EQ predicate on the "emaFilter" field.
+func EmaFilterEQ(v EmaFilter) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldEmaFilter, v))
+}
+
+// EmaFilterNEQ applies the NEQ predicate on the "emaFilter" field.
+func EmaFilterNEQ(v EmaFilter) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldEmaFilter, v))
+}
+
+// EmaFilterIn applies the In predicate on the "emaFilter" field.
+func EmaFilterIn(vs ...EmaFilter) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldEmaFilter, vs...))
+}
+
+// EmaFilterNotIn applies the NotIn predicate on the "emaFilter" field.
+func EmaFilterNotIn(vs ...EmaFilter) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldEmaFilter, vs...))
+}
+
+// EmaPeriodEQ applies the EQ predicate on the "emaPeriod" field.
+func EmaPeriodEQ(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldEmaPeriod, v))
+}
+
+// EmaPeriodNEQ applies the NEQ predicate on the "emaPeriod" field.
+func EmaPeriodNEQ(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldEmaPeriod, v))
+}
+
+// EmaPeriodIn applies the In predicate on the "emaPeriod" field.
+func EmaPeriodIn(vs ...int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldEmaPeriod, vs...))
+}
+
+// EmaPeriodNotIn applies the NotIn predicate on the "emaPeriod" field.
+func EmaPeriodNotIn(vs ...int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldEmaPeriod, vs...))
+}
+
+// EmaPeriodGT applies the GT predicate on the "emaPeriod" field.
+func EmaPeriodGT(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldEmaPeriod, v))
+}
+
+// EmaPeriodGTE applies the GTE predicate on the "emaPeriod" field.
+func EmaPeriodGTE(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldEmaPeriod, v))
+}
+
+// EmaPeriodLT applies the LT predicate on the "emaPeriod" field.
+func EmaPeriodLT(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldEmaPeriod, v))
+}
+
+// EmaPeriodLTE applies the LTE predicate on the "emaPeriod" field.
+func EmaPeriodLTE(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldEmaPeriod, v))
+}
+
+// EmaPeriodIsNil applies the IsNil predicate on the "emaPeriod" field.
+func EmaPeriodIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldEmaPeriod))
+}
+
+// EmaPeriodNotNil applies the NotNil predicate on the "emaPeriod" field.
+func EmaPeriodNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldEmaPeriod))
+}
+
+// MacdFilterEQ applies the EQ predicate on the "macdFilter" field.
+func MacdFilterEQ(v bool) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldMacdFilter, v))
+}
+
+// MacdFilterNEQ applies the NEQ predicate on the "macdFilter" field.
+func MacdFilterNEQ(v bool) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldMacdFilter, v))
+}
+
+// MacdFilterIsNil applies the IsNil predicate on the "macdFilter" field.
+func MacdFilterIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldMacdFilter))
+}
+
+// MacdFilterNotNil applies the NotNil predicate on the "macdFilter" field.
+func MacdFilterNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldMacdFilter))
+}
+
 // EnableAutoBuyEQ applies the EQ predicate on the "enableAutoBuy" field.
 func EnableAutoBuyEQ(v bool) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldEnableAutoBuy, v))
@@ -1631,6 +2726,26 @@ func EnablePushNotificationNEQ(v bool) predicate.Strategy {
 	return predicate.Strategy(sql.FieldNEQ(FieldEnablePushNotification, v))
 }
 
+// PaperTradingEQ applies the EQ predicate on the "paperTrading" field.
+func PaperTradingEQ(v bool) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldPaperTrading, v))
+}
+
+// PaperTradingNEQ applies the NEQ predicate on the "paperTrading" field.
+func PaperTradingNEQ(v bool) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldPaperTrading, v))
+}
+
+// PaperTradingIsNil applies the IsNil predicate on the "paperTrading" field.
+func PaperTradingIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldPaperTrading))
+}
+
+// PaperTradingNotNil applies the NotNil predicate on the "paperTrading" field.
+func PaperTradingNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldPaperTrading))
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
 func StatusEQ(v Status) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldStatus, v))
@@ -1726,6 +2841,81 @@ func GridTrendContainsFold(v string) predicate.Strategy {
 	return predicate.Strategy(sql.FieldContainsFold(FieldGridTrend, v))
 }
 
+// BuyFilterReasonEQ applies the EQ predicate on the "buyFilterReason" field.
+func BuyFilterReasonEQ(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldBuyFilterReason, v))
+}
+
+// BuyFilterReasonNEQ applies the NEQ predicate on the "buyFilterReason" field.
+func BuyFilterReasonNEQ(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldBuyFilterReason, v))
+}
+
+// BuyFilterReasonIn applies the In predicate on the "buyFilterReason" field.
+func BuyFilterReasonIn(vs ...string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldBuyFilterReason, vs...))
+}
+
+// BuyFilterReasonNotIn applies the NotIn predicate on the "buyFilterReason" field.
+func BuyFilterReasonNotIn(vs ...string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldBuyFilterReason, vs...))
+}
+
+// BuyFilterReasonGT applies the GT predicate on the "buyFilterReason" field.
+func BuyFilterReasonGT(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldBuyFilterReason, v))
+}
+
+// BuyFilterReasonGTE applies the GTE predicate on the "buyFilterReason" field.
+func BuyFilterReasonGTE(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldBuyFilterReason, v))
+}
+
+// BuyFilterReasonLT applies the LT predicate on the "buyFilterReason" field.
+func BuyFilterReasonLT(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldBuyFilterReason, v))
+}
+
+// BuyFilterReasonLTE applies the LTE predicate on the "buyFilterReason" field.
+func BuyFilterReasonLTE(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldBuyFilterReason, v))
+}
+
+// BuyFilterReasonContains applies the Contains predicate on the "buyFilterReason" field.
+func BuyFilterReasonContains(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldContains(FieldBuyFilterReason, v))
+}
+
+// BuyFilterReasonHasPrefix applies the HasPrefix predicate on the "buyFilterReason" field.
+func BuyFilterReasonHasPrefix(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldHasPrefix(FieldBuyFilterReason, v))
+}
+
+// BuyFilterReasonHasSuffix applies the HasSuffix predicate on the "buyFilterReason" field.
+func BuyFilterReasonHasSuffix(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldHasSuffix(FieldBuyFilterReason, v))
+}
+
+// BuyFilterReasonIsNil applies the IsNil predicate on the "buyFilterReason" field.
+func BuyFilterReasonIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldBuyFilterReason))
+}
+
+// BuyFilterReasonNotNil applies the NotNil predicate on the "buyFilterReason" field.
+func BuyFilterReasonNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldBuyFilterReason))
+}
+
+// BuyFilterReasonEqualFold applies the EqualFold predicate on the "buyFilterReason" field.
+func BuyFilterReasonEqualFold(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEqualFold(FieldBuyFilterReason, v))
+}
+
+// BuyFilterReasonContainsFold applies the ContainsFold predicate on the "buyFilterReason" field.
+func BuyFilterReasonContainsFold(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldContainsFold(FieldBuyFilterReason, v))
+}
+
 // LastLowerThresholdAlertTimeEQ applies the EQ predicate on the "lastLowerThresholdAlertTime" field.
 func LastLowerThresholdAlertTimeEQ(v time.Time) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldLastLowerThresholdAlertTime, v))
@@ -1826,6 +3016,56 @@ func LastUpperThresholdAlertTimeNotNil() predicate.Strategy {
 	return predicate.Strategy(sql.FieldNotNull(FieldLastUpperThresholdAlertTime))
 }
 
+// OutOfRangeTimeEQ applies the EQ predicate on the "outOfRangeTime" field.
+func OutOfRangeTimeEQ(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldOutOfRangeTime, v))
+}
+
+// OutOfRangeTimeNEQ applies the NEQ predicate on the "outOfRangeTime" field.
+func OutOfRangeTimeNEQ(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldOutOfRangeTime, v))
+}
+
+// OutOfRangeTimeIn applies the In predicate on the "outOfRangeTime" field.
+func OutOfRangeTimeIn(vs ...time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldOutOfRangeTime, vs...))
+}
+
+// OutOfRangeTimeNotIn applies the NotIn predicate on the "outOfRangeTime" field.
+func OutOfRangeTimeNotIn(vs ...time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldOutOfRangeTime, vs...))
+}
+
+// OutOfRangeTimeGT applies the GT predicate on the "outOfRangeTime" field.
+func OutOfRangeTimeGT(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldOutOfRangeTime, v))
+}
+
+// OutOfRangeTimeGTE applies the GTE predicate on the "outOfRangeTime" field.
+func OutOfRangeTimeGTE(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldOutOfRangeTime, v))
+}
+
+// OutOfRangeTimeLT applies the LT predicate on the "outOfRangeTime" field.
+func OutOfRangeTimeLT(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldOutOfRangeTime, v))
+}
+
+// OutOfRangeTimeLTE applies the LTE predicate on the "outOfRangeTime" field.
+func OutOfRangeTimeLTE(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldOutOfRangeTime, v))
+}
+
+// OutOfRangeTimeIsNil applies the IsNil predicate on the "outOfRangeTime" field.
+func OutOfRangeTimeIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldOutOfRangeTime))
+}
+
+// OutOfRangeTimeNotNil applies the NotNil predicate on the "outOfRangeTime" field.
+func OutOfRangeTimeNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldOutOfRangeTime))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Strategy) predicate.Strategy {
 	return predicate.Strategy(sql.AndPredicates(predicates...))
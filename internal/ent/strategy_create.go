@@ -94,12 +94,194 @@ func (_c *StrategyCreate) SetNillableMaxGridLimit(v *int) *StrategyCreate {
 	return _c
 }
 
+// SetGridMode sets the "gridMode" field.
+func (_c *StrategyCreate) SetGridMode(v strategy.GridMode) *StrategyCreate {
+	_c.mutation.SetGridMode(v)
+	return _c
+}
+
+// SetNillableGridMode sets the "gridMode" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableGridMode(v *strategy.GridMode) *StrategyCreate {
+	if v != nil {
+		_c.SetGridMode(*v)
+	}
+	return _c
+}
+
+// SetInventorySeeded sets the "inventorySeeded" field.
+func (_c *StrategyCreate) SetInventorySeeded(v bool) *StrategyCreate {
+	_c.mutation.SetInventorySeeded(v)
+	return _c
+}
+
+// SetNillableInventorySeeded sets the "inventorySeeded" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableInventorySeeded(v *bool) *StrategyCreate {
+	if v != nil {
+		_c.SetInventorySeeded(*v)
+	}
+	return _c
+}
+
+// SetGridType sets the "gridType" field.
+func (_c *StrategyCreate) SetGridType(v strategy.GridType) *StrategyCreate {
+	_c.mutation.SetGridType(v)
+	return _c
+}
+
+// SetNillableGridType sets the "gridType" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableGridType(v *strategy.GridType) *StrategyCreate {
+	if v != nil {
+		_c.SetGridType(*v)
+	}
+	return _c
+}
+
+// SetGridCount sets the "gridCount" field.
+func (_c *StrategyCreate) SetGridCount(v int) *StrategyCreate {
+	_c.mutation.SetGridCount(v)
+	return _c
+}
+
+// SetNillableGridCount sets the "gridCount" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableGridCount(v *int) *StrategyCreate {
+	if v != nil {
+		_c.SetGridCount(*v)
+	}
+	return _c
+}
+
+// SetPriceStep sets the "priceStep" field.
+func (_c *StrategyCreate) SetPriceStep(v decimal.Decimal) *StrategyCreate {
+	_c.mutation.SetPriceStep(v)
+	return _c
+}
+
+// SetNillablePriceStep sets the "priceStep" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillablePriceStep(v *decimal.Decimal) *StrategyCreate {
+	if v != nil {
+		_c.SetPriceStep(*v)
+	}
+	return _c
+}
+
+// SetGridLadder sets the "gridLadder" field.
+func (_c *StrategyCreate) SetGridLadder(v string) *StrategyCreate {
+	_c.mutation.SetGridLadder(v)
+	return _c
+}
+
+// SetNillableGridLadder sets the "gridLadder" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableGridLadder(v *string) *StrategyCreate {
+	if v != nil {
+		_c.SetGridLadder(*v)
+	}
+	return _c
+}
+
 // SetTakeProfitRatio sets the "takeProfitRatio" field.
 func (_c *StrategyCreate) SetTakeProfitRatio(v decimal.Decimal) *StrategyCreate {
 	_c.mutation.SetTakeProfitRatio(v)
 	return _c
 }
 
+// SetTrailingTakeProfit sets the "trailingTakeProfit" field.
+func (_c *StrategyCreate) SetTrailingTakeProfit(v decimal.Decimal) *StrategyCreate {
+	_c.mutation.SetTrailingTakeProfit(v)
+	return _c
+}
+
+// SetNillableTrailingTakeProfit sets the "trailingTakeProfit" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableTrailingTakeProfit(v *decimal.Decimal) *StrategyCreate {
+	if v != nil {
+		_c.SetTrailingTakeProfit(*v)
+	}
+	return _c
+}
+
+// SetTakeProfitPortion sets the "takeProfitPortion" field.
+func (_c *StrategyCreate) SetTakeProfitPortion(v decimal.Decimal) *StrategyCreate {
+	_c.mutation.SetTakeProfitPortion(v)
+	return _c
+}
+
+// SetNillableTakeProfitPortion sets the "takeProfitPortion" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableTakeProfitPortion(v *decimal.Decimal) *StrategyCreate {
+	if v != nil {
+		_c.SetTakeProfitPortion(*v)
+	}
+	return _c
+}
+
+// SetAdaptiveSpacing sets the "adaptiveSpacing" field.
+func (_c *StrategyCreate) SetAdaptiveSpacing(v bool) *StrategyCreate {
+	_c.mutation.SetAdaptiveSpacing(v)
+	return _c
+}
+
+// SetNillableAdaptiveSpacing sets the "adaptiveSpacing" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableAdaptiveSpacing(v *bool) *StrategyCreate {
+	if v != nil {
+		_c.SetAdaptiveSpacing(*v)
+	}
+	return _c
+}
+
+// SetAdaptiveMinRatio sets the "adaptiveMinRatio" field.
+func (_c *StrategyCreate) SetAdaptiveMinRatio(v decimal.Decimal) *StrategyCreate {
+	_c.mutation.SetAdaptiveMinRatio(v)
+	return _c
+}
+
+// SetNillableAdaptiveMinRatio sets the "adaptiveMinRatio" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableAdaptiveMinRatio(v *decimal.Decimal) *StrategyCreate {
+	if v != nil {
+		_c.SetAdaptiveMinRatio(*v)
+	}
+	return _c
+}
+
+// SetAdaptiveMaxRatio sets the "adaptiveMaxRatio" field.
+func (_c *StrategyCreate) SetAdaptiveMaxRatio(v decimal.Decimal) *StrategyCreate {
+	_c.mutation.SetAdaptiveMaxRatio(v)
+	return _c
+}
+
+// SetNillableAdaptiveMaxRatio sets the "adaptiveMaxRatio" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableAdaptiveMaxRatio(v *decimal.Decimal) *StrategyCreate {
+	if v != nil {
+		_c.SetAdaptiveMaxRatio(*v)
+	}
+	return _c
+}
+
+// SetAdaptiveVolatility sets the "adaptiveVolatility" field.
+func (_c *StrategyCreate) SetAdaptiveVolatility(v decimal.Decimal) *StrategyCreate {
+	_c.mutation.SetAdaptiveVolatility(v)
+	return _c
+}
+
+// SetNillableAdaptiveVolatility sets the "adaptiveVolatility" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableAdaptiveVolatility(v *decimal.Decimal) *StrategyCreate {
+	if v != nil {
+		_c.SetAdaptiveVolatility(*v)
+	}
+	return _c
+}
+
+// SetAdaptiveUpdateTime sets the "adaptiveUpdateTime" field.
+func (_c *StrategyCreate) SetAdaptiveUpdateTime(v time.Time) *StrategyCreate {
+	_c.mutation.SetAdaptiveUpdateTime(v)
+	return _c
+}
+
+// SetNillableAdaptiveUpdateTime sets the "adaptiveUpdateTime" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableAdaptiveUpdateTime(v *time.Time) *StrategyCreate {
+	if v != nil {
+		_c.SetAdaptiveUpdateTime(*v)
+	}
+	return _c
+}
+
 // SetUpperPriceBound sets the "upperPriceBound" field.
 func (_c *StrategyCreate) SetUpperPriceBound(v decimal.Decimal) *StrategyCreate {
 	_c.mutation.SetUpperPriceBound(v)
@@ -230,6 +412,48 @@ func (_c *StrategyCreate) SetNillableDynamicStopLoss(v *bool) *StrategyCreate {
 	return _c
 }
 
+// SetTrailingUp sets the "trailingUp" field.
+func (_c *StrategyCreate) SetTrailingUp(v bool) *StrategyCreate {
+	_c.mutation.SetTrailingUp(v)
+	return _c
+}
+
+// SetNillableTrailingUp sets the "trailingUp" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableTrailingUp(v *bool) *StrategyCreate {
+	if v != nil {
+		_c.SetTrailingUp(*v)
+	}
+	return _c
+}
+
+// SetTrailingDown sets the "trailingDown" field.
+func (_c *StrategyCreate) SetTrailingDown(v bool) *StrategyCreate {
+	_c.mutation.SetTrailingDown(v)
+	return _c
+}
+
+// SetNillableTrailingDown sets the "trailingDown" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableTrailingDown(v *bool) *StrategyCreate {
+	if v != nil {
+		_c.SetTrailingDown(*v)
+	}
+	return _c
+}
+
+// SetTrailingDwellMinutes sets the "trailingDwellMinutes" field.
+func (_c *StrategyCreate) SetTrailingDwellMinutes(v int) *StrategyCreate {
+	_c.mutation.SetTrailingDwellMinutes(v)
+	return _c
+}
+
+// SetNillableTrailingDwellMinutes sets the "trailingDwellMinutes" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableTrailingDwellMinutes(v *int) *StrategyCreate {
+	if v != nil {
+		_c.SetTrailingDwellMinutes(*v)
+	}
+	return _c
+}
+
 // SetDropOn sets the "dropOn" field.
 func (_c *StrategyCreate) SetDropOn(v bool) *StrategyCreate {
 	_c.mutation.SetDropOn(v)
@@ -272,6 +496,62 @@ func (_c *StrategyCreate) SetNillableDropThreshold(v *decimal.Decimal) *Strategy
 	return _c
 }
 
+// SetRsiThreshold sets the "rsiThreshold" field.
+func (_c *StrategyCreate) SetRsiThreshold(v decimal.Decimal) *StrategyCreate {
+	_c.mutation.SetRsiThreshold(v)
+	return _c
+}
+
+// SetNillableRsiThreshold sets the "rsiThreshold" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableRsiThreshold(v *decimal.Decimal) *StrategyCreate {
+	if v != nil {
+		_c.SetRsiThreshold(*v)
+	}
+	return _c
+}
+
+// SetEmaFilter sets the "emaFilter" field.
+func (_c *StrategyCreate) SetEmaFilter(v strategy.EmaFilter) *StrategyCreate {
+	_c.mutation.SetEmaFilter(v)
+	return _c
+}
+
+// SetNillableEmaFilter sets the "emaFilter" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableEmaFilter(v *strategy.EmaFilter) *StrategyCreate {
+	if v != nil {
+		_c.SetEmaFilter(*v)
+	}
+	return _c
+}
+
+// SetEmaPeriod sets the "emaPeriod" field.
+func (_c *StrategyCreate) SetEmaPeriod(v int) *StrategyCreate {
+	_c.mutation.SetEmaPeriod(v)
+	return _c
+}
+
+// SetNillableEmaPeriod sets the "emaPeriod" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableEmaPeriod(v *int) *StrategyCreate {
+	if v != nil {
+		_c.SetEmaPeriod(*v)
+	}
+	return _c
+}
+
+// SetMacdFilter sets the "macdFilter" field.
+func (_c *StrategyCreate) SetMacdFilter(v bool) *StrategyCreate {
+	_c.mutation.SetMacdFilter(v)
+	return _c
+}
+
+// SetNillableMacdFilter sets the "macdFilter" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableMacdFilter(v *bool) *StrategyCreate {
+	if v != nil {
+		_c.SetMacdFilter(*v)
+	}
+	return _c
+}
+
 // SetEnableAutoBuy sets the "enableAutoBuy" field.
 func (_c *StrategyCreate) SetEnableAutoBuy(v bool) *StrategyCreate {
 	_c.mutation.SetEnableAutoBuy(v)
@@ -296,6 +576,20 @@ func (_c *StrategyCreate) SetEnablePushNotification(v bool) *StrategyCreate {
 	return _c
 }
 
+// SetPaperTrading sets the "paperTrading" field.
+func (_c *StrategyCreate) SetPaperTrading(v bool) *StrategyCreate {
+	_c.mutation.SetPaperTrading(v)
+	return _c
+}
+
+// SetNillablePaperTrading sets the "paperTrading" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillablePaperTrading(v *bool) *StrategyCreate {
+	if v != nil {
+		_c.SetPaperTrading(*v)
+	}
+	return _c
+}
+
 // SetStatus sets the "status" field.
 func (_c *StrategyCreate) SetStatus(v strategy.Status) *StrategyCreate {
 	_c.mutation.SetStatus(v)
@@ -316,6 +610,20 @@ func (_c *StrategyCreate) SetNillableGridTrend(v *string) *StrategyCreate {
 	return _c
 }
 
+// SetBuyFilterReason sets the "buyFilterReason" field.
+func (_c *StrategyCreate) SetBuyFilterReason(v string) *StrategyCreate {
+	_c.mutation.SetBuyFilterReason(v)
+	return _c
+}
+
+// SetNillableBuyFilterReason sets the "buyFilterReason" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableBuyFilterReason(v *string) *StrategyCreate {
+	if v != nil {
+		_c.SetBuyFilterReason(*v)
+	}
+	return _c
+}
+
 // SetLastLowerThresholdAlertTime sets the "lastLowerThresholdAlertTime" field.
 func (_c *StrategyCreate) SetLastLowerThresholdAlertTime(v time.Time) *StrategyCreate {
 	_c.mutation.SetLastLowerThresholdAlertTime(v)
@@ -344,6 +652,20 @@ func (_c *StrategyCreate) SetNillableLastUpperThresholdAlertTime(v *time.Time) *
 	return _c
 }
 
+// SetOutOfRangeTime sets the "outOfRangeTime" field.
+func (_c *StrategyCreate) SetOutOfRangeTime(v time.Time) *StrategyCreate {
+	_c.mutation.SetOutOfRangeTime(v)
+	return _c
+}
+
+// SetNillableOutOfRangeTime sets the "outOfRangeTime" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableOutOfRangeTime(v *time.Time) *StrategyCreate {
+	if v != nil {
+		_c.SetOutOfRangeTime(*v)
+	}
+	return _c
+}
+
 // Mutation returns the StrategyMutation object of the builder.
 func (_c *StrategyCreate) Mutation() *StrategyMutation {
 	return _c.mutation
@@ -387,10 +709,30 @@ func (_c *StrategyCreate) defaults() {
 		v := strategy.DefaultUpdateTime()
 		_c.mutation.SetUpdateTime(v)
 	}
+	if _, ok := _c.mutation.GridMode(); !ok {
+		v := strategy.DefaultGridMode
+		_c.mutation.SetGridMode(v)
+	}
+	if _, ok := _c.mutation.GridType(); !ok {
+		v := strategy.DefaultGridType
+		_c.mutation.SetGridType(v)
+	}
+	if _, ok := _c.mutation.TrailingDwellMinutes(); !ok {
+		v := strategy.DefaultTrailingDwellMinutes
+		_c.mutation.SetTrailingDwellMinutes(v)
+	}
 	if _, ok := _c.mutation.CandlesToCheck(); !ok {
 		v := strategy.DefaultCandlesToCheck
 		_c.mutation.SetCandlesToCheck(v)
 	}
+	if _, ok := _c.mutation.EmaFilter(); !ok {
+		v := strategy.DefaultEmaFilter
+		_c.mutation.SetEmaFilter(v)
+	}
+	if _, ok := _c.mutation.EmaPeriod(); !ok {
+		v := strategy.DefaultEmaPeriod
+		_c.mutation.SetEmaPeriod(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -441,6 +783,27 @@ func (_c *StrategyCreate) check() error {
 			return &ValidationError{Name: "maxGridLimit", err: fmt.Errorf(`ent: validator failed for field "Strategy.maxGridLimit": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.GridMode(); !ok {
+		return &ValidationError{Name: "gridMode", err: errors.New(`ent: missing required field "Strategy.gridMode"`)}
+	}
+	if v, ok := _c.mutation.GridMode(); ok {
+		if err := strategy.GridModeValidator(v); err != nil {
+			return &ValidationError{Name: "gridMode", err: fmt.Errorf(`ent: validator failed for field "Strategy.gridMode": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GridType(); !ok {
+		return &ValidationError{Name: "gridType", err: errors.New(`ent: missing required field "Strategy.gridType"`)}
+	}
+	if v, ok := _c.mutation.GridType(); ok {
+		if err := strategy.GridTypeValidator(v); err != nil {
+			return &ValidationError{Name: "gridType", err: fmt.Errorf(`ent: validator failed for field "Strategy.gridType": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.GridCount(); ok {
+		if err := strategy.GridCountValidator(v); err != nil {
+			return &ValidationError{Name: "gridCount", err: fmt.Errorf(`ent: validator failed for field "Strategy.gridCount": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.TakeProfitRatio(); !ok {
 		return &ValidationError{Name: "takeProfitRatio", err: errors.New(`ent: missing required field "Strategy.takeProfitRatio"`)}
 	}
@@ -453,6 +816,14 @@ func (_c *StrategyCreate) check() error {
 	if _, ok := _c.mutation.InitialOrderSize(); !ok {
 		return &ValidationError{Name: "initialOrderSize", err: errors.New(`ent: missing required field "Strategy.initialOrderSize"`)}
 	}
+	if _, ok := _c.mutation.EmaFilter(); !ok {
+		return &ValidationError{Name: "emaFilter", err: errors.New(`ent: missing required field "Strategy.emaFilter"`)}
+	}
+	if v, ok := _c.mutation.EmaFilter(); ok {
+		if err := strategy.EmaFilterValidator(v); err != nil {
+			return &ValidationError{Name: "emaFilter", err: fmt.Errorf(`ent: validator failed for field "Strategy.emaFilter": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.EnableAutoBuy(); !ok {
 		return &ValidationError{Name: "enableAutoBuy", err: errors.New(`ent: missing required field "Strategy.enableAutoBuy"`)}
 	}
@@ -473,6 +844,11 @@ func (_c *StrategyCreate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Strategy.status": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.BuyFilterReason(); ok {
+		if err := strategy.BuyFilterReasonValidator(v); err != nil {
+			return &ValidationError{Name: "buyFilterReason", err: fmt.Errorf(`ent: validator failed for field "Strategy.buyFilterReason": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -531,10 +907,62 @@ func (_c *StrategyCreate) createSpec() (*Strategy, *sqlgraph.CreateSpec) {
 		_spec.SetField(strategy.FieldMaxGridLimit, field.TypeInt, value)
 		_node.MaxGridLimit = &value
 	}
+	if value, ok := _c.mutation.GridMode(); ok {
+		_spec.SetField(strategy.FieldGridMode, field.TypeEnum, value)
+		_node.GridMode = value
+	}
+	if value, ok := _c.mutation.InventorySeeded(); ok {
+		_spec.SetField(strategy.FieldInventorySeeded, field.TypeBool, value)
+		_node.InventorySeeded = value
+	}
+	if value, ok := _c.mutation.GridType(); ok {
+		_spec.SetField(strategy.FieldGridType, field.TypeEnum, value)
+		_node.GridType = value
+	}
+	if value, ok := _c.mutation.GridCount(); ok {
+		_spec.SetField(strategy.FieldGridCount, field.TypeInt, value)
+		_node.GridCount = &value
+	}
+	if value, ok := _c.mutation.PriceStep(); ok {
+		_spec.SetField(strategy.FieldPriceStep, field.TypeString, value)
+		_node.PriceStep = &value
+	}
+	if value, ok := _c.mutation.GridLadder(); ok {
+		_spec.SetField(strategy.FieldGridLadder, field.TypeString, value)
+		_node.GridLadder = &value
+	}
 	if value, ok := _c.mutation.TakeProfitRatio(); ok {
 		_spec.SetField(strategy.FieldTakeProfitRatio, field.TypeString, value)
 		_node.TakeProfitRatio = value
 	}
+	if value, ok := _c.mutation.TrailingTakeProfit(); ok {
+		_spec.SetField(strategy.FieldTrailingTakeProfit, field.TypeString, value)
+		_node.TrailingTakeProfit = &value
+	}
+	if value, ok := _c.mutation.TakeProfitPortion(); ok {
+		_spec.SetField(strategy.FieldTakeProfitPortion, field.TypeString, value)
+		_node.TakeProfitPortion = &value
+	}
+	if value, ok := _c.mutation.AdaptiveSpacing(); ok {
+		_spec.SetField(strategy.FieldAdaptiveSpacing, field.TypeBool, value)
+		_node.AdaptiveSpacing = value
+	}
+	if value, ok := _c.mutation.AdaptiveMinRatio(); ok {
+		_spec.SetField(strategy.FieldAdaptiveMinRatio, field.TypeString, value)
+		_node.AdaptiveMinRatio = &value
+	}
+	if value, ok := _c.mutation.AdaptiveMaxRatio(); ok {
+		_spec.SetField(strategy.FieldAdaptiveMaxRatio, field.TypeString, value)
+		_node.AdaptiveMaxRatio = &value
+	}
+	if value, ok := _c.mutation.AdaptiveVolatility(); ok {
+		_spec.SetField(strategy.FieldAdaptiveVolatility, field.TypeString, value)
+		_node.AdaptiveVolatility = &value
+	}
+	if value, ok := _c.mutation.AdaptiveUpdateTime(); ok {
+		_spec.SetField(strategy.FieldAdaptiveUpdateTime, field.TypeTime, value)
+		_node.AdaptiveUpdateTime = &value
+	}
 	if value, ok := _c.mutation.UpperPriceBound(); ok {
 		_spec.SetField(strategy.FieldUpperPriceBound, field.TypeString, value)
 		_node.UpperPriceBound = value
@@ -579,6 +1007,18 @@ func (_c *StrategyCreate) createSpec() (*Strategy, *sqlgraph.CreateSpec) {
 		_spec.SetField(strategy.FieldDynamicStopLoss, field.TypeBool, value)
 		_node.DynamicStopLoss = value
 	}
+	if value, ok := _c.mutation.TrailingUp(); ok {
+		_spec.SetField(strategy.FieldTrailingUp, field.TypeBool, value)
+		_node.TrailingUp = value
+	}
+	if value, ok := _c.mutation.TrailingDown(); ok {
+		_spec.SetField(strategy.FieldTrailingDown, field.TypeBool, value)
+		_node.TrailingDown = value
+	}
+	if value, ok := _c.mutation.TrailingDwellMinutes(); ok {
+		_spec.SetField(strategy.FieldTrailingDwellMinutes, field.TypeInt, value)
+		_node.TrailingDwellMinutes = value
+	}
 	if value, ok := _c.mutation.DropOn(); ok {
 		_spec.SetField(strategy.FieldDropOn, field.TypeBool, value)
 		_node.DropOn = value
@@ -591,6 +1031,22 @@ func (_c *StrategyCreate) createSpec() (*Strategy, *sqlgraph.CreateSpec) {
 		_spec.SetField(strategy.FieldDropThreshold, field.TypeString, value)
 		_node.DropThreshold = &value
 	}
+	if value, ok := _c.mutation.RsiThreshold(); ok {
+		_spec.SetField(strategy.FieldRsiThreshold, field.TypeString, value)
+		_node.RsiThreshold = &value
+	}
+	if value, ok := _c.mutation.EmaFilter(); ok {
+		_spec.SetField(strategy.FieldEmaFilter, field.TypeEnum, value)
+		_node.EmaFilter = value
+	}
+	if value, ok := _c.mutation.EmaPeriod(); ok {
+		_spec.SetField(strategy.FieldEmaPeriod, field.TypeInt, value)
+		_node.EmaPeriod = value
+	}
+	if value, ok := _c.mutation.MacdFilter(); ok {
+		_spec.SetField(strategy.FieldMacdFilter, field.TypeBool, value)
+		_node.MacdFilter = value
+	}
 	if value, ok := _c.mutation.EnableAutoBuy(); ok {
 		_spec.SetField(strategy.FieldEnableAutoBuy, field.TypeBool, value)
 		_node.EnableAutoBuy = value
@@ -607,6 +1063,10 @@ func (_c *StrategyCreate) createSpec() (*Strategy, *sqlgraph.CreateSpec) {
 		_spec.SetField(strategy.FieldEnablePushNotification, field.TypeBool, value)
 		_node.EnablePushNotification = value
 	}
+	if value, ok := _c.mutation.PaperTrading(); ok {
+		_spec.SetField(strategy.FieldPaperTrading, field.TypeBool, value)
+		_node.PaperTrading = value
+	}
 	if value, ok := _c.mutation.Status(); ok {
 		_spec.SetField(strategy.FieldStatus, field.TypeEnum, value)
 		_node.Status = value
@@ -615,6 +1075,10 @@ func (_c *StrategyCreate) createSpec() (*Strategy, *sqlgraph.CreateSpec) {
 		_spec.SetField(strategy.FieldGridTrend, field.TypeString, value)
 		_node.GridTrend = &value
 	}
+	if value, ok := _c.mutation.BuyFilterReason(); ok {
+		_spec.SetField(strategy.FieldBuyFilterReason, field.TypeString, value)
+		_node.BuyFilterReason = &value
+	}
 	if value, ok := _c.mutation.LastLowerThresholdAlertTime(); ok {
 		_spec.SetField(strategy.FieldLastLowerThresholdAlertTime, field.TypeTime, value)
 		_node.LastLowerThresholdAlertTime = &value
@@ -623,6 +1087,10 @@ func (_c *StrategyCreate) createSpec() (*Strategy, *sqlgraph.CreateSpec) {
 		_spec.SetField(strategy.FieldLastUpperThresholdAlertTime, field.TypeTime, value)
 		_node.LastUpperThresholdAlertTime = &value
 	}
+	if value, ok := _c.mutation.OutOfRangeTime(); ok {
+		_spec.SetField(strategy.FieldOutOfRangeTime, field.TypeTime, value)
+		_node.OutOfRangeTime = &value
+	}
 	return _node, _spec
 }
 
@@ -147,6 +147,121 @@ func (_u *StrategyUpdate) ClearMaxGridLimit() *StrategyUpdate {
 	return _u
 }
 
+// SetGridMode sets the "gridMode" field.
+func (_u *StrategyUpdate) SetGridMode(v strategy.GridMode) *StrategyUpdate {
+	_u.mutation.SetGridMode(v)
+	return _u
+}
+
+// SetNillableGridMode sets the "gridMode" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableGridMode(v *strategy.GridMode) *StrategyUpdate {
+	if v != nil {
+		_u.SetGridMode(*v)
+	}
+	return _u
+}
+
+// SetInventorySeeded sets the "inventorySeeded" field.
+func (_u *StrategyUpdate) SetInventorySeeded(v bool) *StrategyUpdate {
+	_u.mutation.SetInventorySeeded(v)
+	return _u
+}
+
+// SetNillableInventorySeeded sets the "inventorySeeded" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableInventorySeeded(v *bool) *StrategyUpdate {
+	if v != nil {
+		_u.SetInventorySeeded(*v)
+	}
+	return _u
+}
+
+// ClearInventorySeeded clears the value of the "inventorySeeded" field.
+func (_u *StrategyUpdate) ClearInventorySeeded() *StrategyUpdate {
+	_u.mutation.ClearInventorySeeded()
+	return _u
+}
+
+// SetGridType sets the "gridType" field.
+func (_u *StrategyUpdate) SetGridType(v strategy.GridType) *StrategyUpdate {
+	_u.mutation.SetGridType(v)
+	return _u
+}
+
+// SetNillableGridType sets the "gridType" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableGridType(v *strategy.GridType) *StrategyUpdate {
+	if v != nil {
+		_u.SetGridType(*v)
+	}
+	return _u
+}
+
+// SetGridCount sets the "gridCount" field.
+func (_u *StrategyUpdate) SetGridCount(v int) *StrategyUpdate {
+	_u.mutation.ResetGridCount()
+	_u.mutation.SetGridCount(v)
+	return _u
+}
+
+// SetNillableGridCount sets the "gridCount" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableGridCount(v *int) *StrategyUpdate {
+	if v != nil {
+		_u.SetGridCount(*v)
+	}
+	return _u
+}
+
+// AddGridCount adds value to the "gridCount" field.
+func (_u *StrategyUpdate) AddGridCount(v int) *StrategyUpdate {
+	_u.mutation.AddGridCount(v)
+	return _u
+}
+
+// ClearGridCount clears the value of the "gridCount" field.
+func (_u *StrategyUpdate) ClearGridCount() *StrategyUpdate {
+	_u.mutation.ClearGridCount()
+	return _u
+}
+
+// SetPriceStep sets the "priceStep" field.
+func (_u *StrategyUpdate) SetPriceStep(v decimal.Decimal) *StrategyUpdate {
+	_u.mutation.SetPriceStep(v)
+	return _u
+}
+
+// SetNillablePriceStep sets the "priceStep" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillablePriceStep(v *decimal.Decimal) *StrategyUpdate {
+	if v != nil {
+		_u.SetPriceStep(*v)
+	}
+	return _u
+}
+
+// ClearPriceStep clears the value of the "priceStep" field.
+func (_u *StrategyUpdate) ClearPriceStep() *StrategyUpdate {
+	_u.mutation.ClearPriceStep()
+	return _u
+}
+
+// SetGridLadder sets the "gridLadder" field.
+func (_u *StrategyUpdate) SetGridLadder(v string) *StrategyUpdate {
+	_u.mutation.SetGridLadder(v)
+	return _u
+}
+
+// SetNillableGridLadder sets the "gridLadder" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableGridLadder(v *string) *StrategyUpdate {
+	if v != nil {
+		_u.SetGridLadder(*v)
+	}
+	return _u
+}
+
+// ClearGridLadder clears the value of the "gridLadder" field.
+func (_u *StrategyUpdate) ClearGridLadder() *StrategyUpdate {
+	_u.mutation.ClearGridLadder()
+	return _u
+}
+
 // SetTakeProfitRatio sets the "takeProfitRatio" field.
 func (_u *StrategyUpdate) SetTakeProfitRatio(v decimal.Decimal) *StrategyUpdate {
 	_u.mutation.SetTakeProfitRatio(v)
@@ -161,6 +276,146 @@ func (_u *StrategyUpdate) SetNillableTakeProfitRatio(v *decimal.Decimal) *Strate
 	return _u
 }
 
+// SetTrailingTakeProfit sets the "trailingTakeProfit" field.
+func (_u *StrategyUpdate) SetTrailingTakeProfit(v decimal.Decimal) *StrategyUpdate {
+	_u.mutation.SetTrailingTakeProfit(v)
+	return _u
+}
+
+// SetNillableTrailingTakeProfit sets the "trailingTakeProfit" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableTrailingTakeProfit(v *decimal.Decimal) *StrategyUpdate {
+	if v != nil {
+		_u.SetTrailingTakeProfit(*v)
+	}
+	return _u
+}
+
+// ClearTrailingTakeProfit clears the value of the "trailingTakeProfit" field.
+func (_u *StrategyUpdate) ClearTrailingTakeProfit() *StrategyUpdate {
+	_u.mutation.ClearTrailingTakeProfit()
+	return _u
+}
+
+// SetTakeProfitPortion sets the "takeProfitPortion" field.
+func (_u *StrategyUpdate) SetTakeProfitPortion(v decimal.Decimal) *StrategyUpdate {
+	_u.mutation.SetTakeProfitPortion(v)
+	return _u
+}
+
+// SetNillableTakeProfitPortion sets the "takeProfitPortion" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableTakeProfitPortion(v *decimal.Decimal) *StrategyUpdate {
+	if v != nil {
+		_u.SetTakeProfitPortion(*v)
+	}
+	return _u
+}
+
+// ClearTakeProfitPortion clears the value of the "takeProfitPortion" field.
+func (_u *StrategyUpdate) ClearTakeProfitPortion() *StrategyUpdate {
+	_u.mutation.ClearTakeProfitPortion()
+	return _u
+}
+
+// SetAdaptiveSpacing sets the "adaptiveSpacing" field.
+func (_u *StrategyUpdate) SetAdaptiveSpacing(v bool) *StrategyUpdate {
+	_u.mutation.SetAdaptiveSpacing(v)
+	return _u
+}
+
+// SetNillableAdaptiveSpacing sets the "adaptiveSpacing" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableAdaptiveSpacing(v *bool) *StrategyUpdate {
+	if v != nil {
+		_u.SetAdaptiveSpacing(*v)
+	}
+	return _u
+}
+
+// ClearAdaptiveSpacing clears the value of the "adaptiveSpacing" field.
+func (_u *StrategyUpdate) ClearAdaptiveSpacing() *StrategyUpdate {
+	_u.mutation.ClearAdaptiveSpacing()
+	return _u
+}
+
+// SetAdaptiveMinRatio sets the "adaptiveMinRatio" field.
+func (_u *StrategyUpdate) SetAdaptiveMinRatio(v decimal.Decimal) *StrategyUpdate {
+	_u.mutation.SetAdaptiveMinRatio(v)
+	return _u
+}
+
+// SetNillableAdaptiveMinRatio sets the "adaptiveMinRatio" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableAdaptiveMinRatio(v *decimal.Decimal) *StrategyUpdate {
+	if v != nil {
+		_u.SetAdaptiveMinRatio(*v)
+	}
+	return _u
+}
+
+// ClearAdaptiveMinRatio clears the value of the "adaptiveMinRatio" field.
+func (_u *StrategyUpdate) ClearAdaptiveMinRatio() *StrategyUpdate {
+	_u.mutation.ClearAdaptiveMinRatio()
+	return _u
+}
+
+// SetAdaptiveMaxRatio sets the "adaptiveMaxRatio" field.
+func (_u *StrategyUpdate) SetAdaptiveMaxRatio(v decimal.Decimal) *StrategyUpdate {
+	_u.mutation.SetAdaptiveMaxRatio(v)
+	return _u
+}
+
+// SetNillableAdaptiveMaxRatio sets the "adaptiveMaxRatio" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableAdaptiveMaxRatio(v *decimal.Decimal) *StrategyUpdate {
+	if v != nil {
+		_u.SetAdaptiveMaxRatio(*v)
+	}
+	return _u
+}
+
+// ClearAdaptiveMaxRatio clears the value of the "adaptiveMaxRatio" field.
+func (_u *StrategyUpdate) ClearAdaptiveMaxRatio() *StrategyUpdate {
+	_u.mutation.ClearAdaptiveMaxRatio()
+	return _u
+}
+
+// SetAdaptiveVolatility sets the "adaptiveVolatility" field.
+func (_u *StrategyUpdate) SetAdaptiveVolatility(v decimal.Decimal) *StrategyUpdate {
+	_u.mutation.SetAdaptiveVolatility(v)
+	return _u
+}
+
+// SetNillableAdaptiveVolatility sets the "adaptiveVolatility" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableAdaptiveVolatility(v *decimal.Decimal) *StrategyUpdate {
+	if v != nil {
+		_u.SetAdaptiveVolatility(*v)
+	}
+	return _u
+}
+
+// ClearAdaptiveVolatility clears the value of the "adaptiveVolatility" field.
+func (_u *StrategyUpdate) ClearAdaptiveVolatility() *StrategyUpdate {
+	_u.mutation.ClearAdaptiveVolatility()
+	return _u
+}
+
+// SetAdaptiveUpdateTime sets the "adaptiveUpdateTime" field.
+func (_u *StrategyUpdate) SetAdaptiveUpdateTime(v time.Time) *StrategyUpdate {
+	_u.mutation.SetAdaptiveUpdateTime(v)
+	return _u
+}
+
+// SetNillableAdaptiveUpdateTime sets the "adaptiveUpdateTime" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableAdaptiveUpdateTime(v *time.Time) *StrategyUpdate {
+	if v != nil {
+		_u.SetAdaptiveUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearAdaptiveUpdateTime clears the value of the "adaptiveUpdateTime" field.
+func (_u *StrategyUpdate) ClearAdaptiveUpdateTime() *StrategyUpdate {
+	_u.mutation.ClearAdaptiveUpdateTime()
+	return _u
+}
+
 // SetUpperPriceBound sets the "upperPriceBound" field.
 func (_u *StrategyUpdate) SetUpperPriceBound(v decimal.Decimal) *StrategyUpdate {
 	_u.mutation.SetUpperPriceBound(v)
@@ -370,6 +625,73 @@ func (_u *StrategyUpdate) ClearDynamicStopLoss() *StrategyUpdate {
 	return _u
 }
 
+// SetTrailingUp sets the "trailingUp" field.
+func (_u *StrategyUpdate) SetTrailingUp(v bool) *StrategyUpdate {
+	_u.mutation.SetTrailingUp(v)
+	return _u
+}
+
+// SetNillableTrailingUp sets the "trailingUp" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableTrailingUp(v *bool) *StrategyUpdate {
+	if v != nil {
+		_u.SetTrailingUp(*v)
+	}
+	return _u
+}
+
+// ClearTrailingUp clears the value of the "trailingUp" field.
+func (_u *StrategyUpdate) ClearTrailingUp() *StrategyUpdate {
+	_u.mutation.ClearTrailingUp()
+	return _u
+}
+
+// SetTrailingDown sets the "trailingDown" field.
+func (_u *StrategyUpdate) SetTrailingDown(v bool) *StrategyUpdate {
+	_u.mutation.SetTrailingDown(v)
+	return _u
+}
+
+// SetNillableTrailingDown sets the "trailingDown" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableTrailingDown(v *bool) *StrategyUpdate {
+	if v != nil {
+		_u.SetTrailingDown(*v)
+	}
+	return _u
+}
+
+// ClearTrailingDown clears the value of the "trailingDown" field.
+func (_u *StrategyUpdate) ClearTrailingDown() *StrategyUpdate {
+	_u.mutation.ClearTrailingDown()
+	return _u
+}
+
+// SetTrailingDwellMinutes sets the "trailingDwellMinutes" field.
+func (_u *StrategyUpdate) SetTrailingDwellMinutes(v int) *StrategyUpdate {
+	_u.mutation.ResetTrailingDwellMinutes()
+	_u.mutation.SetTrailingDwellMinutes(v)
+	return _u
+}
+
+// SetNillableTrailingDwellMinutes sets the "trailingDwellMinutes" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableTrailingDwellMinutes(v *int) *StrategyUpdate {
+	if v != nil {
+		_u.SetTrailingDwellMinutes(*v)
+	}
+	return _u
+}
+
+// AddTrailingDwellMinutes adds value to the "trailingDwellMinutes" field.
+func (_u *StrategyUpdate) AddTrailingDwellMinutes(v int) *StrategyUpdate {
+	_u.mutation.AddTrailingDwellMinutes(v)
+	return _u
+}
+
+// ClearTrailingDwellMinutes clears the value of the "trailingDwellMinutes" field.
+func (_u *StrategyUpdate) ClearTrailingDwellMinutes() *StrategyUpdate {
+	_u.mutation.ClearTrailingDwellMinutes()
+	return _u
+}
+
 // SetDropOn sets the "dropOn" field.
 func (_u *StrategyUpdate) SetDropOn(v bool) *StrategyUpdate {
 	_u.mutation.SetDropOn(v)
@@ -437,6 +759,87 @@ func (_u *StrategyUpdate) ClearDropThreshold() *StrategyUpdate {
 	return _u
 }
 
+// SetRsiThreshold sets the "rsiThreshold" field.
+func (_u *StrategyUpdate) SetRsiThreshold(v decimal.Decimal) *StrategyUpdate {
+	_u.mutation.SetRsiThreshold(v)
+	return _u
+}
+
+// SetNillableRsiThreshold sets the "rsiThreshold" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableRsiThreshold(v *decimal.Decimal) *StrategyUpdate {
+	if v != nil {
+		_u.SetRsiThreshold(*v)
+	}
+	return _u
+}
+
+// ClearRsiThreshold clears the value of the "rsiThreshold" field.
+func (_u *StrategyUpdate) ClearRsiThreshold() *StrategyUpdate {
+	_u.mutation.ClearRsiThreshold()
+	return _u
+}
+
+// SetEmaFilter sets the "emaFilter" field.
+func (_u *StrategyUpdate) SetEmaFilter(v strategy.EmaFilter) *StrategyUpdate {
+	_u.mutation.SetEmaFilter(v)
+	return _u
+}
+
+// SetNillableEmaFilter sets the "emaFilter" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableEmaFilter(v *strategy.EmaFilter) *StrategyUpdate {
+	if v != nil {
+		_u.SetEmaFilter(*v)
+	}
+	return _u
+}
+
+// SetEmaPeriod sets the "emaPeriod" field.
+func (_u *StrategyUpdate) SetEmaPeriod(v int) *StrategyUpdate {
+	_u.mutation.ResetEmaPeriod()
+	_u.mutation.SetEmaPeriod(v)
+	return _u
+}
+
+// SetNillableEmaPeriod sets the "emaPeriod" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableEmaPeriod(v *int) *StrategyUpdate {
+	if v != nil {
+		_u.SetEmaPeriod(*v)
+	}
+	return _u
+}
+
+// AddEmaPeriod adds value to the "emaPeriod" field.
+func (_u *StrategyUpdate) AddEmaPeriod(v int) *StrategyUpdate {
+	_u.mutation.AddEmaPeriod(v)
+	return _u
+}
+
+// ClearEmaPeriod clears the value of the "emaPeriod" field.
+func (_u *StrategyUpdate) ClearEmaPeriod() *StrategyUpdate {
+	_u.mutation.ClearEmaPeriod()
+	return _u
+}
+
+// SetMacdFilter sets the "macdFilter" field.
+func (_u *StrategyUpdate) SetMacdFilter(v bool) *StrategyUpdate {
+	_u.mutation.SetMacdFilter(v)
+	return _u
+}
+
+// SetNillableMacdFilter sets the "macdFilter" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableMacdFilter(v *bool) *StrategyUpdate {
+	if v != nil {
+		_u.SetMacdFilter(*v)
+	}
+	return _u
+}
+
+// ClearMacdFilter clears the value of the "macdFilter" field.
+func (_u *StrategyUpdate) ClearMacdFilter() *StrategyUpdate {
+	_u.mutation.ClearMacdFilter()
+	return _u
+}
+
 // SetEnableAutoBuy sets the "enableAutoBuy" field.
 func (_u *StrategyUpdate) SetEnableAutoBuy(v bool) *StrategyUpdate {
 	_u.mutation.SetEnableAutoBuy(v)
@@ -493,6 +896,26 @@ func (_u *StrategyUpdate) SetNillableEnablePushNotification(v *bool) *StrategyUp
 	return _u
 }
 
+// SetPaperTrading sets the "paperTrading" field.
+func (_u *StrategyUpdate) SetPaperTrading(v bool) *StrategyUpdate {
+	_u.mutation.SetPaperTrading(v)
+	return _u
+}
+
+// SetNillablePaperTrading sets the "paperTrading" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillablePaperTrading(v *bool) *StrategyUpdate {
+	if v != nil {
+		_u.SetPaperTrading(*v)
+	}
+	return _u
+}
+
+// ClearPaperTrading clears the value of the "paperTrading" field.
+func (_u *StrategyUpdate) ClearPaperTrading() *StrategyUpdate {
+	_u.mutation.ClearPaperTrading()
+	return _u
+}
+
 // SetStatus sets the "status" field.
 func (_u *StrategyUpdate) SetStatus(v strategy.Status) *StrategyUpdate {
 	_u.mutation.SetStatus(v)
@@ -527,6 +950,26 @@ func (_u *StrategyUpdate) ClearGridTrend() *StrategyUpdate {
 	return _u
 }
 
+// SetBuyFilterReason sets the "buyFilterReason" field.
+func (_u *StrategyUpdate) SetBuyFilterReason(v string) *StrategyUpdate {
+	_u.mutation.SetBuyFilterReason(v)
+	return _u
+}
+
+// SetNillableBuyFilterReason sets the "buyFilterReason" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableBuyFilterReason(v *string) *StrategyUpdate {
+	if v != nil {
+		_u.SetBuyFilterReason(*v)
+	}
+	return _u
+}
+
+// ClearBuyFilterReason clears the value of the "buyFilterReason" field.
+func (_u *StrategyUpdate) ClearBuyFilterReason() *StrategyUpdate {
+	_u.mutation.ClearBuyFilterReason()
+	return _u
+}
+
 // SetLastLowerThresholdAlertTime sets the "lastLowerThresholdAlertTime" field.
 func (_u *StrategyUpdate) SetLastLowerThresholdAlertTime(v time.Time) *StrategyUpdate {
 	_u.mutation.SetLastLowerThresholdAlertTime(v)
@@ -567,6 +1010,26 @@ func (_u *StrategyUpdate) ClearLastUpperThresholdAlertTime() *StrategyUpdate {
 	return _u
 }
 
+// SetOutOfRangeTime sets the "outOfRangeTime" field.
+func (_u *StrategyUpdate) SetOutOfRangeTime(v time.Time) *StrategyUpdate {
+	_u.mutation.SetOutOfRangeTime(v)
+	return _u
+}
+
+// SetNillableOutOfRangeTime sets the "outOfRangeTime" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableOutOfRangeTime(v *time.Time) *StrategyUpdate {
+	if v != nil {
+		_u.SetOutOfRangeTime(*v)
+	}
+	return _u
+}
+
+// ClearOutOfRangeTime clears the value of the "outOfRangeTime" field.
+func (_u *StrategyUpdate) ClearOutOfRangeTime() *StrategyUpdate {
+	_u.mutation.ClearOutOfRangeTime()
+	return _u
+}
+
 // Mutation returns the StrategyMutation object of the builder.
 func (_u *StrategyUpdate) Mutation() *StrategyMutation {
 	return _u.mutation
@@ -635,11 +1098,36 @@ func (_u *StrategyUpdate) check() error {
 			return &ValidationError{Name: "maxGridLimit", err: fmt.Errorf(`ent: validator failed for field "Strategy.maxGridLimit": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.GridMode(); ok {
+		if err := strategy.GridModeValidator(v); err != nil {
+			return &ValidationError{Name: "gridMode", err: fmt.Errorf(`ent: validator failed for field "Strategy.gridMode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GridType(); ok {
+		if err := strategy.GridTypeValidator(v); err != nil {
+			return &ValidationError{Name: "gridType", err: fmt.Errorf(`ent: validator failed for field "Strategy.gridType": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GridCount(); ok {
+		if err := strategy.GridCountValidator(v); err != nil {
+			return &ValidationError{Name: "gridCount", err: fmt.Errorf(`ent: validator failed for field "Strategy.gridCount": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EmaFilter(); ok {
+		if err := strategy.EmaFilterValidator(v); err != nil {
+			return &ValidationError{Name: "emaFilter", err: fmt.Errorf(`ent: validator failed for field "Strategy.emaFilter": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Status(); ok {
 		if err := strategy.StatusValidator(v); err != nil {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Strategy.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.BuyFilterReason(); ok {
+		if err := strategy.BuyFilterReasonValidator(v); err != nil {
+			return &ValidationError{Name: "buyFilterReason", err: fmt.Errorf(`ent: validator failed for field "Strategy.buyFilterReason": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -688,9 +1176,84 @@ func (_u *StrategyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.MaxGridLimitCleared() {
 		_spec.ClearField(strategy.FieldMaxGridLimit, field.TypeInt)
 	}
+	if value, ok := _u.mutation.GridMode(); ok {
+		_spec.SetField(strategy.FieldGridMode, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.InventorySeeded(); ok {
+		_spec.SetField(strategy.FieldInventorySeeded, field.TypeBool, value)
+	}
+	if _u.mutation.InventorySeededCleared() {
+		_spec.ClearField(strategy.FieldInventorySeeded, field.TypeBool)
+	}
+	if value, ok := _u.mutation.GridType(); ok {
+		_spec.SetField(strategy.FieldGridType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.GridCount(); ok {
+		_spec.SetField(strategy.FieldGridCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGridCount(); ok {
+		_spec.AddField(strategy.FieldGridCount, field.TypeInt, value)
+	}
+	if _u.mutation.GridCountCleared() {
+		_spec.ClearField(strategy.FieldGridCount, field.TypeInt)
+	}
+	if value, ok := _u.mutation.PriceStep(); ok {
+		_spec.SetField(strategy.FieldPriceStep, field.TypeString, value)
+	}
+	if _u.mutation.PriceStepCleared() {
+		_spec.ClearField(strategy.FieldPriceStep, field.TypeString)
+	}
+	if value, ok := _u.mutation.GridLadder(); ok {
+		_spec.SetField(strategy.FieldGridLadder, field.TypeString, value)
+	}
+	if _u.mutation.GridLadderCleared() {
+		_spec.ClearField(strategy.FieldGridLadder, field.TypeString)
+	}
 	if value, ok := _u.mutation.TakeProfitRatio(); ok {
 		_spec.SetField(strategy.FieldTakeProfitRatio, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.TrailingTakeProfit(); ok {
+		_spec.SetField(strategy.FieldTrailingTakeProfit, field.TypeString, value)
+	}
+	if _u.mutation.TrailingTakeProfitCleared() {
+		_spec.ClearField(strategy.FieldTrailingTakeProfit, field.TypeString)
+	}
+	if value, ok := _u.mutation.TakeProfitPortion(); ok {
+		_spec.SetField(strategy.FieldTakeProfitPortion, field.TypeString, value)
+	}
+	if _u.mutation.TakeProfitPortionCleared() {
+		_spec.ClearField(strategy.FieldTakeProfitPortion, field.TypeString)
+	}
+	if value, ok := _u.mutation.AdaptiveSpacing(); ok {
+		_spec.SetField(strategy.FieldAdaptiveSpacing, field.TypeBool, value)
+	}
+	if _u.mutation.AdaptiveSpacingCleared() {
+		_spec.ClearField(strategy.FieldAdaptiveSpacing, field.TypeBool)
+	}
+	if value, ok := _u.mutation.AdaptiveMinRatio(); ok {
+		_spec.SetField(strategy.FieldAdaptiveMinRatio, field.TypeString, value)
+	}
+	if _u.mutation.AdaptiveMinRatioCleared() {
+		_spec.ClearField(strategy.FieldAdaptiveMinRatio, field.TypeString)
+	}
+	if value, ok := _u.mutation.AdaptiveMaxRatio(); ok {
+		_spec.SetField(strategy.FieldAdaptiveMaxRatio, field.TypeString, value)
+	}
+	if _u.mutation.AdaptiveMaxRatioCleared() {
+		_spec.ClearField(strategy.FieldAdaptiveMaxRatio, field.TypeString)
+	}
+	if value, ok := _u.mutation.AdaptiveVolatility(); ok {
+		_spec.SetField(strategy.FieldAdaptiveVolatility, field.TypeString, value)
+	}
+	if _u.mutation.AdaptiveVolatilityCleared() {
+		_spec.ClearField(strategy.FieldAdaptiveVolatility, field.TypeString)
+	}
+	if value, ok := _u.mutation.AdaptiveUpdateTime(); ok {
+		_spec.SetField(strategy.FieldAdaptiveUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.AdaptiveUpdateTimeCleared() {
+		_spec.ClearField(strategy.FieldAdaptiveUpdateTime, field.TypeTime)
+	}
 	if value, ok := _u.mutation.UpperPriceBound(); ok {
 		_spec.SetField(strategy.FieldUpperPriceBound, field.TypeString, value)
 	}
@@ -751,6 +1314,27 @@ func (_u *StrategyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.DynamicStopLossCleared() {
 		_spec.ClearField(strategy.FieldDynamicStopLoss, field.TypeBool)
 	}
+	if value, ok := _u.mutation.TrailingUp(); ok {
+		_spec.SetField(strategy.FieldTrailingUp, field.TypeBool, value)
+	}
+	if _u.mutation.TrailingUpCleared() {
+		_spec.ClearField(strategy.FieldTrailingUp, field.TypeBool)
+	}
+	if value, ok := _u.mutation.TrailingDown(); ok {
+		_spec.SetField(strategy.FieldTrailingDown, field.TypeBool, value)
+	}
+	if _u.mutation.TrailingDownCleared() {
+		_spec.ClearField(strategy.FieldTrailingDown, field.TypeBool)
+	}
+	if value, ok := _u.mutation.TrailingDwellMinutes(); ok {
+		_spec.SetField(strategy.FieldTrailingDwellMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTrailingDwellMinutes(); ok {
+		_spec.AddField(strategy.FieldTrailingDwellMinutes, field.TypeInt, value)
+	}
+	if _u.mutation.TrailingDwellMinutesCleared() {
+		_spec.ClearField(strategy.FieldTrailingDwellMinutes, field.TypeInt)
+	}
 	if value, ok := _u.mutation.DropOn(); ok {
 		_spec.SetField(strategy.FieldDropOn, field.TypeBool, value)
 	}
@@ -772,6 +1356,30 @@ func (_u *StrategyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.DropThresholdCleared() {
 		_spec.ClearField(strategy.FieldDropThreshold, field.TypeString)
 	}
+	if value, ok := _u.mutation.RsiThreshold(); ok {
+		_spec.SetField(strategy.FieldRsiThreshold, field.TypeString, value)
+	}
+	if _u.mutation.RsiThresholdCleared() {
+		_spec.ClearField(strategy.FieldRsiThreshold, field.TypeString)
+	}
+	if value, ok := _u.mutation.EmaFilter(); ok {
+		_spec.SetField(strategy.FieldEmaFilter, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.EmaPeriod(); ok {
+		_spec.SetField(strategy.FieldEmaPeriod, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEmaPeriod(); ok {
+		_spec.AddField(strategy.FieldEmaPeriod, field.TypeInt, value)
+	}
+	if _u.mutation.EmaPeriodCleared() {
+		_spec.ClearField(strategy.FieldEmaPeriod, field.TypeInt)
+	}
+	if value, ok := _u.mutation.MacdFilter(); ok {
+		_spec.SetField(strategy.FieldMacdFilter, field.TypeBool, value)
+	}
+	if _u.mutation.MacdFilterCleared() {
+		_spec.ClearField(strategy.FieldMacdFilter, field.TypeBool)
+	}
 	if value, ok := _u.mutation.EnableAutoBuy(); ok {
 		_spec.SetField(strategy.FieldEnableAutoBuy, field.TypeBool, value)
 	}
@@ -784,6 +1392,12 @@ func (_u *StrategyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.EnablePushNotification(); ok {
 		_spec.SetField(strategy.FieldEnablePushNotification, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.PaperTrading(); ok {
+		_spec.SetField(strategy.FieldPaperTrading, field.TypeBool, value)
+	}
+	if _u.mutation.PaperTradingCleared() {
+		_spec.ClearField(strategy.FieldPaperTrading, field.TypeBool)
+	}
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(strategy.FieldStatus, field.TypeEnum, value)
 	}
@@ -793,6 +1407,12 @@ func (_u *StrategyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.GridTrendCleared() {
 		_spec.ClearField(strategy.FieldGridTrend, field.TypeString)
 	}
+	if value, ok := _u.mutation.BuyFilterReason(); ok {
+		_spec.SetField(strategy.FieldBuyFilterReason, field.TypeString, value)
+	}
+	if _u.mutation.BuyFilterReasonCleared() {
+		_spec.ClearField(strategy.FieldBuyFilterReason, field.TypeString)
+	}
 	if value, ok := _u.mutation.LastLowerThresholdAlertTime(); ok {
 		_spec.SetField(strategy.FieldLastLowerThresholdAlertTime, field.TypeTime, value)
 	}
@@ -805,6 +1425,12 @@ func (_u *StrategyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.LastUpperThresholdAlertTimeCleared() {
 		_spec.ClearField(strategy.FieldLastUpperThresholdAlertTime, field.TypeTime)
 	}
+	if value, ok := _u.mutation.OutOfRangeTime(); ok {
+		_spec.SetField(strategy.FieldOutOfRangeTime, field.TypeTime, value)
+	}
+	if _u.mutation.OutOfRangeTimeCleared() {
+		_spec.ClearField(strategy.FieldOutOfRangeTime, field.TypeTime)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{strategy.Label}
@@ -825,137 +1451,392 @@ type StrategyUpdateOne struct {
 	mutation *StrategyMutation
 }
 
-// SetUpdateTime sets the "update_time" field.
-func (_u *StrategyUpdateOne) SetUpdateTime(v time.Time) *StrategyUpdateOne {
-	_u.mutation.SetUpdateTime(v)
+// SetUpdateTime sets the "update_time" field.
+func (_u *StrategyUpdateOne) SetUpdateTime(v time.Time) *StrategyUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetGUID sets the "guid" field.
+func (_u *StrategyUpdateOne) SetGUID(v string) *StrategyUpdateOne {
+	_u.mutation.SetGUID(v)
+	return _u
+}
+
+// SetNillableGUID sets the "guid" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableGUID(v *string) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetGUID(*v)
+	}
+	return _u
+}
+
+// SetUserId sets the "userId" field.
+func (_u *StrategyUpdateOne) SetUserId(v int64) *StrategyUpdateOne {
+	_u.mutation.ResetUserId()
+	_u.mutation.SetUserId(v)
+	return _u
+}
+
+// SetNillableUserId sets the "userId" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableUserId(v *int64) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetUserId(*v)
+	}
+	return _u
+}
+
+// AddUserId adds value to the "userId" field.
+func (_u *StrategyUpdateOne) AddUserId(v int64) *StrategyUpdateOne {
+	_u.mutation.AddUserId(v)
+	return _u
+}
+
+// SetToken sets the "token" field.
+func (_u *StrategyUpdateOne) SetToken(v string) *StrategyUpdateOne {
+	_u.mutation.SetToken(v)
+	return _u
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableToken(v *string) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetToken(*v)
+	}
+	return _u
+}
+
+// SetSymbol sets the "symbol" field.
+func (_u *StrategyUpdateOne) SetSymbol(v string) *StrategyUpdateOne {
+	_u.mutation.SetSymbol(v)
+	return _u
+}
+
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableSymbol(v *string) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetSymbol(*v)
+	}
+	return _u
+}
+
+// SetMartinFactor sets the "martinFactor" field.
+func (_u *StrategyUpdateOne) SetMartinFactor(v float64) *StrategyUpdateOne {
+	_u.mutation.ResetMartinFactor()
+	_u.mutation.SetMartinFactor(v)
+	return _u
+}
+
+// SetNillableMartinFactor sets the "martinFactor" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableMartinFactor(v *float64) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetMartinFactor(*v)
+	}
+	return _u
+}
+
+// AddMartinFactor adds value to the "martinFactor" field.
+func (_u *StrategyUpdateOne) AddMartinFactor(v float64) *StrategyUpdateOne {
+	_u.mutation.AddMartinFactor(v)
+	return _u
+}
+
+// SetMaxGridLimit sets the "maxGridLimit" field.
+func (_u *StrategyUpdateOne) SetMaxGridLimit(v int) *StrategyUpdateOne {
+	_u.mutation.ResetMaxGridLimit()
+	_u.mutation.SetMaxGridLimit(v)
+	return _u
+}
+
+// SetNillableMaxGridLimit sets the "maxGridLimit" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableMaxGridLimit(v *int) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetMaxGridLimit(*v)
+	}
+	return _u
+}
+
+// AddMaxGridLimit adds value to the "maxGridLimit" field.
+func (_u *StrategyUpdateOne) AddMaxGridLimit(v int) *StrategyUpdateOne {
+	_u.mutation.AddMaxGridLimit(v)
+	return _u
+}
+
+// ClearMaxGridLimit clears the value of the "maxGridLimit" field.
+func (_u *StrategyUpdateOne) ClearMaxGridLimit() *StrategyUpdateOne {
+	_u.mutation.ClearMaxGridLimit()
+	return _u
+}
+
+// SetGridMode sets the "gridMode" field.
+func (_u *StrategyUpdateOne) SetGridMode(v strategy.GridMode) *StrategyUpdateOne {
+	_u.mutation.SetGridMode(v)
+	return _u
+}
+
+// SetNillableGridMode sets the "gridMode" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableGridMode(v *strategy.GridMode) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetGridMode(*v)
+	}
+	return _u
+}
+
+// SetInventorySeeded sets the "inventorySeeded" field.
+func (_u *StrategyUpdateOne) SetInventorySeeded(v bool) *StrategyUpdateOne {
+	_u.mutation.SetInventorySeeded(v)
+	return _u
+}
+
+// SetNillableInventorySeeded sets the "inventorySeeded" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableInventorySeeded(v *bool) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetInventorySeeded(*v)
+	}
+	return _u
+}
+
+// ClearInventorySeeded clears the value of the "inventorySeeded" field.
+func (_u *StrategyUpdateOne) ClearInventorySeeded() *StrategyUpdateOne {
+	_u.mutation.ClearInventorySeeded()
+	return _u
+}
+
+// SetGridType sets the "gridType" field.
+func (_u *StrategyUpdateOne) SetGridType(v strategy.GridType) *StrategyUpdateOne {
+	_u.mutation.SetGridType(v)
+	return _u
+}
+
+// SetNillableGridType sets the "gridType" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableGridType(v *strategy.GridType) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetGridType(*v)
+	}
+	return _u
+}
+
+// SetGridCount sets the "gridCount" field.
+func (_u *StrategyUpdateOne) SetGridCount(v int) *StrategyUpdateOne {
+	_u.mutation.ResetGridCount()
+	_u.mutation.SetGridCount(v)
+	return _u
+}
+
+// SetNillableGridCount sets the "gridCount" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableGridCount(v *int) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetGridCount(*v)
+	}
+	return _u
+}
+
+// AddGridCount adds value to the "gridCount" field.
+func (_u *StrategyUpdateOne) AddGridCount(v int) *StrategyUpdateOne {
+	_u.mutation.AddGridCount(v)
+	return _u
+}
+
+// ClearGridCount clears the value of the "gridCount" field.
+func (_u *StrategyUpdateOne) ClearGridCount() *StrategyUpdateOne {
+	_u.mutation.ClearGridCount()
+	return _u
+}
+
+// SetPriceStep sets the "priceStep" field.
+func (_u *StrategyUpdateOne) SetPriceStep(v decimal.Decimal) *StrategyUpdateOne {
+	_u.mutation.SetPriceStep(v)
+	return _u
+}
+
+// SetNillablePriceStep sets the "priceStep" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillablePriceStep(v *decimal.Decimal) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetPriceStep(*v)
+	}
+	return _u
+}
+
+// ClearPriceStep clears the value of the "priceStep" field.
+func (_u *StrategyUpdateOne) ClearPriceStep() *StrategyUpdateOne {
+	_u.mutation.ClearPriceStep()
+	return _u
+}
+
+// SetGridLadder sets the "gridLadder" field.
+func (_u *StrategyUpdateOne) SetGridLadder(v string) *StrategyUpdateOne {
+	_u.mutation.SetGridLadder(v)
+	return _u
+}
+
+// SetNillableGridLadder sets the "gridLadder" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableGridLadder(v *string) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetGridLadder(*v)
+	}
+	return _u
+}
+
+// ClearGridLadder clears the value of the "gridLadder" field.
+func (_u *StrategyUpdateOne) ClearGridLadder() *StrategyUpdateOne {
+	_u.mutation.ClearGridLadder()
 	return _u
 }
 
-// SetGUID sets the "guid" field.
-func (_u *StrategyUpdateOne) SetGUID(v string) *StrategyUpdateOne {
-	_u.mutation.SetGUID(v)
+// SetTakeProfitRatio sets the "takeProfitRatio" field.
+func (_u *StrategyUpdateOne) SetTakeProfitRatio(v decimal.Decimal) *StrategyUpdateOne {
+	_u.mutation.SetTakeProfitRatio(v)
 	return _u
 }
 
-// SetNillableGUID sets the "guid" field if the given value is not nil.
-func (_u *StrategyUpdateOne) SetNillableGUID(v *string) *StrategyUpdateOne {
+// SetNillableTakeProfitRatio sets the "takeProfitRatio" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableTakeProfitRatio(v *decimal.Decimal) *StrategyUpdateOne {
 	if v != nil {
-		_u.SetGUID(*v)
+		_u.SetTakeProfitRatio(*v)
 	}
 	return _u
 }
 
-// SetUserId sets the "userId" field.
-func (_u *StrategyUpdateOne) SetUserId(v int64) *StrategyUpdateOne {
-	_u.mutation.ResetUserId()
-	_u.mutation.SetUserId(v)
+// SetTrailingTakeProfit sets the "trailingTakeProfit" field.
+func (_u *StrategyUpdateOne) SetTrailingTakeProfit(v decimal.Decimal) *StrategyUpdateOne {
+	_u.mutation.SetTrailingTakeProfit(v)
 	return _u
 }
 
-// SetNillableUserId sets the "userId" field if the given value is not nil.
-func (_u *StrategyUpdateOne) SetNillableUserId(v *int64) *StrategyUpdateOne {
+// SetNillableTrailingTakeProfit sets the "trailingTakeProfit" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableTrailingTakeProfit(v *decimal.Decimal) *StrategyUpdateOne {
 	if v != nil {
-		_u.SetUserId(*v)
+		_u.SetTrailingTakeProfit(*v)
 	}
 	return _u
 }
 
-// AddUserId adds value to the "userId" field.
-func (_u *StrategyUpdateOne) AddUserId(v int64) *StrategyUpdateOne {
-	_u.mutation.AddUserId(v)
+// ClearTrailingTakeProfit clears the value of the "trailingTakeProfit" field.
+func (_u *StrategyUpdateOne) ClearTrailingTakeProfit() *StrategyUpdateOne {
+	_u.mutation.ClearTrailingTakeProfit()
 	return _u
 }
 
-// SetToken sets the "token" field.
-func (_u *StrategyUpdateOne) SetToken(v string) *StrategyUpdateOne {
-	_u.mutation.SetToken(v)
+// SetTakeProfitPortion sets the "takeProfitPortion" field.
+func (_u *StrategyUpdateOne) SetTakeProfitPortion(v decimal.Decimal) *StrategyUpdateOne {
+	_u.mutation.SetTakeProfitPortion(v)
 	return _u
 }
 
-// SetNillableToken sets the "token" field if the given value is not nil.
-func (_u *StrategyUpdateOne) SetNillableToken(v *string) *StrategyUpdateOne {
+// SetNillableTakeProfitPortion sets the "takeProfitPortion" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableTakeProfitPortion(v *decimal.Decimal) *StrategyUpdateOne {
 	if v != nil {
-		_u.SetToken(*v)
+		_u.SetTakeProfitPortion(*v)
 	}
 	return _u
 }
 
-// SetSymbol sets the "symbol" field.
-func (_u *StrategyUpdateOne) SetSymbol(v string) *StrategyUpdateOne {
-	_u.mutation.SetSymbol(v)
+// ClearTakeProfitPortion clears the value of the "takeProfitPortion" field.
+func (_u *StrategyUpdateOne) ClearTakeProfitPortion() *StrategyUpdateOne {
+	_u.mutation.ClearTakeProfitPortion()
 	return _u
 }
 
-// SetNillableSymbol sets the "symbol" field if the given value is not nil.
-func (_u *StrategyUpdateOne) SetNillableSymbol(v *string) *StrategyUpdateOne {
+// SetAdaptiveSpacing sets the "adaptiveSpacing" field.
+func (_u *StrategyUpdateOne) SetAdaptiveSpacing(v bool) *StrategyUpdateOne {
+	_u.mutation.SetAdaptiveSpacing(v)
+	return _u
+}
+
+// SetNillableAdaptiveSpacing sets the "adaptiveSpacing" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableAdaptiveSpacing(v *bool) *StrategyUpdateOne {
 	if v != nil {
-		_u.SetSymbol(*v)
+		_u.SetAdaptiveSpacing(*v)
 	}
 	return _u
 }
 
-// SetMartinFactor sets the "martinFactor" field.
-func (_u *StrategyUpdateOne) SetMartinFactor(v float64) *StrategyUpdateOne {
-	_u.mutation.ResetMartinFactor()
-	_u.mutation.SetMartinFactor(v)
+// ClearAdaptiveSpacing clears the value of the "adaptiveSpacing" field.
+func (_u *StrategyUpdateOne) ClearAdaptiveSpacing() *StrategyUpdateOne {
+	_u.mutation.ClearAdaptiveSpacing()
 	return _u
 }
 
-// SetNillableMartinFactor sets the "martinFactor" field if the given value is not nil.
-func (_u *StrategyUpdateOne) SetNillableMartinFactor(v *float64) *StrategyUpdateOne {
+// SetAdaptiveMinRatio sets the "adaptiveMinRatio" field.
+func (_u *StrategyUpdateOne) SetAdaptiveMinRatio(v decimal.Decimal) *StrategyUpdateOne {
+	_u.mutation.SetAdaptiveMinRatio(v)
+	return _u
+}
+
+// SetNillableAdaptiveMinRatio sets the "adaptiveMinRatio" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableAdaptiveMinRatio(v *decimal.Decimal) *StrategyUpdateOne {
 	if v != nil {
-		_u.SetMartinFactor(*v)
+		_u.SetAdaptiveMinRatio(*v)
 	}
 	return _u
 }
 
-// AddMartinFactor adds value to the "martinFactor" field.
-func (_u *StrategyUpdateOne) AddMartinFactor(v float64) *StrategyUpdateOne {
-	_u.mutation.AddMartinFactor(v)
+// ClearAdaptiveMinRatio clears the value of the "adaptiveMinRatio" field.
+func (_u *StrategyUpdateOne) ClearAdaptiveMinRatio() *StrategyUpdateOne {
+	_u.mutation.ClearAdaptiveMinRatio()
 	return _u
 }
 
-// SetMaxGridLimit sets the "maxGridLimit" field.
-func (_u *StrategyUpdateOne) SetMaxGridLimit(v int) *StrategyUpdateOne {
-	_u.mutation.ResetMaxGridLimit()
-	_u.mutation.SetMaxGridLimit(v)
+// SetAdaptiveMaxRatio sets the "adaptiveMaxRatio" field.
+func (_u *StrategyUpdateOne) SetAdaptiveMaxRatio(v decimal.Decimal) *StrategyUpdateOne {
+	_u.mutation.SetAdaptiveMaxRatio(v)
 	return _u
 }
 
-// SetNillableMaxGridLimit sets the "maxGridLimit" field if the given value is not nil.
-func (_u *StrategyUpdateOne) SetNillableMaxGridLimit(v *int) *StrategyUpdateOne {
+// SetNillableAdaptiveMaxRatio sets the "adaptiveMaxRatio" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableAdaptiveMaxRatio(v *decimal.Decimal) *StrategyUpdateOne {
 	if v != nil {
-		_u.SetMaxGridLimit(*v)
+		_u.SetAdaptiveMaxRatio(*v)
 	}
 	return _u
 }
 
-// AddMaxGridLimit adds value to the "maxGridLimit" field.
-func (_u *StrategyUpdateOne) AddMaxGridLimit(v int) *StrategyUpdateOne {
-	_u.mutation.AddMaxGridLimit(v)
+// ClearAdaptiveMaxRatio clears the value of the "adaptiveMaxRatio" field.
+func (_u *StrategyUpdateOne) ClearAdaptiveMaxRatio() *StrategyUpdateOne {
+	_u.mutation.ClearAdaptiveMaxRatio()
 	return _u
 }
 
-// ClearMaxGridLimit clears the value of the "maxGridLimit" field.
-func (_u *StrategyUpdateOne) ClearMaxGridLimit() *StrategyUpdateOne {
-	_u.mutation.ClearMaxGridLimit()
+// SetAdaptiveVolatility sets the "adaptiveVolatility" field.
+func (_u *StrategyUpdateOne) SetAdaptiveVolatility(v decimal.Decimal) *StrategyUpdateOne {
+	_u.mutation.SetAdaptiveVolatility(v)
 	return _u
 }
 
-// SetTakeProfitRatio sets the "takeProfitRatio" field.
-func (_u *StrategyUpdateOne) SetTakeProfitRatio(v decimal.Decimal) *StrategyUpdateOne {
-	_u.mutation.SetTakeProfitRatio(v)
+// SetNillableAdaptiveVolatility sets the "adaptiveVolatility" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableAdaptiveVolatility(v *decimal.Decimal) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetAdaptiveVolatility(*v)
+	}
 	return _u
 }
 
-// SetNillableTakeProfitRatio sets the "takeProfitRatio" field if the given value is not nil.
-func (_u *StrategyUpdateOne) SetNillableTakeProfitRatio(v *decimal.Decimal) *StrategyUpdateOne {
+// ClearAdaptiveVolatility clears the value of the "adaptiveVolatility" field.
+func (_u *StrategyUpdateOne) ClearAdaptiveVolatility() *StrategyUpdateOne {
+	_u.mutation.ClearAdaptiveVolatility()
+	return _u
+}
+
+// SetAdaptiveUpdateTime sets the "adaptiveUpdateTime" field.
+func (_u *StrategyUpdateOne) SetAdaptiveUpdateTime(v time.Time) *StrategyUpdateOne {
+	_u.mutation.SetAdaptiveUpdateTime(v)
+	return _u
+}
+
+// SetNillableAdaptiveUpdateTime sets the "adaptiveUpdateTime" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableAdaptiveUpdateTime(v *time.Time) *StrategyUpdateOne {
 	if v != nil {
-		_u.SetTakeProfitRatio(*v)
+		_u.SetAdaptiveUpdateTime(*v)
 	}
 	return _u
 }
 
+// ClearAdaptiveUpdateTime clears the value of the "adaptiveUpdateTime" field.
+func (_u *StrategyUpdateOne) ClearAdaptiveUpdateTime() *StrategyUpdateOne {
+	_u.mutation.ClearAdaptiveUpdateTime()
+	return _u
+}
+
 // SetUpperPriceBound sets the "upperPriceBound" field.
 func (_u *StrategyUpdateOne) SetUpperPriceBound(v decimal.Decimal) *StrategyUpdateOne {
 	_u.mutation.SetUpperPriceBound(v)
@@ -1165,6 +2046,73 @@ func (_u *StrategyUpdateOne) ClearDynamicStopLoss() *StrategyUpdateOne {
 	return _u
 }
 
+// SetTrailingUp sets the "trailingUp" field.
+func (_u *StrategyUpdateOne) SetTrailingUp(v bool) *StrategyUpdateOne {
+	_u.mutation.SetTrailingUp(v)
+	return _u
+}
+
+// SetNillableTrailingUp sets the "trailingUp" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableTrailingUp(v *bool) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetTrailingUp(*v)
+	}
+	return _u
+}
+
+// ClearTrailingUp clears the value of the "trailingUp" field.
+func (_u *StrategyUpdateOne) ClearTrailingUp() *StrategyUpdateOne {
+	_u.mutation.ClearTrailingUp()
+	return _u
+}
+
+// SetTrailingDown sets the "trailingDown" field.
+func (_u *StrategyUpdateOne) SetTrailingDown(v bool) *StrategyUpdateOne {
+	_u.mutation.SetTrailingDown(v)
+	return _u
+}
+
+// SetNillableTrailingDown sets the "trailingDown" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableTrailingDown(v *bool) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetTrailingDown(*v)
+	}
+	return _u
+}
+
+// ClearTrailingDown clears the value of the "trailingDown" field.
+func (_u *StrategyUpdateOne) ClearTrailingDown() *StrategyUpdateOne {
+	_u.mutation.ClearTrailingDown()
+	return _u
+}
+
+// SetTrailingDwellMinutes sets the "trailingDwellMinutes" field.
+func (_u *StrategyUpdateOne) SetTrailingDwellMinutes(v int) *StrategyUpdateOne {
+	_u.mutation.ResetTrailingDwellMinutes()
+	_u.mutation.SetTrailingDwellMinutes(v)
+	return _u
+}
+
+// SetNillableTrailingDwellMinutes sets the "trailingDwellMinutes" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableTrailingDwellMinutes(v *int) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetTrailingDwellMinutes(*v)
+	}
+	return _u
+}
+
+// AddTrailingDwellMinutes adds value to the "trailingDwellMinutes" field.
+func (_u *StrategyUpdateOne) AddTrailingDwellMinutes(v int) *StrategyUpdateOne {
+	_u.mutation.AddTrailingDwellMinutes(v)
+	return _u
+}
+
+// ClearTrailingDwellMinutes clears the value of the "trailingDwellMinutes" field.
+func (_u *StrategyUpdateOne) ClearTrailingDwellMinutes() *StrategyUpdateOne {
+	_u.mutation.ClearTrailingDwellMinutes()
+	return _u
+}
+
 // SetDropOn sets the "dropOn" field.
 func (_u *StrategyUpdateOne) SetDropOn(v bool) *StrategyUpdateOne {
 	_u.mutation.SetDropOn(v)
@@ -1232,6 +2180,87 @@ func (_u *StrategyUpdateOne) ClearDropThreshold() *StrategyUpdateOne {
 	return _u
 }
 
+// SetRsiThreshold sets the "rsiThreshold" field.
+func (_u *StrategyUpdateOne) SetRsiThreshold(v decimal.Decimal) *StrategyUpdateOne {
+	_u.mutation.SetRsiThreshold(v)
+	return _u
+}
+
+// SetNillableRsiThreshold sets the "rsiThreshold" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableRsiThreshold(v *decimal.Decimal) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetRsiThreshold(*v)
+	}
+	return _u
+}
+
+// ClearRsiThreshold clears the value of the "rsiThreshold" field.
+func (_u *StrategyUpdateOne) ClearRsiThreshold() *StrategyUpdateOne {
+	_u.mutation.ClearRsiThreshold()
+	return _u
+}
+
+// SetEmaFilter sets the "emaFilter" field.
+func (_u *StrategyUpdateOne) SetEmaFilter(v strategy.EmaFilter) *StrategyUpdateOne {
+	_u.mutation.SetEmaFilter(v)
+	return _u
+}
+
+// SetNillableEmaFilter sets the "emaFilter" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableEmaFilter(v *strategy.EmaFilter) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetEmaFilter(*v)
+	}
+	return _u
+}
+
+// SetEmaPeriod sets the "emaPeriod" field.
+func (_u *StrategyUpdateOne) SetEmaPeriod(v int) *StrategyUpdateOne {
+	_u.mutation.ResetEmaPeriod()
+	_u.mutation.SetEmaPeriod(v)
+	return _u
+}
+
+// SetNillableEmaPeriod sets the "emaPeriod" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableEmaPeriod(v *int) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetEmaPeriod(*v)
+	}
+	return _u
+}
+
+// AddEmaPeriod adds value to the "emaPeriod" field.
+func (_u *StrategyUpdateOne) AddEmaPeriod(v int) *StrategyUpdateOne {
+	_u.mutation.AddEmaPeriod(v)
+	return _u
+}
+
+// ClearEmaPeriod clears the value of the "emaPeriod" field.
+func (_u *StrategyUpdateOne) ClearEmaPeriod() *StrategyUpdateOne {
+	_u.mutation.ClearEmaPeriod()
+	return _u
+}
+
+// SetMacdFilter sets the "macdFilter" field.
+func (_u *StrategyUpdateOne) SetMacdFilter(v bool) *StrategyUpdateOne {
+	_u.mutation.SetMacdFilter(v)
+	return _u
+}
+
+// SetNillableMacdFilter sets the "macdFilter" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableMacdFilter(v *bool) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetMacdFilter(*v)
+	}
+	return _u
+}
+
+// ClearMacdFilter clears the value of the "macdFilter" field.
+func (_u *StrategyUpdateOne) ClearMacdFilter() *StrategyUpdateOne {
+	_u.mutation.ClearMacdFilter()
+	return _u
+}
+
 // SetEnableAutoBuy sets the "enableAutoBuy" field.
 func (_u *StrategyUpdateOne) SetEnableAutoBuy(v bool) *StrategyUpdateOne {
 	_u.mutation.SetEnableAutoBuy(v)
@@ -1288,6 +2317,26 @@ func (_u *StrategyUpdateOne) SetNillableEnablePushNotification(v *bool) *Strateg
 	return _u
 }
 
+// SetPaperTrading sets the "paperTrading" field.
+func (_u *StrategyUpdateOne) SetPaperTrading(v bool) *StrategyUpdateOne {
+	_u.mutation.SetPaperTrading(v)
+	return _u
+}
+
+// SetNillablePaperTrading sets the "paperTrading" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillablePaperTrading(v *bool) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetPaperTrading(*v)
+	}
+	return _u
+}
+
+// ClearPaperTrading clears the value of the "paperTrading" field.
+func (_u *StrategyUpdateOne) ClearPaperTrading() *StrategyUpdateOne {
+	_u.mutation.ClearPaperTrading()
+	return _u
+}
+
 // SetStatus sets the "status" field.
 func (_u *StrategyUpdateOne) SetStatus(v strategy.Status) *StrategyUpdateOne {
 	_u.mutation.SetStatus(v)
@@ -1322,6 +2371,26 @@ func (_u *StrategyUpdateOne) ClearGridTrend() *StrategyUpdateOne {
 	return _u
 }
 
+// SetBuyFilterReason sets the "buyFilterReason" field.
+func (_u *StrategyUpdateOne) SetBuyFilterReason(v string) *StrategyUpdateOne {
+	_u.mutation.SetBuyFilterReason(v)
+	return _u
+}
+
+// SetNillableBuyFilterReason sets the "buyFilterReason" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableBuyFilterReason(v *string) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetBuyFilterReason(*v)
+	}
+	return _u
+}
+
+// ClearBuyFilterReason clears the value of the "buyFilterReason" field.
+func (_u *StrategyUpdateOne) ClearBuyFilterReason() *StrategyUpdateOne {
+	_u.mutation.ClearBuyFilterReason()
+	return _u
+}
+
 // SetLastLowerThresholdAlertTime sets the "lastLowerThresholdAlertTime" field.
 func (_u *StrategyUpdateOne) SetLastLowerThresholdAlertTime(v time.Time) *StrategyUpdateOne {
 	_u.mutation.SetLastLowerThresholdAlertTime(v)
@@ -1362,6 +2431,26 @@ func (_u *StrategyUpdateOne) ClearLastUpperThresholdAlertTime() *StrategyUpdateO
 	return _u
 }
 
+// SetOutOfRangeTime sets the "outOfRangeTime" field.
+func (_u *StrategyUpdateOne) SetOutOfRangeTime(v time.Time) *StrategyUpdateOne {
+	_u.mutation.SetOutOfRangeTime(v)
+	return _u
+}
+
+// SetNillableOutOfRangeTime sets the "outOfRangeTime" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableOutOfRangeTime(v *time.Time) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetOutOfRangeTime(*v)
+	}
+	return _u
+}
+
+// ClearOutOfRangeTime clears the value of the "outOfRangeTime" field.
+func (_u *StrategyUpdateOne) ClearOutOfRangeTime() *StrategyUpdateOne {
+	_u.mutation.ClearOutOfRangeTime()
+	return _u
+}
+
 // Mutation returns the StrategyMutation object of the builder.
 func (_u *StrategyUpdateOne) Mutation() *StrategyMutation {
 	return _u.mutation
@@ -1443,11 +2532,36 @@ func (_u *StrategyUpdateOne) check() error {
 			return &ValidationError{Name: "maxGridLimit", err: fmt.Errorf(`ent: validator failed for field "Strategy.maxGridLimit": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.GridMode(); ok {
+		if err := strategy.GridModeValidator(v); err != nil {
+			return &ValidationError{Name: "gridMode", err: fmt.Errorf(`ent: validator failed for field "Strategy.gridMode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GridType(); ok {
+		if err := strategy.GridTypeValidator(v); err != nil {
+			return &ValidationError{Name: "gridType", err: fmt.Errorf(`ent: validator failed for field "Strategy.gridType": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GridCount(); ok {
+		if err := strategy.GridCountValidator(v); err != nil {
+			return &ValidationError{Name: "gridCount", err: fmt.Errorf(`ent: validator failed for field "Strategy.gridCount": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EmaFilter(); ok {
+		if err := strategy.EmaFilterValidator(v); err != nil {
+			return &ValidationError{Name: "emaFilter", err: fmt.Errorf(`ent: validator failed for field "Strategy.emaFilter": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Status(); ok {
 		if err := strategy.StatusValidator(v); err != nil {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Strategy.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.BuyFilterReason(); ok {
+		if err := strategy.BuyFilterReasonValidator(v); err != nil {
+			return &ValidationError{Name: "buyFilterReason", err: fmt.Errorf(`ent: validator failed for field "Strategy.buyFilterReason": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1513,9 +2627,84 @@ func (_u *StrategyUpdateOne) sqlSave(ctx context.Context) (_node *Strategy, err
 	if _u.mutation.MaxGridLimitCleared() {
 		_spec.ClearField(strategy.FieldMaxGridLimit, field.TypeInt)
 	}
+	if value, ok := _u.mutation.GridMode(); ok {
+		_spec.SetField(strategy.FieldGridMode, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.InventorySeeded(); ok {
+		_spec.SetField(strategy.FieldInventorySeeded, field.TypeBool, value)
+	}
+	if _u.mutation.InventorySeededCleared() {
+		_spec.ClearField(strategy.FieldInventorySeeded, field.TypeBool)
+	}
+	if value, ok := _u.mutation.GridType(); ok {
+		_spec.SetField(strategy.FieldGridType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.GridCount(); ok {
+		_spec.SetField(strategy.FieldGridCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGridCount(); ok {
+		_spec.AddField(strategy.FieldGridCount, field.TypeInt, value)
+	}
+	if _u.mutation.GridCountCleared() {
+		_spec.ClearField(strategy.FieldGridCount, field.TypeInt)
+	}
+	if value, ok := _u.mutation.PriceStep(); ok {
+		_spec.SetField(strategy.FieldPriceStep, field.TypeString, value)
+	}
+	if _u.mutation.PriceStepCleared() {
+		_spec.ClearField(strategy.FieldPriceStep, field.TypeString)
+	}
+	if value, ok := _u.mutation.GridLadder(); ok {
+		_spec.SetField(strategy.FieldGridLadder, field.TypeString, value)
+	}
+	if _u.mutation.GridLadderCleared() {
+		_spec.ClearField(strategy.FieldGridLadder, field.TypeString)
+	}
 	if value, ok := _u.mutation.TakeProfitRatio(); ok {
 		_spec.SetField(strategy.FieldTakeProfitRatio, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.TrailingTakeProfit(); ok {
+		_spec.SetField(strategy.FieldTrailingTakeProfit, field.TypeString, value)
+	}
+	if _u.mutation.TrailingTakeProfitCleared() {
+		_spec.ClearField(strategy.FieldTrailingTakeProfit, field.TypeString)
+	}
+	if value, ok := _u.mutation.TakeProfitPortion(); ok {
+		_spec.SetField(strategy.FieldTakeProfitPortion, field.TypeString, value)
+	}
+	if _u.mutation.TakeProfitPortionCleared() {
+		_spec.ClearField(strategy.FieldTakeProfitPortion, field.TypeString)
+	}
+	if value, ok := _u.mutation.AdaptiveSpacing(); ok {
+		_spec.SetField(strategy.FieldAdaptiveSpacing, field.TypeBool, value)
+	}
+	if _u.mutation.AdaptiveSpacingCleared() {
+		_spec.ClearField(strategy.FieldAdaptiveSpacing, field.TypeBool)
+	}
+	if value, ok := _u.mutation.AdaptiveMinRatio(); ok {
+		_spec.SetField(strategy.FieldAdaptiveMinRatio, field.TypeString, value)
+	}
+	if _u.mutation.AdaptiveMinRatioCleared() {
+		_spec.ClearField(strategy.FieldAdaptiveMinRatio, field.TypeString)
+	}
+	if value, ok := _u.mutation.AdaptiveMaxRatio(); ok {
+		_spec.SetField(strategy.FieldAdaptiveMaxRatio, field.TypeString, value)
+	}
+	if _u.mutation.AdaptiveMaxRatioCleared() {
+		_spec.ClearField(strategy.FieldAdaptiveMaxRatio, field.TypeString)
+	}
+	if value, ok := _u.mutation.AdaptiveVolatility(); ok {
+		_spec.SetField(strategy.FieldAdaptiveVolatility, field.TypeString, value)
+	}
+	if _u.mutation.AdaptiveVolatilityCleared() {
+		_spec.ClearField(strategy.FieldAdaptiveVolatility, field.TypeString)
+	}
+	if value, ok := _u.mutation.AdaptiveUpdateTime(); ok {
+		_spec.SetField(strategy.FieldAdaptiveUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.AdaptiveUpdateTimeCleared() {
+		_spec.ClearField(strategy.FieldAdaptiveUpdateTime, field.TypeTime)
+	}
 	if value, ok := _u.mutation.UpperPriceBound(); ok {
 		_spec.SetField(strategy.FieldUpperPriceBound, field.TypeString, value)
 	}
@@ -1576,6 +2765,27 @@ func (_u *StrategyUpdateOne) sqlSave(ctx context.Context) (_node *Strategy, err
 	if _u.mutation.DynamicStopLossCleared() {
 		_spec.ClearField(strategy.FieldDynamicStopLoss, field.TypeBool)
 	}
+	if value, ok := _u.mutation.TrailingUp(); ok {
+		_spec.SetField(strategy.FieldTrailingUp, field.TypeBool, value)
+	}
+	if _u.mutation.TrailingUpCleared() {
+		_spec.ClearField(strategy.FieldTrailingUp, field.TypeBool)
+	}
+	if value, ok := _u.mutation.TrailingDown(); ok {
+		_spec.SetField(strategy.FieldTrailingDown, field.TypeBool, value)
+	}
+	if _u.mutation.TrailingDownCleared() {
+		_spec.ClearField(strategy.FieldTrailingDown, field.TypeBool)
+	}
+	if value, ok := _u.mutation.TrailingDwellMinutes(); ok {
+		_spec.SetField(strategy.FieldTrailingDwellMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTrailingDwellMinutes(); ok {
+		_spec.AddField(strategy.FieldTrailingDwellMinutes, field.TypeInt, value)
+	}
+	if _u.mutation.TrailingDwellMinutesCleared() {
+		_spec.ClearField(strategy.FieldTrailingDwellMinutes, field.TypeInt)
+	}
 	if value, ok := _u.mutation.DropOn(); ok {
 		_spec.SetField(strategy.FieldDropOn, field.TypeBool, value)
 	}
@@ -1597,6 +2807,30 @@ func (_u *StrategyUpdateOne) sqlSave(ctx context.Context) (_node *Strategy, err
 	if _u.mutation.DropThresholdCleared() {
 		_spec.ClearField(strategy.FieldDropThreshold, field.TypeString)
 	}
+	if value, ok := _u.mutation.RsiThreshold(); ok {
+		_spec.SetField(strategy.FieldRsiThreshold, field.TypeString, value)
+	}
+	if _u.mutation.RsiThresholdCleared() {
+		_spec.ClearField(strategy.FieldRsiThreshold, field.TypeString)
+	}
+	if value, ok := _u.mutation.EmaFilter(); ok {
+		_spec.SetField(strategy.FieldEmaFilter, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.EmaPeriod(); ok {
+		_spec.SetField(strategy.FieldEmaPeriod, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEmaPeriod(); ok {
+		_spec.AddField(strategy.FieldEmaPeriod, field.TypeInt, value)
+	}
+	if _u.mutation.EmaPeriodCleared() {
+		_spec.ClearField(strategy.FieldEmaPeriod, field.TypeInt)
+	}
+	if value, ok := _u.mutation.MacdFilter(); ok {
+		_spec.SetField(strategy.FieldMacdFilter, field.TypeBool, value)
+	}
+	if _u.mutation.MacdFilterCleared() {
+		_spec.ClearField(strategy.FieldMacdFilter, field.TypeBool)
+	}
 	if value, ok := _u.mutation.EnableAutoBuy(); ok {
 		_spec.SetField(strategy.FieldEnableAutoBuy, field.TypeBool, value)
 	}
@@ -1609,6 +2843,12 @@ func (_u *StrategyUpdateOne) sqlSave(ctx context.Context) (_node *Strategy, err
 	if value, ok := _u.mutation.EnablePushNotification(); ok {
 		_spec.SetField(strategy.FieldEnablePushNotification, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.PaperTrading(); ok {
+		_spec.SetField(strategy.FieldPaperTrading, field.TypeBool, value)
+	}
+	if _u.mutation.PaperTradingCleared() {
+		_spec.ClearField(strategy.FieldPaperTrading, field.TypeBool)
+	}
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(strategy.FieldStatus, field.TypeEnum, value)
 	}
@@ -1618,6 +2858,12 @@ func (_u *StrategyUpdateOne) sqlSave(ctx context.Context) (_node *Strategy, err
 	if _u.mutation.GridTrendCleared() {
 		_spec.ClearField(strategy.FieldGridTrend, field.TypeString)
 	}
+	if value, ok := _u.mutation.BuyFilterReason(); ok {
+		_spec.SetField(strategy.FieldBuyFilterReason, field.TypeString, value)
+	}
+	if _u.mutation.BuyFilterReasonCleared() {
+		_spec.ClearField(strategy.FieldBuyFilterReason, field.TypeString)
+	}
 	if value, ok := _u.mutation.LastLowerThresholdAlertTime(); ok {
 		_spec.SetField(strategy.FieldLastLowerThresholdAlertTime, field.TypeTime, value)
 	}
@@ -1630,6 +2876,12 @@ func (_u *StrategyUpdateOne) sqlSave(ctx context.Context) (_node *Strategy, err
 	if _u.mutation.LastUpperThresholdAlertTimeCleared() {
 		_spec.ClearField(strategy.FieldLastUpperThresholdAlertTime, field.TypeTime)
 	}
+	if value, ok := _u.mutation.OutOfRangeTime(); ok {
+		_spec.SetField(strategy.FieldOutOfRangeTime, field.TypeTime, value)
+	}
+	if _u.mutation.OutOfRangeTimeCleared() {
+		_spec.ClearField(strategy.FieldOutOfRangeTime, field.TypeTime)
+	}
 	_node = &Strategy{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -21,6 +21,24 @@ type NonceManager struct {
 
 type NonceConsumeFunc func(ctx context.Context, nonce uint64) (hash string, err error)
 
+// 账户nonce状态
+type NonceState struct {
+	Latest  uint64  // 已打包的下一个nonce
+	Pending uint64  // 交易池中的下一个nonce
+	Stored  *uint64 // 本地最后使用的nonce
+}
+
+// 本地已使用但交易池中缺失的nonce
+func (s NonceState) Gap() (uint64, bool) {
+	if s.Stored == nil || *s.Stored < s.Pending {
+		return 0, false
+	}
+	return s.Pending, true
+}
+
+// 恢复nonce缺口, 返回 true 时重置本地nonce
+type NonceRecoverFunc func(ctx context.Context, state NonceState) (resetNonce bool, err error)
+
 func NewNonceManager(dbClient *ent.Client, ethClient *ethclient.Client) *NonceManager {
 	return &NonceManager{
 		dbClient:  dbClient,
@@ -29,7 +47,7 @@ func NewNonceManager(dbClient *ent.Client, ethClient *ethclient.Client) *NonceMa
 	}
 }
 
-func (m *NonceManager) Request(ctx context.Context, account common.Address, consume NonceConsumeFunc) error {
+func (m *NonceManager) lockAccount(account common.Address) *sync.Mutex {
 	m.mutex.Lock()
 	userMutex, ok := m.userLocks[account.Hex()]
 	if !ok {
@@ -39,6 +57,11 @@ func (m *NonceManager) Request(ctx context.Context, account common.Address, cons
 	m.mutex.Unlock()
 
 	userMutex.Lock()
+	return userMutex
+}
+
+func (m *NonceManager) Request(ctx context.Context, account common.Address, consume NonceConsumeFunc) error {
+	userMutex := m.lockAccount(account)
 	defer userMutex.Unlock()
 
 	nextNonce, err := m.ethClient.PendingNonceAt(ctx, account)
@@ -56,8 +79,9 @@ func (m *NonceManager) Request(ctx context.Context, account common.Address, cons
 		nextNonce = storedNonce.Nonce + 1
 	}
 
-	_, err = consume(ctx, nextNonce)
-	if err == nil {
+	// 返回交易哈希时交易已签名保存, 即使广播失败也占用该 nonce, 由订单对账处理
+	hash, err := consume(ctx, nextNonce)
+	if err == nil || hash != "" {
 		var err2 error
 		if ent.IsNotFound(findErr) {
 			err2 = nonceModel.Save(ctx, account.Hex(), nextNonce)
@@ -72,3 +96,63 @@ func (m *NonceManager) Request(ctx context.Context, account common.Address, cons
 
 	return err
 }
+
+func (m *NonceManager) State(ctx context.Context, account common.Address) (NonceState, error) {
+	var state NonceState
+	nonceModel := model.NewNonceModel(m.dbClient.Nonce)
+	storedNonce, err := nonceModel.FindOne(ctx, account.Hex())
+	if err != nil && !ent.IsNotFound(err) {
+		return state, err
+	}
+	if err == nil {
+		state.Stored = &storedNonce.Nonce
+	}
+
+	state.Latest, err = m.ethClient.NonceAt(ctx, account, nil)
+	if err != nil {
+		return state, err
+	}
+
+	state.Pending, err = m.ethClient.PendingNonceAt(ctx, account)
+	if err != nil {
+		return state, err
+	}
+	return state, nil
+}
+
+// 持有账户锁重新检查缺口, 缺口未变化时执行恢复
+func (m *NonceManager) Recover(ctx context.Context, account common.Address, gapNonce uint64, recover NonceRecoverFunc) (bool, error) {
+	userMutex := m.lockAccount(account)
+	defer userMutex.Unlock()
+
+	state, err := m.State(ctx, account)
+	if err != nil {
+		return false, err
+	}
+	if nonce, ok := state.Gap(); !ok || nonce != gapNonce {
+		return false, nil
+	}
+
+	resetNonce, err := recover(ctx, state)
+	if err != nil {
+		return false, err
+	}
+	if !resetNonce {
+		return true, nil
+	}
+
+	// 下次请求从交易池中的下一个nonce开始
+	nonceModel := model.NewNonceModel(m.dbClient.Nonce)
+	if state.Pending == 0 {
+		err = nonceModel.Delete(ctx, account.Hex())
+	} else {
+		err = nonceModel.UpdateNonce(ctx, account.Hex(), state.Pending-1)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	logger.Infof("[NonceManager] 重置账户nonce, account: %s, stored: %d, latest: %d, pending: %d",
+		account, *state.Stored, state.Latest, state.Pending)
+	return true, nil
+}
@@ -0,0 +1,228 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/fachebot/evm-grid-bot/internal/ent"
+	"github.com/fachebot/evm-grid-bot/internal/eth"
+	"github.com/fachebot/evm-grid-bot/internal/logger"
+	"github.com/fachebot/evm-grid-bot/internal/model"
+	"github.com/fachebot/evm-grid-bot/internal/svc"
+	"github.com/fachebot/evm-grid-bot/internal/utils"
+	"github.com/fachebot/evm-grid-bot/internal/utils/evm"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/samber/lo"
+)
+
+const (
+	nonceGapTimeout      = time.Minute
+	nonceReconcilePeriod = time.Second * 30
+)
+
+type nonceGap struct {
+	nonce uint64
+	since time.Time
+}
+
+// 检查账户nonce缺口, 防止一笔丢失的交易阻塞后续所有交易
+type NonceReconciler struct {
+	ctx      context.Context
+	cancel   context.CancelFunc
+	stopChan chan struct{}
+	svcCtx   *svc.ServiceContext
+	gaps     map[string]nonceGap
+}
+
+func NewNonceReconciler(svcCtx *svc.ServiceContext) *NonceReconciler {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &NonceReconciler{
+		ctx:    ctx,
+		cancel: cancel,
+		svcCtx: svcCtx,
+		gaps:   make(map[string]nonceGap),
+	}
+}
+
+func (r *NonceReconciler) Stop() {
+	if r.stopChan == nil {
+		return
+	}
+
+	logger.Infof("[NonceReconciler] 准备停止服务")
+
+	r.cancel()
+
+	<-r.stopChan
+	close(r.stopChan)
+	r.stopChan = nil
+
+	logger.Infof("[NonceReconciler] 服务已经停止")
+}
+
+func (r *NonceReconciler) Start() {
+	if r.stopChan != nil {
+		return
+	}
+
+	r.stopChan = make(chan struct{})
+	logger.Infof("[NonceReconciler] 开始运行服务")
+	go r.run()
+}
+
+func (r *NonceReconciler) run() {
+	timer := time.NewTimer(nonceReconcilePeriod)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			r.handlePolling()
+			timer.Reset(nonceReconcilePeriod)
+		case <-r.ctx.Done():
+			r.stopChan <- struct{}{}
+			return
+		}
+	}
+}
+
+func (r *NonceReconciler) handlePolling() {
+	nonces, err := model.NewNonceModel(r.svcCtx.DbClient.Nonce).FindAll(r.ctx)
+	if err != nil {
+		logger.Errorf("[NonceReconciler] 获取账户nonce列表失败, %v", err)
+		return
+	}
+
+	now := time.Now()
+	for _, item := range nonces {
+		account := common.HexToAddress(item.Account)
+		state, err := r.svcCtx.NonceManager.State(r.ctx, account)
+		if err != nil {
+			logger.Errorf("[NonceReconciler] 查询账户nonce状态失败, account: %s, %v", item.Account, err)
+			continue
+		}
+
+		// 缺口需要持续一段时间, 避免误判刚广播的交易
+		gapNonce, ok := state.Gap()
+		if !ok {
+			delete(r.gaps, item.Account)
+			continue
+		}
+		gap, ok := r.gaps[item.Account]
+		if !ok || gap.nonce != gapNonce {
+			r.gaps[item.Account] = nonceGap{nonce: gapNonce, since: now}
+			continue
+		}
+		if now.Sub(gap.since) < nonceGapTimeout {
+			continue
+		}
+
+		var action string
+		recovered, err := r.svcCtx.NonceManager.Recover(r.ctx, account, gapNonce, func(ctx context.Context, state eth.NonceState) (bool, error) {
+			result, resetNonce, err := r.recoverGap(ctx, account, state)
+			action = result
+			return resetNonce, err
+		})
+		if err != nil {
+			logger.Errorf("[NonceReconciler] 恢复nonce缺口失败, account: %s, nonce: %d, %v", item.Account, gapNonce, err)
+			continue
+		}
+
+		delete(r.gaps, item.Account)
+		if recovered {
+			logger.Infof("[NonceReconciler] 恢复nonce缺口, account: %s, nonce: %d, latest: %d, pending: %d, stored: %d, action: %s",
+				item.Account, gapNonce, state.Latest, state.Pending, lo.FromPtr(state.Stored), action)
+			r.sendNotification(item.Account, gapNonce, action)
+		}
+	}
+}
+
+// 没有在途订单时重置本地nonce, 否则一次填补所有缺失的nonce, 有订单的重新广播订单交易, 其余发送空交易
+func (r *NonceReconciler) recoverGap(ctx context.Context, account common.Address, state eth.NonceState) (string, bool, error) {
+	orders, err := r.svcCtx.OrderModel.FindActiveOrdersByAccount(ctx, account.Hex())
+	if err != nil {
+		return "", false, err
+	}
+
+	inflight := lo.Filter(orders, func(item *ent.Order, _ int) bool {
+		return item.Nonce >= state.Pending
+	})
+	if len(inflight) == 0 {
+		return "重置本地nonce", true, nil
+	}
+
+	rawTxs := make(map[uint64]string)
+	for _, item := range inflight {
+		if item.RawTx != nil {
+			rawTxs[item.Nonce] = *item.RawTx
+		}
+	}
+
+	var rebroadcasted, filled int
+	for nonce := state.Pending; nonce <= *state.Stored; nonce++ {
+		if rawTx, ok := rawTxs[nonce]; ok {
+			if err = rebroadcastRawTx(ctx, r.svcCtx, rawTx); err != nil {
+				return "", false, err
+			}
+			rebroadcasted++
+			continue
+		}
+
+		if err = r.sendNoopTransaction(ctx, account, nonce); err != nil {
+			return "", false, err
+		}
+		filled++
+	}
+	return fmt.Sprintf("重新广播订单交易 %d 笔, 发送空交易填补 %d 笔", rebroadcasted, filled), false, nil
+}
+
+// 发送给自己的零值转账
+func (r *NonceReconciler) sendNoopTransaction(ctx context.Context, account common.Address, nonce uint64) error {
+	prv, err := getPrivateKey(ctx, r.svcCtx, account.Hex())
+	if err != nil {
+		return err
+	}
+
+	gasTipCap, gasFeeCap, err := evm.SuggestGasFees(ctx, r.svcCtx.EthClient)
+	if err != nil {
+		return err
+	}
+
+	chainId := big.NewInt(int64(r.svcCtx.Config.Chain.Id))
+	dynamicFeeTx := ethtypes.DynamicFeeTx{
+		ChainID:   chainId,
+		Nonce:     nonce,
+		GasTipCap: gasTipCap,
+		GasFeeCap: gasFeeCap,
+		Gas:       cancelTxGas,
+		To:        &account,
+		Value:     big.NewInt(0),
+	}
+	signedTx, err := ethtypes.SignTx(ethtypes.NewTx(&dynamicFeeTx), ethtypes.NewLondonSigner(chainId), prv)
+	if err != nil {
+		return err
+	}
+
+	return r.svcCtx.EthClient.SendTransaction(ctx, signedTx)
+}
+
+func (r *NonceReconciler) sendNotification(account string, nonce uint64, action string) {
+	w, err := r.svcCtx.WalletModel.FindByAccount(r.ctx, account)
+	if err != nil {
+		logger.Errorf("[NonceReconciler] 查询钱包信息失败, account: %s, %v", account, err)
+		return
+	}
+	if w.UserId == 0 {
+		return
+	}
+
+	text := fmt.Sprintf("🩹 检测到钱包交易 nonce 缺口, 已自动恢复!\n\n`%s`\n\n🔢 缺失 nonce: %d\n🛠 处理方式: %s", account, nonce, action)
+	_, err = utils.SendMessage(r.svcCtx.BotApi, w.UserId, text)
+	if err != nil {
+		logger.Warnf("[NonceReconciler] 发送电报通知失败, userId: %d, text: %s, %v", w.UserId, text, err)
+	}
+}
@@ -2,9 +2,9 @@ package job
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
-	"strings"
 	"time"
 
 	"github.com/fachebot/evm-grid-bot/internal/ent"
@@ -18,25 +18,34 @@ import (
 	"github.com/fachebot/evm-grid-bot/internal/utils/evm"
 	"github.com/fachebot/evm-grid-bot/internal/utils/format"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/samber/lo"
 	"github.com/shopspring/decimal"
 )
 
+const (
+	submittingTimeout = time.Minute
+)
+
 type OrderKeeper struct {
-	ctx        context.Context
-	cancel     context.CancelFunc
-	stopChan   chan struct{}
-	svcCtx     *svc.ServiceContext
-	timeoutTxs map[string]struct{}
+	ctx      context.Context
+	cancel   context.CancelFunc
+	stopChan chan struct{}
+	svcCtx   *svc.ServiceContext
+
+	// 上次检查交易状态的区块
+	lastBlock uint64
 }
 
 func NewOrderKeeper(svcCtx *svc.ServiceContext) *OrderKeeper {
 	ctx, cancel := context.WithCancel(context.Background())
 	return &OrderKeeper{
-		ctx:        ctx,
-		cancel:     cancel,
-		svcCtx:     svcCtx,
-		timeoutTxs: map[string]struct{}{},
+		ctx:    ctx,
+		cancel: cancel,
+		svcCtx: svcCtx,
 	}
 }
 
@@ -67,6 +76,9 @@ func (keeper *OrderKeeper) Start() {
 }
 
 func (keeper *OrderKeeper) run() {
+	// 启动时根据链上状态恢复未完成订单
+	keeper.recoverOrders()
+
 	timer := time.NewTimer(0)
 	defer timer.Stop()
 
@@ -116,6 +128,9 @@ func (keeper *OrderKeeper) sendNotification(ord *ent.Order, text string, force b
 }
 
 func (keeper *OrderKeeper) handleRetryExit(ord *ent.Order) {
+	keeper.svcCtx.StrategyLocker.Lock(ord.StrategyId)
+	defer keeper.svcCtx.StrategyLocker.Unlock(ord.StrategyId)
+
 	// 查询策略
 	record, err := keeper.svcCtx.StrategyModel.FindByGUID(keeper.ctx, ord.StrategyId)
 	if err != nil {
@@ -126,25 +141,26 @@ func (keeper *OrderKeeper) handleRetryExit(ord *ent.Order) {
 	keeper.sendNotification(ord, fmt.Sprintf("♻️ 正在尝试重新清仓 *%s* 代币失败", ord.Symbol), true)
 
 	// 卖出代币
-	orderArgs, err := strategy.SellToken(keeper.ctx, keeper.svcCtx, record, "重新清仓", &ord.InAmount, nil, true)
+	orderArgs, err := strategy.SellToken(keeper.ctx, keeper.svcCtx, record, "重新清仓", &ord.InAmount, nil, true, func(orderArgs *ent.Order) {
+		orderArgs.GridBuyCost = ord.GridBuyCost
+	})
 	if err != nil {
 		logger.Errorf("[OrderKeeper] 尝试重新清仓失败, strategy: %s, token: %s, %v", ord.StrategyId, ord.Symbol, err)
 		keeper.sendNotification(ord, fmt.Sprintf("❌ 尝试重新清仓 *%s* 代币失败，请手动清仓", ord.Symbol), true)
 		return
 	}
-	orderArgs.GridBuyCost = ord.GridBuyCost
 
-	// 保存订单记录
-	err = utils.Tx(keeper.ctx, keeper.svcCtx.DbClient, func(tx *ent.Tx) error {
-		_, err = model.NewOrderModel(tx.Order).Save(keeper.ctx, orderArgs)
-		return err
-	})
+	// 更新订单状态
+	err = keeper.svcCtx.OrderModel.SetOrderPendingStatus(keeper.ctx, orderArgs.ID)
 	if err != nil {
-		logger.Errorf("[OrderKeeper] 保存订单记录失败, order: %+v, %v", orderArgs, err)
+		logger.Errorf("[OrderKeeper] 更新订单状态失败, order: %+v, %v", orderArgs, err)
 	}
 }
 
 func (keeper *OrderKeeper) handleCloseOrder(ord *ent.Order, tokenBalanceChanges map[common.Address]*big.Int) {
+	keeper.svcCtx.StrategyLocker.Lock(ord.StrategyId)
+	defer keeper.svcCtx.StrategyLocker.Unlock(ord.StrategyId)
+
 	tokenMeta, err := keeper.svcCtx.TokenMetaCache.GetTokenMeta(keeper.ctx, ord.Token)
 	if err != nil {
 		logger.Errorf("[OrderKeeper] 查询代币元数据失败, token: %s, %v", ord.Token, err)
@@ -199,14 +215,18 @@ func (keeper *OrderKeeper) handleCloseOrder(ord *ent.Order, tokenBalanceChanges
 	}
 
 	// 查询稳定币余额
-	bal, err := evm.GetTokenBalance(keeper.ctx, keeper.svcCtx.EthClient, stablecoinCA.Hex(), ord.Account)
-	if err != nil {
-		logger.Errorf("[OrderKeeper] 查询代币余额失败, token: %s, %v", stablecoinCA, err)
-		return
+	var stablecoinBal decimal.Decimal
+	if !ord.Paper {
+		bal, err := evm.GetTokenBalance(keeper.ctx, keeper.svcCtx.EthClient, stablecoinCA.Hex(), ord.Account)
+		if err != nil {
+			logger.Errorf("[OrderKeeper] 查询代币余额失败, token: %s, %v", stablecoinCA, err)
+			return
+		}
+		stablecoinBal = evm.ParseUnits(bal, keeper.svcCtx.Config.Chain.StablecoinDecimals)
 	}
-	stablecoinBal := evm.ParseUnits(bal, keeper.svcCtx.Config.Chain.StablecoinDecimals)
 
 	// 更新订单状态
+	var profit *decimal.Decimal
 	err = utils.Tx(keeper.ctx, keeper.svcCtx.DbClient, func(tx *ent.Tx) error {
 		if ord.GridId != nil {
 			switch ord.Type {
@@ -217,7 +237,7 @@ func (keeper *OrderKeeper) handleCloseOrder(ord *ent.Order, tokenBalanceChanges
 					return err
 				}
 			case order.TypeSell:
-				_, err := model.NewGridModel(tx.Grid).DeleteByGuid(keeper.ctx, *ord.GridId)
+				err := model.NewGridModel(tx.Grid).SettleSell(keeper.ctx, *ord.GridId, ord.InAmount, cost)
 				if err != nil {
 					return err
 				}
@@ -225,8 +245,8 @@ func (keeper *OrderKeeper) handleCloseOrder(ord *ent.Order, tokenBalanceChanges
 		}
 
 		if !cost.IsZero() {
-			profit := outAmount.Sub(cost)
-			err = model.NewOrderModel(tx.Order).UpdateProfit(keeper.ctx, ord.ID, profit)
+			profit = lo.ToPtr(outAmount.Sub(cost))
+			err = model.NewOrderModel(tx.Order).UpdateProfit(keeper.ctx, ord.ID, *profit)
 			if err != nil {
 				return err
 			}
@@ -254,7 +274,17 @@ func (keeper *OrderKeeper) handleCloseOrder(ord *ent.Order, tokenBalanceChanges
 	logger.Infof("[OrderKeeper] 设置订单 closed 状态, id: %d, type: %s, finalPrice: %s, outAmount: %s, hash: %s",
 		ord.ID, ord.Type, finalPrice, outAmount, ord.TxHash)
 
+	// 累加策略已实现盈利
+	if profit != nil {
+		keeper.svcCtx.StrategyCache.AddRealizedProfit(ord.StrategyId, ord.ID, *profit)
+	}
+
 	// 发送电报通知
+	if ord.Paper {
+		keeper.sendPaperNotification(ord, finalPrice, outAmount)
+		return
+	}
+
 	chainId := keeper.svcCtx.Config.Chain.Id
 	switch ord.Type {
 	case order.TypeBuy:
@@ -298,7 +328,58 @@ func (keeper *OrderKeeper) handleCloseOrder(ord *ent.Order, tokenBalanceChanges
 	}
 }
 
+func (keeper *OrderKeeper) sendPaperNotification(ord *ent.Order, finalPrice, outAmount decimal.Decimal) {
+	chainId := keeper.svcCtx.Config.Chain.Id
+	switch ord.Type {
+	case order.TypeBuy:
+		text := fmt.Sprintf("📝 模拟盘 | 🟢 网格 `#%d` 买入 %sU [%s](%s), 价格: %s",
+			*ord.GridNumber, ord.InAmount.Truncate(2), ord.Symbol, utils.GetGmgnTokenLink(chainId, ord.Token), format.Price(finalPrice, 5))
+		keeper.sendNotification(ord, text, false)
+	case order.TypeSell:
+		if ord.GridId != nil {
+			text := fmt.Sprintf("📝 模拟盘 | 🔴 网格 `#%d` 卖出 %sU [%s](%s), 价格: %s",
+				*ord.GridNumber, outAmount.Truncate(2), ord.Symbol, utils.GetGmgnTokenLink(chainId, ord.Token), format.Price(finalPrice, 5))
+			keeper.sendNotification(ord, text, false)
+		} else {
+			text := fmt.Sprintf("📝 模拟盘 | ✅ 清仓 *%s* 代币成功, 成交价格: %s, 💰 金额: %sU",
+				ord.Symbol, format.Price(finalPrice, 5), outAmount.Truncate(2))
+			keeper.sendNotification(ord, text, true)
+		}
+	}
+}
+
+func (keeper *OrderKeeper) handlePaperOrder(ord *ent.Order) {
+	tokenMeta, err := keeper.svcCtx.TokenMetaCache.GetTokenMeta(keeper.ctx, ord.Token)
+	if err != nil {
+		logger.Errorf("[OrderKeeper] 查询代币元数据失败, token: %s, %v", ord.Token, err)
+		return
+	}
+
+	// 按报价模拟余额变化
+	token := common.HexToAddress(ord.Token)
+	stablecoinCA := common.HexToAddress(keeper.svcCtx.Config.Chain.StablecoinCA)
+	stablecoinDecimals := keeper.svcCtx.Config.Chain.StablecoinDecimals
+	changes := make(map[common.Address]*big.Int)
+	switch ord.Type {
+	case order.TypeBuy:
+		changes[token] = evm.FormatUnits(ord.OutAmount, tokenMeta.Decimals)
+		changes[stablecoinCA] = evm.FormatUnits(ord.InAmount.Neg(), stablecoinDecimals)
+	case order.TypeSell:
+		changes[token] = evm.FormatUnits(ord.InAmount.Neg(), tokenMeta.Decimals)
+		changes[stablecoinCA] = evm.FormatUnits(ord.OutAmount, stablecoinDecimals)
+	}
+
+	keeper.handleCloseOrder(ord, changes)
+}
+
 func (keeper *OrderKeeper) handleRejectOrder(ord *ent.Order, reason string) {
+	keeper.handleFailedOrder(ord, order.StatusRejected, reason)
+}
+
+// 订单失败: 删除买入中的网格, 卖出中的网格恢复为已买入
+func (keeper *OrderKeeper) handleFailedOrder(ord *ent.Order, status order.Status, reason string) {
+	// 重试清仓时会重新加锁, 只在更新网格期间持有策略锁
+	keeper.svcCtx.StrategyLocker.Lock(ord.StrategyId)
 	err := utils.Tx(keeper.ctx, keeper.svcCtx.DbClient, func(tx *ent.Tx) error {
 		if ord.GridId != nil {
 			if ord.Type == order.TypeBuy {
@@ -314,26 +395,28 @@ func (keeper *OrderKeeper) handleRejectOrder(ord *ent.Order, reason string) {
 			}
 		}
 
-		return model.NewOrderModel(tx.Order).SetOrderRejectedStatus(keeper.ctx, ord.ID, reason)
+		return model.NewOrderModel(tx.Order).SetOrderFailedStatus(keeper.ctx, ord.ID, status, reason)
 	})
+	keeper.svcCtx.StrategyLocker.Unlock(ord.StrategyId)
 	if err != nil {
-		logger.Errorf("[OrderKeeper] 设置订单 rejected 状态失败, id: %d, hash: %s, %v", ord.ID, ord.TxHash, err)
+		logger.Errorf("[OrderKeeper] 设置订单 %s 状态失败, id: %d, hash: %s, %v", status, ord.ID, ord.TxHash, err)
 		return
 	}
-	logger.Infof("[OrderKeeper] 设置订单 rejected 状态, id: %d, hash: %s, reason: %s", ord.ID, ord.TxHash, reason)
+	logger.Infof("[OrderKeeper] 设置订单 %s 状态, id: %d, hash: %s, reason: %s", status, ord.ID, ord.TxHash, reason)
 
 	// 发送失败通知
+	cause := failureCause(status, reason)
 	chainId := keeper.svcCtx.Config.Chain.Id
 	switch ord.Type {
 	case order.TypeBuy:
-		keeper.sendNotification(ord, fmt.Sprintf("❌ 网格 `#%d` 买入 %sU [%s](%s), 原因: 流动性不足或者滑点问题 [>>](%s)",
-			*ord.GridNumber, ord.InAmount.Truncate(2), ord.Symbol, utils.GetGmgnTokenLink(chainId, ord.Token), utils.GetBlockExplorerTxLink(chainId, ord.TxHash)), false)
+		keeper.sendNotification(ord, fmt.Sprintf("❌ 网格 `#%d` 买入 %sU [%s](%s), 原因: %s [>>](%s)",
+			*ord.GridNumber, ord.InAmount.Truncate(2), ord.Symbol, utils.GetGmgnTokenLink(chainId, ord.Token), cause, utils.GetBlockExplorerTxLink(chainId, ord.TxHash)), false)
 	case order.TypeSell:
 		if ord.GridId != nil {
-			keeper.sendNotification(ord, fmt.Sprintf("❌ 网格 `#%d` 卖出 %s [%s](%s) 失败, 原因: 流动性不足或者滑点问题 [>>](%s)",
-				*ord.GridNumber, ord.InAmount, ord.Symbol, utils.GetGmgnTokenLink(chainId, ord.Token), utils.GetBlockExplorerTxLink(chainId, ord.TxHash)), false)
+			keeper.sendNotification(ord, fmt.Sprintf("❌ 网格 `#%d` 卖出 %s [%s](%s) 失败, 原因: %s [>>](%s)",
+				*ord.GridNumber, ord.InAmount, ord.Symbol, utils.GetGmgnTokenLink(chainId, ord.Token), cause, utils.GetBlockExplorerTxLink(chainId, ord.TxHash)), false)
 		} else {
-			keeper.sendNotification(ord, fmt.Sprintf("❌ 清仓 *%s* 代币失败, 原因: 流动性不足或者滑点问题 [>>](%s)", ord.Symbol, utils.GetBlockExplorerTxLink(chainId, ord.TxHash)), true)
+			keeper.sendNotification(ord, fmt.Sprintf("❌ 清仓 *%s* 代币失败, 原因: %s [>>](%s)", ord.Symbol, cause, utils.GetBlockExplorerTxLink(chainId, ord.TxHash)), true)
 		}
 	}
 
@@ -343,71 +426,225 @@ func (keeper *OrderKeeper) handleRejectOrder(ord *ent.Order, reason string) {
 	}
 }
 
-func (keeper *OrderKeeper) handlePolling() {
-	// 获取订单列表
-	orders, err := keeper.svcCtx.OrderModel.FindPendingOrders(keeper.ctx, 100)
+// 处理长时间处于 submitting 状态的订单, 通常是服务在广播交易前后异常退出
+func (keeper *OrderKeeper) handleSubmittingOrders() {
+	orders, err := keeper.svcCtx.OrderModel.FindSubmittingOrders(keeper.ctx, time.Now().Add(-submittingTimeout), 100)
 	if err != nil {
-		logger.Errorf("[OrderKeeper] 获取订单列表失败, %v", err)
-	}
-	if len(orders) == 0 {
+		logger.Errorf("[OrderKeeper] 获取 submitting 订单列表失败, %v", err)
 		return
 	}
 
-	// 检查交易状态
-	now := time.Now()
-	openOrders := make([]*ent.Order, 0)
-	tokenBalanceChanges := make(map[int]map[common.Address]*big.Int)
 	for _, item := range orders {
-		// 忽略超时交易
-		_, timeout := keeper.timeoutTxs[item.TxHash]
-		if timeout {
-			continue
-		}
-
-		// 查询交易收据
-		receipt, err := keeper.svcCtx.EthClient.TransactionReceipt(keeper.ctx, common.HexToHash(item.TxHash))
-		if err != nil {
-			// 标记超时交易
-			if strings.Contains(err.Error(), "not found") {
-				if now.Sub(item.CreateTime) > time.Minute*2 {
-					keeper.timeoutTxs[item.TxHash] = struct{}{}
-					logger.Errorf("[OrderKeeper] 交易打包超时, account: %s, nonce: %d, hash: %s, createTime: %v",
-						item.Account, item.Nonce, item.TxHash, item.CreateTime)
-				}
+		if item.StrategyId != "" {
+			if !keeper.svcCtx.StrategyLocker.TryLock(item.StrategyId) {
 				continue
 			}
+			keeper.reconcileSubmittingOrder(item)
+			keeper.svcCtx.StrategyLocker.Unlock(item.StrategyId)
+		} else {
+			keeper.reconcileSubmittingOrder(item)
+		}
+	}
+}
 
-			logger.Errorf("[OrderKeeper] 查询交易收据失败, account: %s, nonce: %d, hash: %s, %v", item.Account, item.Nonce, item.TxHash, err)
+func (keeper *OrderKeeper) reconcileSubmittingOrder(ord *ent.Order) {
+	// 查询交易是否已经广播
+	broadcasted := false
+	if !ord.Paper {
+		_, _, err := keeper.svcCtx.EthClient.TransactionByHash(keeper.ctx, common.HexToHash(ord.TxHash))
+		if err == nil {
+			broadcasted = true
+		} else if !errors.Is(err, ethereum.NotFound) {
+			logger.Errorf("[OrderKeeper] 查询交易失败, account: %s, nonce: %d, hash: %s, %v", ord.Account, ord.Nonce, ord.TxHash, err)
 			return
 		}
+	}
 
-		// 处理驳回订单
-		if receipt.Status == 0 {
-			keeper.handleRejectOrder(item, "execution reverted")
-			continue
+	// 节点未查询到交易时, nonce 未被占用则重新广播, 避免负载均衡节点或交易池驱逐导致误判
+	reason := "transaction not broadcast"
+	if !broadcasted && !ord.Paper {
+		var err error
+		broadcasted, reason, err = keeper.rebroadcastSubmittingOrder(ord)
+		if err != nil {
+			logger.Errorf("[OrderKeeper] 重新广播交易失败, account: %s, nonce: %d, hash: %s, %v", ord.Account, ord.Nonce, ord.TxHash, err)
+			return
 		}
+	}
 
-		// 查询余额变化
-		changes, err := evm.GetTokenBalanceChanges(keeper.ctx, keeper.svcCtx.EthClient, receipt, item.Account)
+	// 交易未广播, 业务数据未变更, 直接驳回订单
+	if !broadcasted {
+		err := keeper.svcCtx.OrderModel.SetOrderRejectedStatus(keeper.ctx, ord.ID, reason)
 		if err != nil {
-			logger.Errorf("[OrderKeeper] 查询代币余额变化失败, account: %s, nonce: %d, hash: %s, %v", item.Account, item.Nonce, item.TxHash, err)
+			logger.Errorf("[OrderKeeper] 设置订单 rejected 状态失败, id: %d, hash: %s, %v", ord.ID, ord.TxHash, err)
 			return
 		}
+		logger.Warnf("[OrderKeeper] 交易未广播, 驳回订单, id: %d, type: %s, strategy: %s, hash: %s, reason: %s", ord.ID, ord.Type, ord.StrategyId, ord.TxHash, reason)
+		return
+	}
+
+	// 交易已广播, 补全业务数据后进入 pending 状态
+	err := utils.Tx(keeper.ctx, keeper.svcCtx.DbClient, func(tx *ent.Tx) error {
+		gridModel := model.NewGridModel(tx.Grid)
+		switch {
+		case ord.Type == order.TypeBuy && ord.GridId != nil:
+			_, err := gridModel.FindByGuid(keeper.ctx, *ord.GridId)
+			if ent.IsNotFound(err) {
+				_, err = gridModel.Save(keeper.ctx, ent.Grid{
+					GUID:       *ord.GridId,
+					Account:    ord.Account,
+					Token:      ord.Token,
+					Symbol:     ord.Symbol,
+					StrategyId: ord.StrategyId,
+					GridNumber: lo.FromPtr(ord.GridNumber),
+					OrderPrice: ord.Price,
+					FinalPrice: ord.FinalPrice,
+					Amount:     ord.InAmount,
+					Quantity:   ord.OutAmount,
+					Status:     grid.StatusBuying,
+				})
+			}
+			if err != nil {
+				return err
+			}
+		case ord.Type == order.TypeSell && ord.GridId != nil:
+			if err := gridModel.SetSellingStatus(keeper.ctx, *ord.GridId); err != nil {
+				return err
+			}
+		case ord.Type == order.TypeSell && ord.StrategyId != "":
+			// 清仓订单, 删除已买入网格
+			gridRecords, err := gridModel.FindByStrategyId(keeper.ctx, ord.StrategyId)
+			if err != nil {
+				return err
+			}
+			for _, item := range gridRecords {
+				if item.Status != grid.StatusBought {
+					continue
+				}
+				if _, err = gridModel.DeleteByGuid(keeper.ctx, item.GUID); err != nil {
+					return err
+				}
+			}
+		}
+
+		return model.NewOrderModel(tx.Order).SetOrderPendingStatus(keeper.ctx, ord.ID)
+	})
+	if err != nil {
+		logger.Errorf("[OrderKeeper] 恢复 submitting 订单失败, id: %d, hash: %s, %v", ord.ID, ord.TxHash, err)
+		return
+	}
+	logger.Infof("[OrderKeeper] 恢复 submitting 订单, id: %d, type: %s, strategy: %s, hash: %s", ord.ID, ord.Type, ord.StrategyId, ord.TxHash)
+}
+
+// 重新广播 submitting 订单的交易, 只有 nonce 已被其他交易占用或节点拒绝时才返回未广播
+func (keeper *OrderKeeper) rebroadcastSubmittingOrder(ord *ent.Order) (bool, string, error) {
+	latestNonce, err := keeper.svcCtx.EthClient.NonceAt(keeper.ctx, common.HexToAddress(ord.Account), nil)
+	if err != nil {
+		return false, "", err
+	}
+
+	if latestNonce > ord.Nonce {
+		// 再次确认交易未打包, 防止节点数据不一致
+		receipt, _, err := keeper.findReceipt(ord)
+		if err != nil {
+			return false, "", err
+		}
+		if receipt != nil {
+			return true, "", nil
+		}
+		return false, "nonce consumed by another transaction", nil
+	}
 
-		openOrders = append(openOrders, item)
-		tokenBalanceChanges[item.ID] = changes
+	if ord.RawTx == nil {
+		return false, "transaction not broadcast", nil
 	}
 
-	if len(openOrders) == 0 {
+	err = rebroadcastRawTx(keeper.ctx, keeper.svcCtx, *ord.RawTx)
+	if err == nil {
+		logger.Infof("[OrderKeeper] 重新广播 submitting 订单交易, id: %d, nonce: %d, hash: %s", ord.ID, ord.Nonce, ord.TxHash)
+		return true, "", nil
+	}
+
+	// 节点返回的错误表示拒绝交易, 其他错误为网络问题, 稍后重试
+	var rpcErr rpc.Error
+	if !errors.As(err, &rpcErr) {
+		return false, "", err
+	}
+	return false, lo.Substring("rebroadcast refused: "+err.Error(), 0, 500), nil
+}
+
+func (keeper *OrderKeeper) handlePolling() {
+	// 处理未完成提交的订单
+	keeper.handleSubmittingOrders()
+
+	// 处理超时订单
+	keeper.handleTimeoutOrders()
+
+	// 获取订单列表
+	orders, err := keeper.svcCtx.OrderModel.FindPendingOrders(keeper.ctx, 100)
+	if err != nil {
+		logger.Errorf("[OrderKeeper] 获取订单列表失败, %v", err)
+	}
+	if len(orders) == 0 {
 		return
 	}
 
-	// 更新订单信息
-	for _, item := range openOrders {
-		changes, ok := tokenBalanceChanges[item.ID]
-		if !ok {
+	// 处理模拟订单
+	chainOrders := make([]*ent.Order, 0, len(orders))
+	for _, item := range orders {
+		if item.Paper {
+			keeper.handlePaperOrder(item)
 			continue
 		}
-		keeper.handleCloseOrder(item, changes)
+		chainOrders = append(chainOrders, item)
+	}
+	if len(chainOrders) == 0 {
+		return
+	}
+
+	// 出现新区块后再检查交易状态
+	blockNumber, err := keeper.svcCtx.EthClient.BlockNumber(keeper.ctx)
+	if err != nil {
+		logger.Errorf("[OrderKeeper] 查询最新区块失败, %v", err)
+		return
+	}
+	if blockNumber <= keeper.lastBlock {
+		return
 	}
+	keeper.lastBlock = blockNumber
+
+	keeper.handleChainOrders(chainOrders, time.Now())
+}
+
+// 根据交易收据完成订单
+func (keeper *OrderKeeper) settleOrder(ord *ent.Order, receipt *ethtypes.Receipt, hash string) error {
+	// 取消交易已打包
+	if ord.Cancelled && hash == ord.TxHash {
+		keeper.handleRejectOrder(ord, "transaction cancelled")
+		return nil
+	}
+
+	// 被替换前的交易已打包
+	if hash != ord.TxHash {
+		if err := keeper.svcCtx.OrderModel.UpdateTxHash(keeper.ctx, ord.ID, hash); err != nil {
+			logger.Errorf("[OrderKeeper] 更新交易哈希失败, id: %d, hash: %s, %v", ord.ID, hash, err)
+			return err
+		}
+		ord.TxHash = hash
+	}
+
+	// 处理驳回订单
+	if receipt.Status == 0 {
+		keeper.handleRejectOrder(ord, "execution reverted")
+		return nil
+	}
+
+	// 查询余额变化
+	changes, err := evm.GetTokenBalanceChanges(keeper.ctx, keeper.svcCtx.EthClient, receipt, ord.Account)
+	if err != nil {
+		logger.Errorf("[OrderKeeper] 查询代币余额变化失败, account: %s, nonce: %d, hash: %s, %v", ord.Account, ord.Nonce, ord.TxHash, err)
+		return err
+	}
+
+	keeper.handleCloseOrder(ord, changes)
+	return nil
 }
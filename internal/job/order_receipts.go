@@ -0,0 +1,86 @@
+package job
+
+import (
+	"time"
+
+	"github.com/fachebot/evm-grid-bot/internal/ent"
+	"github.com/fachebot/evm-grid-bot/internal/logger"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const receiptBatchSize = 100
+
+// 批量查询交易收据, 未打包的交易不在结果中, 查询失败的交易记录在 failed 中
+func (keeper *OrderKeeper) batchReceipts(hashes []string) (map[string]*ethtypes.Receipt, map[string]error, error) {
+	receipts := make(map[string]*ethtypes.Receipt)
+	failed := make(map[string]error)
+	for start := 0; start < len(hashes); start += receiptBatchSize {
+		end := min(start+receiptBatchSize, len(hashes))
+		results := make([]*ethtypes.Receipt, end-start)
+		elems := make([]rpc.BatchElem, end-start)
+		for idx, hash := range hashes[start:end] {
+			elems[idx] = rpc.BatchElem{
+				Method: "eth_getTransactionReceipt",
+				Args:   []any{hash},
+				Result: &results[idx],
+			}
+		}
+
+		if err := keeper.svcCtx.EthClient.Client().BatchCallContext(keeper.ctx, elems); err != nil {
+			return nil, nil, err
+		}
+
+		for idx, elem := range elems {
+			hash := hashes[start+idx]
+			if elem.Error != nil {
+				failed[hash] = elem.Error
+				continue
+			}
+			if results[idx] != nil {
+				receipts[hash] = results[idx]
+			}
+		}
+	}
+	return receipts, failed, nil
+}
+
+// 每个新区块批量检查链上订单, 单个订单出错不影响其他订单
+func (keeper *OrderKeeper) handleChainOrders(orders []*ent.Order, now time.Time) {
+	hashes := make([]string, 0, len(orders))
+	for _, item := range orders {
+		hashes = append(hashes, orderTxHashes(item)...)
+	}
+
+	receipts, failed, err := keeper.batchReceipts(hashes)
+	if err != nil {
+		logger.Errorf("[OrderKeeper] 批量查询交易收据失败, count: %d, %v", len(hashes), err)
+		return
+	}
+
+	for _, item := range orders {
+		var hash string
+		var receipt *ethtypes.Receipt
+		var queryErr error
+		for _, h := range orderTxHashes(item) {
+			if r, ok := receipts[h]; ok {
+				hash, receipt = h, r
+				break
+			}
+			if err, ok := failed[h]; ok {
+				queryErr = err
+			}
+		}
+
+		if receipt != nil {
+			_ = keeper.settleOrder(item, receipt, hash)
+			continue
+		}
+		if queryErr != nil {
+			logger.Errorf("[OrderKeeper] 查询交易收据失败, account: %s, nonce: %d, hash: %s, %v", item.Account, item.Nonce, item.TxHash, queryErr)
+			continue
+		}
+		keeper.handleStuckOrder(item, now)
+	}
+}
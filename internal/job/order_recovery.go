@@ -0,0 +1,236 @@
+package job
+
+import (
+	"errors"
+	"time"
+
+	"github.com/fachebot/evm-grid-bot/internal/ent"
+	"github.com/fachebot/evm-grid-bot/internal/ent/order"
+	"github.com/fachebot/evm-grid-bot/internal/logger"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	minTimeoutRetryDelay = time.Minute
+	maxTimeoutRetryDelay = time.Minute * 30
+)
+
+// 订单在链上的结果
+type orderFate int
+
+const (
+	orderFateMined     orderFate = iota // 交易已打包
+	orderFateInMempool                  // 交易仍在交易池中
+	orderFateReplaced                   // nonce 已被其他交易使用
+	orderFateDropped                    // 超时交易的 nonce 已被其他交易使用
+	orderFateMissing                    // 节点查询不到交易, nonce 尚未使用
+)
+
+// 超时订单的重试间隔, 按重试次数指数增长
+func timeoutRetryDelay(retryCount int) time.Duration {
+	delay := minTimeoutRetryDelay
+	for i := 0; i < retryCount && delay < maxTimeoutRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxTimeoutRetryDelay)
+}
+
+func failureCause(status order.Status, reason string) string {
+	switch status {
+	case order.StatusReplaced:
+		return "交易 nonce 已被其他交易使用"
+	case order.StatusDropped:
+		return "交易长时间未打包, 已被节点丢弃"
+	}
+
+	switch reason {
+	case "transaction cancelled":
+		return "交易长时间未打包, 已取消"
+	case "transaction not broadcast":
+		return "交易未广播"
+	}
+	return "流动性不足或者滑点问题"
+}
+
+// 节点是否知道订单的任意一笔交易
+func (keeper *OrderKeeper) isKnownToNode(ord *ent.Order) (bool, error) {
+	for _, hash := range orderTxHashes(ord) {
+		_, _, err := keeper.svcCtx.EthClient.TransactionByHash(keeper.ctx, common.HexToHash(hash))
+		if err == nil {
+			return true, nil
+		}
+		if !errors.Is(err, ethereum.NotFound) {
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+func (keeper *OrderKeeper) markSubmitted(ord *ent.Order) {
+	known, err := keeper.isKnownToNode(ord)
+	if err != nil || !known {
+		return
+	}
+
+	if err = keeper.svcCtx.OrderModel.SetOrderSubmittedStatus(keeper.ctx, ord.ID); err != nil {
+		logger.Errorf("[OrderKeeper] 设置订单 submitted 状态失败, id: %d, hash: %s, %v", ord.ID, ord.TxHash, err)
+		return
+	}
+	ord.Status = order.StatusSubmitted
+}
+
+// 根据链上状态判断订单结果
+func (keeper *OrderKeeper) decideOrderFate(ord *ent.Order) (orderFate, *ethtypes.Receipt, string, error) {
+	receipt, hash, err := keeper.findReceipt(ord)
+	if err != nil {
+		return 0, nil, "", err
+	}
+	if receipt != nil {
+		return orderFateMined, receipt, hash, nil
+	}
+
+	latestNonce, err := keeper.svcCtx.EthClient.NonceAt(keeper.ctx, common.HexToAddress(ord.Account), nil)
+	if err != nil {
+		return 0, nil, "", err
+	}
+	if ord.Nonce < latestNonce {
+		// 再次查询收据, 避免交易在两次查询之间打包
+		receipt, hash, err = keeper.findReceipt(ord)
+		if err != nil {
+			return 0, nil, "", err
+		}
+		if receipt != nil {
+			return orderFateMined, receipt, hash, nil
+		}
+		// 超时订单长时间未打包, 视为被节点丢弃
+		if ord.Status == order.StatusTimeout {
+			return orderFateDropped, nil, "", nil
+		}
+		return orderFateReplaced, nil, "", nil
+	}
+
+	known, err := keeper.isKnownToNode(ord)
+	if err != nil {
+		return 0, nil, "", err
+	}
+	if known {
+		return orderFateInMempool, nil, "", nil
+	}
+	return orderFateMissing, nil, "", nil
+}
+
+// 节点查询不到交易时, 交易仍可能在其他节点打包, 重新广播或发送同 nonce 取消交易,
+// 直到 nonce 被使用后才确定订单结果
+func (keeper *OrderKeeper) handleMissingOrder(ord *ent.Order, now time.Time) {
+	if ord.RawTx != nil {
+		err := rebroadcastRawTx(keeper.ctx, keeper.svcCtx, *ord.RawTx)
+		if err == nil {
+			logger.Infof("[OrderKeeper] 重新广播交易, id: %d, account: %s, nonce: %d, hash: %s", ord.ID, ord.Account, ord.Nonce, ord.TxHash)
+		} else if !ord.Cancelled {
+			logger.Warnf("[OrderKeeper] 重新广播交易失败, 发送取消交易, id: %d, nonce: %d, hash: %s, %v", ord.ID, ord.Nonce, ord.TxHash, err)
+			if err = keeper.replaceTransaction(ord, true, now); err != nil {
+				logger.Errorf("[OrderKeeper] 发送取消交易失败, account: %s, nonce: %d, hash: %s, %v", ord.Account, ord.Nonce, ord.TxHash, err)
+			}
+		}
+	}
+
+	// 没有签名数据时等待 nonce 缺口处理, 按超时订单定期重新检查
+	var err error
+	if ord.Status != order.StatusTimeout {
+		err = keeper.svcCtx.OrderModel.SetOrderTimeoutStatus(keeper.ctx, ord.ID, now.Add(timeoutRetryDelay(0)))
+	} else {
+		retryCount := ord.RetryCount + 1
+		err = keeper.svcCtx.OrderModel.UpdateRetry(keeper.ctx, ord.ID, retryCount, now.Add(timeoutRetryDelay(retryCount)))
+	}
+	if err != nil {
+		logger.Errorf("[OrderKeeper] 更新订单重试时间失败, id: %d, hash: %s, %v", ord.ID, ord.TxHash, err)
+	}
+}
+
+// 按重试间隔重新检查超时订单
+func (keeper *OrderKeeper) handleTimeoutOrders() {
+	now := time.Now()
+	orders, err := keeper.svcCtx.OrderModel.FindTimeoutOrders(keeper.ctx, now, 100)
+	if err != nil {
+		logger.Errorf("[OrderKeeper] 获取 timeout 订单列表失败, %v", err)
+		return
+	}
+
+	for _, item := range orders {
+		fate, receipt, hash, err := keeper.decideOrderFate(item)
+		if err != nil {
+			logger.Errorf("[OrderKeeper] 查询订单链上状态失败, id: %d, hash: %s, %v", item.ID, item.TxHash, err)
+			continue
+		}
+
+		switch fate {
+		case orderFateMined:
+			_ = keeper.settleOrder(item, receipt, hash)
+		case orderFateReplaced:
+			keeper.handleFailedOrder(item, order.StatusReplaced, "nonce used by another transaction")
+		case orderFateDropped:
+			keeper.handleFailedOrder(item, order.StatusDropped, "transaction dropped")
+		case orderFateMissing:
+			keeper.handleMissingOrder(item, now)
+		case orderFateInMempool:
+			retryCount := item.RetryCount + 1
+			err = keeper.svcCtx.OrderModel.UpdateRetry(keeper.ctx, item.ID, retryCount, now.Add(timeoutRetryDelay(retryCount)))
+			if err != nil {
+				logger.Errorf("[OrderKeeper] 更新订单重试次数失败, id: %d, hash: %s, %v", item.ID, item.TxHash, err)
+			}
+		}
+	}
+}
+
+// 服务启动时检查所有未完成的链上订单
+func (keeper *OrderKeeper) recoverOrders() {
+	orders, err := keeper.svcCtx.OrderModel.FindUnsettledOrders(keeper.ctx)
+	if err != nil {
+		logger.Errorf("[OrderKeeper] 获取未完成订单列表失败, %v", err)
+		return
+	}
+	if len(orders) == 0 {
+		return
+	}
+
+	now := time.Now()
+	counts := make(map[orderFate]int)
+	for _, item := range orders {
+		fate, receipt, hash, err := keeper.decideOrderFate(item)
+		if err != nil {
+			logger.Errorf("[OrderKeeper] 查询订单链上状态失败, id: %d, hash: %s, %v", item.ID, item.TxHash, err)
+			continue
+		}
+		counts[fate]++
+
+		switch fate {
+		case orderFateMined:
+			_ = keeper.settleOrder(item, receipt, hash)
+		case orderFateReplaced:
+			keeper.handleFailedOrder(item, order.StatusReplaced, "nonce used by another transaction")
+		case orderFateDropped:
+			keeper.handleFailedOrder(item, order.StatusDropped, "transaction dropped")
+		case orderFateMissing:
+			// 刚广播的交易可能尚未同步到节点, 交由轮询处理
+			lastSendTime := item.CreateTime
+			if item.ReplaceTime != nil {
+				lastSendTime = *item.ReplaceTime
+			}
+			if item.Status == order.StatusTimeout || now.Sub(lastSendTime) > stuckTxTimeout {
+				keeper.handleMissingOrder(item, now)
+			}
+		case orderFateInMempool:
+			if item.Status == order.StatusPending {
+				if err = keeper.svcCtx.OrderModel.SetOrderSubmittedStatus(keeper.ctx, item.ID); err != nil {
+					logger.Errorf("[OrderKeeper] 设置订单 submitted 状态失败, id: %d, hash: %s, %v", item.ID, item.TxHash, err)
+				}
+			}
+		}
+	}
+
+	logger.Infof("[OrderKeeper] 恢复未完成订单, total: %d, mined: %d, inMempool: %d, replaced: %d, dropped: %d, missing: %d",
+		len(orders), counts[orderFateMined], counts[orderFateInMempool], counts[orderFateReplaced], counts[orderFateDropped], counts[orderFateMissing])
+}
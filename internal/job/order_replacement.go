@@ -0,0 +1,216 @@
+package job
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/fachebot/evm-grid-bot/internal/ent"
+	"github.com/fachebot/evm-grid-bot/internal/ent/order"
+	"github.com/fachebot/evm-grid-bot/internal/logger"
+	"github.com/fachebot/evm-grid-bot/internal/svc"
+	"github.com/fachebot/evm-grid-bot/internal/utils/evm"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/samber/lo"
+)
+
+const (
+	cancelTxGas     = 21000
+	stuckTxTimeout  = time.Minute * 2
+	txHashSeparator = ","
+)
+
+// 订单关联的所有交易哈希, 最新的交易在前
+func orderTxHashes(ord *ent.Order) []string {
+	hashes := []string{ord.TxHash}
+	if ord.ReplacedTxHashes != nil && *ord.ReplacedTxHashes != "" {
+		replaced := strings.Split(*ord.ReplacedTxHashes, txHashSeparator)
+		hashes = append(hashes, lo.Reverse(replaced)...)
+	}
+	return hashes
+}
+
+// 查询订单任意一笔交易的收据, 所有交易都未打包时返回 nil
+func (keeper *OrderKeeper) findReceipt(ord *ent.Order) (*ethtypes.Receipt, string, error) {
+	for _, hash := range orderTxHashes(ord) {
+		receipt, err := keeper.svcCtx.EthClient.TransactionReceipt(keeper.ctx, common.HexToHash(hash))
+		if err == nil {
+			return receipt, hash, nil
+		}
+		if !errors.Is(err, ethereum.NotFound) {
+			return nil, "", err
+		}
+	}
+	return nil, "", nil
+}
+
+// 处理未打包交易, 超过等待时间后提高手续费重新发送, 超过加速次数后发送取消交易
+func (keeper *OrderKeeper) handleStuckOrder(ord *ent.Order, now time.Time) {
+	c := keeper.svcCtx.Config.TxReplacement
+	lastSendTime := ord.CreateTime
+	if ord.ReplaceTime != nil {
+		lastSendTime = *ord.ReplaceTime
+	}
+
+	// 交易池已收到交易
+	if ord.Status == order.StatusPending {
+		keeper.markSubmitted(ord)
+	}
+
+	if !c.Enable || ord.RawTx == nil || ord.Cancelled {
+		if now.Sub(lastSendTime) > stuckTxTimeout {
+			err := keeper.svcCtx.OrderModel.SetOrderTimeoutStatus(keeper.ctx, ord.ID, now.Add(timeoutRetryDelay(0)))
+			if err != nil {
+				logger.Errorf("[OrderKeeper] 设置订单 timeout 状态失败, id: %d, hash: %s, %v", ord.ID, ord.TxHash, err)
+				return
+			}
+			logger.Errorf("[OrderKeeper] 交易打包超时, account: %s, nonce: %d, hash: %s, createTime: %v",
+				ord.Account, ord.Nonce, ord.TxHash, ord.CreateTime)
+		}
+		return
+	}
+
+	if now.Sub(lastSendTime) < time.Duration(c.SpeedUpDelaySeconds)*time.Second {
+		return
+	}
+
+	cancel := ord.ReplaceCount >= c.MaxSpeedUps
+	if err := keeper.replaceTransaction(ord, cancel, now); err != nil {
+		logger.Errorf("[OrderKeeper] 替换交易失败, account: %s, nonce: %d, hash: %s, cancel: %v, %v",
+			ord.Account, ord.Nonce, ord.TxHash, cancel, err)
+	}
+}
+
+func getPrivateKey(ctx context.Context, svcCtx *svc.ServiceContext, account string) (*ecdsa.PrivateKey, error) {
+	w, err := svcCtx.WalletModel.FindByAccount(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	pk, err := svcCtx.HashEncoder.Decryption(w.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.HexToECDSA(pk)
+}
+
+// 重新广播已签名的交易, 交易池中已存在时视为成功
+func rebroadcastRawTx(ctx context.Context, svcCtx *svc.ServiceContext, rawTx string) error {
+	data, err := hexutil.Decode(rawTx)
+	if err != nil {
+		return err
+	}
+
+	tx := new(ethtypes.Transaction)
+	if err = tx.UnmarshalBinary(data); err != nil {
+		return err
+	}
+
+	err = svcCtx.EthClient.SendTransaction(ctx, tx)
+	if err != nil && strings.Contains(err.Error(), "already known") {
+		return nil
+	}
+	return err
+}
+
+// 按百分比提高手续费
+func bumpFee(value *big.Int, percent int) *big.Int {
+	v := new(big.Int).Mul(value, big.NewInt(int64(100+percent)))
+	return v.Div(v, big.NewInt(100))
+}
+
+func (keeper *OrderKeeper) replaceTransaction(ord *ent.Order, cancel bool, now time.Time) error {
+	data, err := hexutil.Decode(*ord.RawTx)
+	if err != nil {
+		return err
+	}
+	oldTx := new(ethtypes.Transaction)
+	if err = oldTx.UnmarshalBinary(data); err != nil {
+		return err
+	}
+
+	prv, err := getPrivateKey(keeper.ctx, keeper.svcCtx, ord.Account)
+	if err != nil {
+		return err
+	}
+
+	// 计算新的手续费, 不低于当前网络建议值
+	suggestedTip, minFeeCap, err := evm.SuggestGasFees(keeper.ctx, keeper.svcCtx.EthClient)
+	if err != nil {
+		return err
+	}
+
+	percent := keeper.svcCtx.Config.TxReplacement.FeeBumpPercent
+	gasTipCap := bumpFee(oldTx.GasTipCap(), percent)
+	if suggestedTip.Cmp(gasTipCap) > 0 {
+		gasTipCap = suggestedTip
+	}
+	gasFeeCap := bumpFee(oldTx.GasFeeCap(), percent)
+	if minFeeCap.Cmp(gasFeeCap) > 0 {
+		gasFeeCap = minFeeCap
+	}
+	if gasTipCap.Cmp(gasFeeCap) > 0 {
+		gasFeeCap = gasTipCap
+	}
+
+	// 取消交易为发送给自己的零值转账
+	account := common.HexToAddress(ord.Account)
+	dynamicFeeTx := ethtypes.DynamicFeeTx{
+		ChainID:   oldTx.ChainId(),
+		Nonce:     oldTx.Nonce(),
+		GasTipCap: gasTipCap,
+		GasFeeCap: gasFeeCap,
+		Gas:       oldTx.Gas(),
+		To:        oldTx.To(),
+		Value:     oldTx.Value(),
+		Data:      oldTx.Data(),
+	}
+	if cancel {
+		dynamicFeeTx.Gas = cancelTxGas
+		dynamicFeeTx.To = &account
+		dynamicFeeTx.Value = big.NewInt(0)
+		dynamicFeeTx.Data = nil
+	}
+	if dynamicFeeTx.To == nil {
+		return errors.New("missing recipient")
+	}
+
+	signedTx, err := ethtypes.SignTx(ethtypes.NewTx(&dynamicFeeTx), ethtypes.NewLondonSigner(oldTx.ChainId()), prv)
+	if err != nil {
+		return err
+	}
+	rawTx, err := signedTx.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	// 先保存替换交易, 再广播
+	replaced := ord.TxHash
+	if ord.ReplacedTxHashes != nil && *ord.ReplacedTxHashes != "" {
+		replaced = *ord.ReplacedTxHashes + txHashSeparator + ord.TxHash
+	}
+	hash := signedTx.Hash().Hex()
+	err = keeper.svcCtx.OrderModel.UpdateReplacement(
+		keeper.ctx, ord.ID, hash, hexutil.Encode(rawTx), replaced, ord.ReplaceCount+1, cancel, now)
+	if err != nil {
+		return err
+	}
+
+	err = keeper.svcCtx.EthClient.SendTransaction(keeper.ctx, signedTx)
+	if err != nil {
+		return err
+	}
+
+	logger.Infof("[OrderKeeper] 替换交易成功, id: %d, account: %s, nonce: %d, oldHash: %s, newHash: %s, cancel: %v, gasTipCap: %s, gasFeeCap: %s",
+		ord.ID, ord.Account, ord.Nonce, ord.TxHash, hash, cancel, gasTipCap, gasFeeCap)
+
+	return nil
+}
@@ -31,6 +31,10 @@ func (model *GridModel) Save(ctx context.Context, args ent.Grid) (*ent.Grid, err
 		SetFinalPrice(args.FinalPrice).
 		SetAmount(args.Amount).
 		SetQuantity(args.Quantity).
+		SetNillableSellPrice(args.SellPrice).
+		SetNillableTakeProfitPrice(args.TakeProfitPrice).
+		SetNillablePeakPrice(args.PeakPrice).
+		SetRunner(args.Runner).
 		SetStatus(args.Status).
 		Save(ctx)
 }
@@ -64,6 +68,52 @@ func (model *GridModel) SetBoughtStatus(ctx context.Context, guid string, finalP
 		Exec(ctx)
 }
 
+// 卖出成交, 部分卖出时保留剩余数量和成本作为留仓网格, 否则删除网格
+func (model *GridModel) SettleSell(ctx context.Context, guid string, quantity, cost decimal.Decimal) error {
+	g, err := model.FindByGuid(ctx, guid)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if cost.IsZero() || cost.GreaterThanOrEqual(g.Amount) || quantity.GreaterThanOrEqual(g.Quantity) {
+		_, err = model.DeleteByGuid(ctx, guid)
+		return err
+	}
+
+	return model.client.Update().
+		Where(grid.GUIDEQ(guid)).
+		SetQuantity(g.Quantity.Sub(quantity)).
+		SetAmount(g.Amount.Sub(cost)).
+		SetStatus(grid.StatusBought).
+		SetRunner(true).
+		ClearPeakPrice().
+		Exec(ctx)
+}
+
+func (model *GridModel) UpdateTakeProfitPrice(ctx context.Context, guid string, takeProfitPrice decimal.Decimal) error {
+	return model.client.Update().
+		Where(grid.GUIDEQ(guid)).
+		SetTakeProfitPrice(takeProfitPrice).
+		Exec(ctx)
+}
+
+func (model *GridModel) UpdatePeakPrice(ctx context.Context, guid string, peakPrice decimal.Decimal) error {
+	return model.client.Update().
+		Where(grid.GUIDEQ(guid)).
+		SetPeakPrice(peakPrice).
+		Exec(ctx)
+}
+
+func (model *GridModel) UpdateGridNumber(ctx context.Context, guid string, gridNumber int) error {
+	return model.client.Update().
+		Where(grid.GUIDEQ(guid)).
+		SetGridNumber(gridNumber).
+		Exec(ctx)
+}
+
 func (model *GridModel) UpdateStatusByGuid(ctx context.Context, guid string, status grid.Status) error {
 	return model.client.Update().
 		Where(grid.GUIDEQ(guid)).
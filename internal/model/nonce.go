@@ -28,6 +28,15 @@ func (m *NonceModel) FindOne(ctx context.Context, account string) (*ent.Nonce, e
 	return m.client.Query().Where(nonce.AccountEQ(common.HexToAddress(account).Hex())).First(ctx)
 }
 
+func (m *NonceModel) FindAll(ctx context.Context) ([]*ent.Nonce, error) {
+	return m.client.Query().All(ctx)
+}
+
 func (m *NonceModel) UpdateNonce(ctx context.Context, account string, newValue uint64) error {
 	return m.client.Update().SetNonce(newValue).Where(nonce.AccountEQ(common.HexToAddress(account).Hex())).Exec(ctx)
 }
+
+func (m *NonceModel) Delete(ctx context.Context, account string) error {
+	_, err := m.client.Delete().Where(nonce.AccountEQ(common.HexToAddress(account).Hex())).Exec(ctx)
+	return err
+}
@@ -2,6 +2,7 @@ package model
 
 import (
 	"context"
+	"time"
 
 	"github.com/fachebot/evm-grid-bot/internal/ent"
 	"github.com/fachebot/evm-grid-bot/internal/ent/order"
@@ -38,6 +39,11 @@ func (model *OrderModel) Save(ctx context.Context, args ent.Order) (*ent.Order,
 		SetTxHash(args.TxHash).
 		SetReason(args.Reason).
 		SetNillableProfit(args.Profit).
+		SetPaper(args.Paper).
+		SetNillableRawTx(args.RawTx).
+		SetNillableRoute(args.Route).
+		SetNillableRunnerUpRoute(args.RunnerUpRoute).
+		SetNillableRouteSpreadUsd(args.RouteSpreadUsd).
 		Save(ctx)
 }
 
@@ -61,12 +67,63 @@ func (model *OrderModel) TotalProfit(ctx context.Context, strategyId string, fir
 
 func (model *OrderModel) FindPendingOrders(ctx context.Context, limit int) ([]*ent.Order, error) {
 	return model.client.Query().
-		Where(order.StatusEQ(order.StatusPending)).
+		Where(order.StatusIn(order.StatusPending, order.StatusSubmitted)).
 		Order(order.ByID(sql.OrderAsc())).
 		Limit(limit).
 		All(ctx)
 }
 
+func (model *OrderModel) FindTimeoutOrders(ctx context.Context, now time.Time, limit int) ([]*ent.Order, error) {
+	return model.client.Query().
+		Where(
+			order.StatusEQ(order.StatusTimeout),
+			order.Or(order.NextRetryTimeIsNil(), order.NextRetryTimeLTE(now)),
+		).
+		Order(order.ByID(sql.OrderAsc())).
+		Limit(limit).
+		All(ctx)
+}
+
+// 已广播但尚未确定结果的链上订单
+func (model *OrderModel) FindUnsettledOrders(ctx context.Context) ([]*ent.Order, error) {
+	return model.client.Query().
+		Where(
+			order.Paper(false),
+			order.StatusIn(order.StatusPending, order.StatusSubmitted, order.StatusTimeout),
+		).
+		Order(order.ByID(sql.OrderAsc())).
+		All(ctx)
+}
+
+func (model *OrderModel) FindSubmittingOrders(ctx context.Context, before time.Time, limit int) ([]*ent.Order, error) {
+	return model.client.Query().
+		Where(order.StatusEQ(order.StatusSubmitting), order.CreateTimeLT(before)).
+		Order(order.ByID(sql.OrderAsc())).
+		Limit(limit).
+		All(ctx)
+}
+
+func (model *OrderModel) FindActiveOrdersByAccount(ctx context.Context, account string) ([]*ent.Order, error) {
+	return model.client.Query().
+		Where(
+			order.AccountEQ(account),
+			order.Paper(false),
+			order.StatusIn(order.StatusSubmitting, order.StatusPending, order.StatusSubmitted, order.StatusTimeout),
+		).
+		Order(order.ByNonce(sql.OrderAsc())).
+		All(ctx)
+}
+
+// 策略是否存在未完成的订单
+func (model *OrderModel) HasActiveOrdersByStrategyId(ctx context.Context, strategyId string) (bool, error) {
+	return model.client.Query().
+		Where(
+			order.StrategyIdEQ(strategyId),
+			order.StatusIn(order.StatusSubmitting, order.StatusPending, order.StatusSubmitted, order.StatusTimeout),
+		).
+		Exist(ctx)
+}
+
 func (model *OrderModel) FindOrdersByStrategyId(ctx context.Context, strategyId string, offset, limit int) ([]*ent.Order, int, error) {
 	q := model.client.Query().
 		Where(order.StrategyIdEQ(strategyId))
@@ -87,6 +144,47 @@ func (model *OrderModel) UpdateProfit(ctx context.Context, id int, profit decima
 	return model.client.UpdateOneID(id).SetProfit(profit).Exec(ctx)
 }
 
+func (model *OrderModel) UpdateTxHash(ctx context.Context, id int, txHash string) error {
+	return model.client.UpdateOneID(id).SetTxHash(txHash).Exec(ctx)
+}
+
+// 记录替换交易, 被替换的交易哈希保存在 replacedTxHashes 中
+func (model *OrderModel) UpdateReplacement(ctx context.Context, id int, txHash, rawTx, replacedTxHashes string, replaceCount int, cancelled bool, replaceTime time.Time) error {
+	return model.client.UpdateOneID(id).
+		SetTxHash(txHash).
+		SetRawTx(rawTx).
+		SetReplacedTxHashes(replacedTxHashes).
+		SetReplaceCount(replaceCount).
+		SetCancelled(cancelled).
+		SetReplaceTime(replaceTime).
+		Exec(ctx)
+}
+
+func (model *OrderModel) SetOrderPendingStatus(ctx context.Context, id int) error {
+	return model.client.UpdateOneID(id).SetStatus(order.StatusPending).Exec(ctx)
+}
+
+func (model *OrderModel) SetOrderSubmittedStatus(ctx context.Context, id int) error {
+	return model.client.UpdateOneID(id).SetStatus(order.StatusSubmitted).Exec(ctx)
+}
+
+func (model *OrderModel) SetOrderTimeoutStatus(ctx context.Context, id int, nextRetryTime time.Time) error {
+	return model.client.UpdateOneID(id).
+		SetStatus(order.StatusTimeout).
+		SetRetryCount(0).
+		SetNextRetryTime(nextRetryTime).
+		Exec(ctx)
+}
+
+func (model *OrderModel) UpdateRetry(ctx context.Context, id int, retryCount int, nextRetryTime time.Time) error {
+	return model.client.UpdateOneID(id).SetRetryCount(retryCount).SetNextRetryTime(nextRetryTime).Exec(ctx)
+}
+
+// 设置订单失败状态: rejected, replaced 或 dropped
+func (model *OrderModel) SetOrderFailedStatus(ctx context.Context, id int, status order.Status, reason string) error {
+	return model.client.UpdateOneID(id).SetStatus(status).SetReason(reason).Exec(ctx)
+}
+
 func (model *OrderModel) SetOrderRejectedStatus(ctx context.Context, id int, reason string) error {
 	return model.client.UpdateOneID(id).SetStatus(order.StatusRejected).SetReason(reason).Exec(ctx)
 }
@@ -28,7 +28,20 @@ func (model *StrategyModel) Save(ctx context.Context, args ent.Strategy) (*ent.S
 		SetSymbol(args.Symbol).
 		SetMartinFactor(args.MartinFactor).
 		SetNillableMaxGridLimit(args.MaxGridLimit).
+		SetGridMode(args.GridMode).
+		SetInventorySeeded(args.InventorySeeded).
+		SetGridType(args.GridType).
+		SetNillableGridCount(args.GridCount).
+		SetNillablePriceStep(args.PriceStep).
+		SetNillableGridLadder(args.GridLadder).
 		SetTakeProfitRatio(args.TakeProfitRatio).
+		SetNillableTrailingTakeProfit(args.TrailingTakeProfit).
+		SetNillableTakeProfitPortion(args.TakeProfitPortion).
+		SetAdaptiveSpacing(args.AdaptiveSpacing).
+		SetNillableAdaptiveMinRatio(args.AdaptiveMinRatio).
+		SetNillableAdaptiveMaxRatio(args.AdaptiveMaxRatio).
+		SetNillableAdaptiveVolatility(args.AdaptiveVolatility).
+		SetNillableAdaptiveUpdateTime(args.AdaptiveUpdateTime).
 		SetLowerPriceBound(args.LowerPriceBound).
 		SetUpperPriceBound(args.UpperPriceBound).
 		SetInitialOrderSize(args.InitialOrderSize).
@@ -39,17 +52,27 @@ func (model *StrategyModel) Save(ctx context.Context, args ent.Strategy) (*ent.S
 		SetNillableLastKlineVolume(args.LastKlineVolume).
 		SetNillableFiveKlineVolume(args.FiveKlineVolume).
 		SetNillableGlobalTakeProfitRatio(args.GlobalTakeProfitRatio).
+		SetTrailingUp(args.TrailingUp).
+		SetTrailingDown(args.TrailingDown).
+		SetTrailingDwellMinutes(args.TrailingDwellMinutes).
 		SetDropOn(args.DropOn).
 		SetCandlesToCheck(args.CandlesToCheck).
 		SetNillableDropThreshold(args.DropThreshold).
+		SetNillableRsiThreshold(args.RsiThreshold).
+		SetEmaFilter(args.EmaFilter).
+		SetEmaPeriod(args.EmaPeriod).
+		SetMacdFilter(args.MacdFilter).
 		SetEnableAutoBuy(args.EnableAutoBuy).
 		SetEnableAutoSell(args.EnableAutoSell).
 		SetEnableAutoExit(args.EnableAutoExit).
 		SetEnablePushNotification(args.EnablePushNotification).
+		SetPaperTrading(args.PaperTrading).
 		SetStatus(args.Status).
 		SetNillableGridTrend(args.GridTrend).
+		SetNillableBuyFilterReason(args.BuyFilterReason).
 		SetNillableLastLowerThresholdAlertTime(args.LastLowerThresholdAlertTime).
 		SetNillableLastUpperThresholdAlertTime(args.LastUpperThresholdAlertTime).
+		SetNillableOutOfRangeTime(args.OutOfRangeTime).
 		Save(ctx)
 }
 
@@ -112,6 +135,14 @@ func (model *StrategyModel) UpdateDynamicStopLoss(ctx context.Context, id int, n
 	return model.client.UpdateOneID(id).SetDynamicStopLoss(newValue).Exec(ctx)
 }
 
+func (model *StrategyModel) UpdateMartinFactor(ctx context.Context, id int, newValue float64) error {
+	return model.client.UpdateOneID(id).SetMartinFactor(newValue).Exec(ctx)
+}
+
+func (model *StrategyModel) UpdatePaperTrading(ctx context.Context, id int, newValue bool) error {
+	return model.client.UpdateOneID(id).SetPaperTrading(newValue).Exec(ctx)
+}
+
 func (model *StrategyModel) UpdateGridTrend(ctx context.Context, id int, trending string) error {
 	return model.client.UpdateOneID(id).SetGridTrend(trending).Exec(ctx)
 }
@@ -132,6 +163,67 @@ func (model *StrategyModel) UpdateMaxGridLimit(ctx context.Context, id int, newV
 	return model.client.UpdateOneID(id).SetMaxGridLimit(newValue).Exec(ctx)
 }
 
+func (model *StrategyModel) UpdateGridMode(ctx context.Context, id int, newValue strategy.GridMode) error {
+	return model.client.UpdateOneID(id).SetGridMode(newValue).Exec(ctx)
+}
+
+func (model *StrategyModel) UpdateInventorySeeded(ctx context.Context, id int, newValue bool) error {
+	return model.client.UpdateOneID(id).SetInventorySeeded(newValue).Exec(ctx)
+}
+
+func (model *StrategyModel) UpdateGridType(ctx context.Context, id int, newValue strategy.GridType) error {
+	return model.client.UpdateOneID(id).SetGridType(newValue).Exec(ctx)
+}
+
+func (model *StrategyModel) UpdateGridCount(ctx context.Context, id int, newValue int) error {
+	return model.client.UpdateOneID(id).SetGridCount(newValue).Exec(ctx)
+}
+
+func (model *StrategyModel) UpdatePriceStep(ctx context.Context, id int, newValue decimal.Decimal) error {
+	if newValue.IsZero() {
+		return model.client.UpdateOneID(id).ClearPriceStep().Exec(ctx)
+	}
+	return model.client.UpdateOneID(id).SetPriceStep(newValue).Exec(ctx)
+}
+
+func (model *StrategyModel) UpdateGridLadder(ctx context.Context, id int, newValue string) error {
+	return model.client.UpdateOneID(id).SetGridLadder(newValue).Exec(ctx)
+}
+
+func (model *StrategyModel) UpdateTrailingTakeProfit(ctx context.Context, id int, newValue decimal.Decimal) error {
+	if newValue.IsZero() {
+		return model.client.UpdateOneID(id).ClearTrailingTakeProfit().Exec(ctx)
+	}
+	return model.client.UpdateOneID(id).SetTrailingTakeProfit(newValue).Exec(ctx)
+}
+
+func (model *StrategyModel) UpdateTakeProfitPortion(ctx context.Context, id int, newValue decimal.Decimal) error {
+	if newValue.IsZero() {
+		return model.client.UpdateOneID(id).ClearTakeProfitPortion().Exec(ctx)
+	}
+	return model.client.UpdateOneID(id).SetTakeProfitPortion(newValue).Exec(ctx)
+}
+
+func (model *StrategyModel) UpdateAdaptiveSpacing(ctx context.Context, id int, newValue bool) error {
+	return model.client.UpdateOneID(id).SetAdaptiveSpacing(newValue).Exec(ctx)
+}
+
+func (model *StrategyModel) UpdateAdaptiveMinRatio(ctx context.Context, id int, newValue decimal.Decimal) error {
+	return model.client.UpdateOneID(id).SetAdaptiveMinRatio(newValue).Exec(ctx)
+}
+
+func (model *StrategyModel) UpdateAdaptiveMaxRatio(ctx context.Context, id int, newValue decimal.Decimal) error {
+	return model.client.UpdateOneID(id).SetAdaptiveMaxRatio(newValue).Exec(ctx)
+}
+
+func (model *StrategyModel) UpdateAdaptiveVolatility(ctx context.Context, id int, volatility decimal.Decimal, updateTime time.Time) error {
+	return model.client.UpdateOneID(id).SetAdaptiveVolatility(volatility).SetAdaptiveUpdateTime(updateTime).Exec(ctx)
+}
+
+func (model *StrategyModel) ClearAdaptiveUpdateTime(ctx context.Context, id int) error {
+	return model.client.UpdateOneID(id).ClearAdaptiveUpdateTime().Exec(ctx)
+}
+
 func (model *StrategyModel) UpdateTakeProfitRatio(ctx context.Context, id int, newValue decimal.Decimal) error {
 	return model.client.UpdateOneID(id).SetTakeProfitRatio(newValue).Exec(ctx)
 }
@@ -151,6 +243,32 @@ func (model *StrategyModel) UpdateCandlesToCheck(ctx context.Context, id int, ca
 	return model.client.UpdateOneID(id).SetCandlesToCheck(candlesToCheck).Exec(ctx)
 }
 
+func (model *StrategyModel) UpdateRsiThreshold(ctx context.Context, id int, newValue decimal.Decimal) error {
+	if newValue.IsZero() {
+		return model.client.UpdateOneID(id).ClearRsiThreshold().Exec(ctx)
+	}
+	return model.client.UpdateOneID(id).SetRsiThreshold(newValue).Exec(ctx)
+}
+
+func (model *StrategyModel) UpdateEmaFilter(ctx context.Context, id int, newValue strategy.EmaFilter) error {
+	return model.client.UpdateOneID(id).SetEmaFilter(newValue).Exec(ctx)
+}
+
+func (model *StrategyModel) UpdateEmaPeriod(ctx context.Context, id int, newValue int) error {
+	return model.client.UpdateOneID(id).SetEmaPeriod(newValue).Exec(ctx)
+}
+
+func (model *StrategyModel) UpdateMacdFilter(ctx context.Context, id int, newValue bool) error {
+	return model.client.UpdateOneID(id).SetMacdFilter(newValue).Exec(ctx)
+}
+
+func (model *StrategyModel) UpdateBuyFilterReason(ctx context.Context, id int, reason string) error {
+	if reason == "" {
+		return model.client.UpdateOneID(id).ClearBuyFilterReason().Exec(ctx)
+	}
+	return model.client.UpdateOneID(id).SetBuyFilterReason(reason).Exec(ctx)
+}
+
 func (model *StrategyModel) UpdateDropThreshold(ctx context.Context, id int, dropThreshold decimal.Decimal) error {
 	return model.client.UpdateOneID(id).SetDropThreshold(dropThreshold).Exec(ctx)
 }
@@ -199,6 +317,26 @@ func (model *StrategyModel) UpdateLastUpperThresholdAlertTime(ctx context.Contex
 	return model.client.UpdateOneID(id).SetLastUpperThresholdAlertTime(newValue).Exec(ctx)
 }
 
+func (model *StrategyModel) UpdateOutOfRangeTime(ctx context.Context, id int, newValue time.Time) error {
+	return model.client.UpdateOneID(id).SetOutOfRangeTime(newValue).Exec(ctx)
+}
+
+func (model *StrategyModel) ClearOutOfRangeTime(ctx context.Context, id int) error {
+	return model.client.UpdateOneID(id).ClearOutOfRangeTime().Exec(ctx)
+}
+
+func (model *StrategyModel) UpdateTrailingUp(ctx context.Context, id int, newValue bool) error {
+	return model.client.UpdateOneID(id).SetTrailingUp(newValue).Exec(ctx)
+}
+
+func (model *StrategyModel) UpdateTrailingDown(ctx context.Context, id int, newValue bool) error {
+	return model.client.UpdateOneID(id).SetTrailingDown(newValue).Exec(ctx)
+}
+
+func (model *StrategyModel) UpdateTrailingDwellMinutes(ctx context.Context, id int, newValue int) error {
+	return model.client.UpdateOneID(id).SetTrailingDwellMinutes(newValue).Exec(ctx)
+}
+
 func (model *StrategyModel) UpdateGlobalTakeProfitRatio(ctx context.Context, id int, newValue decimal.Decimal) error {
 	return model.client.UpdateOneID(id).SetGlobalTakeProfitRatio(newValue).Exec(ctx)
 }
@@ -3,20 +3,139 @@ package strategy
 import (
 	"context"
 	"errors"
+	"math/big"
+	"slices"
 	"strconv"
 	"strings"
 
+	"github.com/fachebot/evm-grid-bot/internal/dexagg"
 	"github.com/fachebot/evm-grid-bot/internal/ent"
+	"github.com/fachebot/evm-grid-bot/internal/ent/grid"
 	"github.com/fachebot/evm-grid-bot/internal/ent/order"
+	entstrategy "github.com/fachebot/evm-grid-bot/internal/ent/strategy"
 	"github.com/fachebot/evm-grid-bot/internal/logger"
+	"github.com/fachebot/evm-grid-bot/internal/model"
 	"github.com/fachebot/evm-grid-bot/internal/svc"
 	"github.com/fachebot/evm-grid-bot/internal/swap"
+	"github.com/fachebot/evm-grid-bot/internal/utils"
 	"github.com/fachebot/evm-grid-bot/internal/utils/evm"
 
 	"github.com/samber/lo"
 	"github.com/shopspring/decimal"
 )
 
+// 计算网格买入金额, 低于首个成交网格时按马丁系数加倍
+// 库存网格和超出网格列表的网格不是买入成交的网格, 不参与计算
+func calculateOrderSize(strategyRecord *ent.Strategy, gridRecords []*ent.Grid, gridCount, gridNumber int) decimal.Decimal {
+	if level, ok := getGridLevel(strategyRecord, gridNumber); ok {
+		return level.OrderSize
+	}
+
+	gridRecords = lo.Filter(gridRecords, func(item *ent.Grid, _ int) bool {
+		return item.SellPrice == nil && isGridInRange(item) && item.GridNumber < gridCount
+	})
+	if len(gridRecords) == 0 {
+		return strategyRecord.InitialOrderSize
+	}
+
+	firstGrid := lo.MaxBy(gridRecords, func(a, b *ent.Grid) bool {
+		return a.GridNumber > b.GridNumber
+	})
+	return utils.CalculateMartinOrderSize(strategyRecord.InitialOrderSize, strategyRecord.MartinFactor, firstGrid.GridNumber-gridNumber)
+}
+
+// 计算等差网格价格步长
+func CalculatePriceStep(strategyRecord *ent.Strategy) decimal.Decimal {
+	if strategyRecord.PriceStep != nil && strategyRecord.PriceStep.GreaterThan(decimal.Zero) {
+		return *strategyRecord.PriceStep
+	}
+	if strategyRecord.GridCount != nil {
+		return utils.CalculateArithmeticPriceStep(strategyRecord.LowerPriceBound, strategyRecord.UpperPriceBound, *strategyRecord.GridCount)
+	}
+	return decimal.Zero
+}
+
+// 生成策略网格列表
+func GenerateGridList(strategyRecord *ent.Strategy) ([]decimal.Decimal, error) {
+	switch strategyRecord.GridType {
+	case entstrategy.GridTypeArithmetic:
+		return utils.GenerateArithmeticGrid(strategyRecord.LowerPriceBound, strategyRecord.UpperPriceBound, CalculatePriceStep(strategyRecord))
+	case entstrategy.GridTypeCustom:
+		levels := DecodeGridLadder(strategyRecord.GridLadder)
+		if err := ValidateGridLadder(levels, strategyRecord.LowerPriceBound, strategyRecord.UpperPriceBound); err != nil {
+			return nil, err
+		}
+		return lo.Map(levels, func(item GridLevel, idx int) decimal.Decimal {
+			return item.Price
+		}), nil
+	}
+	return utils.GenerateGrid(
+		strategyRecord.LowerPriceBound, strategyRecord.UpperPriceBound, strategyRecord.TakeProfitRatio.Div(decimal.NewFromInt(100)))
+}
+
+// 计算网格止盈利润, 等比网格按比例, 等差网格按步长, 自定义阶梯按档位比例
+func calculateGridProfit(strategyRecord *ent.Strategy, gridNumber int, price decimal.Decimal) decimal.Decimal {
+	if strategyRecord.GridType == entstrategy.GridTypeArithmetic {
+		return CalculatePriceStep(strategyRecord)
+	}
+	if level, ok := getGridLevel(strategyRecord, gridNumber); ok {
+		return price.Mul(level.TakeProfitRatio.Div(decimal.NewFromInt(100)))
+	}
+	return price.Mul(strategyRecord.TakeProfitRatio.Div(decimal.NewFromInt(100)))
+}
+
+// 计算网格止盈价格, 库存网格按预设卖出价格, 调整间隔前买入的网格按原止盈价格
+func calculateTakeProfitPrice(strategyRecord *ent.Strategy, gridRecord *ent.Grid) decimal.Decimal {
+	if gridRecord.SellPrice != nil {
+		return *gridRecord.SellPrice
+	}
+	if gridRecord.TakeProfitPrice != nil {
+		return *gridRecord.TakeProfitPrice
+	}
+	return gridRecord.FinalPrice.Add(calculateGridProfit(strategyRecord, gridRecord.GridNumber, gridRecord.FinalPrice))
+}
+
+// 固定已买入网格的止盈价格, 避免调整网格参数后止盈目标变化
+func fixTakeProfitPrices(ctx context.Context, gridModel *model.GridModel, strategyRecord *ent.Strategy, gridRecords []*ent.Grid) error {
+	for _, item := range gridRecords {
+		if item.Status != grid.StatusBought || item.SellPrice != nil || item.TakeProfitPrice != nil {
+			continue
+		}
+		if err := gridModel.UpdateTakeProfitPrice(ctx, item.GUID, calculateTakeProfitPrice(strategyRecord, item)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 计算策略最坏情况所需资金
+func CalculateWorstCaseCapital(strategyRecord *ent.Strategy) (decimal.Decimal, error) {
+	gridList, err := GenerateGridList(strategyRecord)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	maxGrids := 0
+	if strategyRecord.MaxGridLimit != nil {
+		maxGrids = *strategyRecord.MaxGridLimit
+	}
+
+	// 自定义阶梯按金额最大的档位累计
+	if strategyRecord.GridType == entstrategy.GridTypeCustom {
+		orderSizes := lo.Map(DecodeGridLadder(strategyRecord.GridLadder), func(item GridLevel, idx int) decimal.Decimal {
+			return item.OrderSize
+		})
+		slices.SortFunc(orderSizes, func(a, b decimal.Decimal) int {
+			return b.Cmp(a)
+		})
+		if maxGrids > 0 && maxGrids < len(orderSizes) {
+			orderSizes = orderSizes[:maxGrids]
+		}
+		return decimal.Sum(decimal.Zero, orderSizes...), nil
+	}
+	return utils.CalculateWorstCaseCapital(strategyRecord.InitialOrderSize, strategyRecord.MartinFactor, len(gridList), maxGrids), nil
+}
+
 func isDowntrend(trending []int) bool {
 	if len((trending)) < 2 {
 		return false
@@ -76,14 +195,71 @@ func updateGridTrend(trending []int, gridNumber int) []int {
 
 func isMinGridNumber(gridRecords []*ent.Grid, gridNumber int) bool {
 	for _, item := range gridRecords {
-		if item.GridNumber < gridNumber {
+		if isGridInRange(item) && item.GridNumber < gridNumber {
 			return false
 		}
 	}
 	return true
 }
 
-func SellToken(ctx context.Context, svcCtx *svc.ServiceContext, strategyRecord *ent.Strategy, title string, uiSellAmount, minSellPrice *decimal.Decimal, exit bool) (ent.Order, error) {
+// 广播交易前保存 submitting 状态的订单, 广播成功后由调用方在同一事务内更新业务数据并设置为 pending
+func SubmitSwap(ctx context.Context, svcCtx *svc.ServiceContext, tx swap.SwapTransaction, orderArgs *ent.Order) error {
+	orderArgs.Status = order.StatusSubmitting
+
+	// 记录成交路由
+	route := tx.Route()
+	if route.Route != "" {
+		orderArgs.Route = &route.Route
+	}
+	if route.RunnerUp != "" {
+		orderArgs.RunnerUpRoute = &route.RunnerUp
+		orderArgs.RouteSpreadUsd = route.SpreadUsd
+	}
+
+	hash, nonce, err := tx.Swap(ctx, func(ctx context.Context, hash string, nonce uint64, rawTx string) error {
+		orderArgs.TxHash = hash
+		orderArgs.Nonce = nonce
+		if rawTx != "" {
+			orderArgs.RawTx = &rawTx
+		}
+
+		ord, err := svcCtx.OrderModel.Save(ctx, *orderArgs)
+		if err != nil {
+			return err
+		}
+		orderArgs.ID = ord.ID
+		return nil
+	})
+	if err != nil {
+		// 模拟执行失败, 交易未广播, 记录失败原因
+		var simErr *dexagg.SimulationError
+		if orderArgs.ID == 0 && errors.As(err, &simErr) {
+			orderArgs.Status = order.StatusRejected
+			orderArgs.Reason = lo.Substring(err.Error(), 0, 500)
+			if _, e := svcCtx.OrderModel.Save(ctx, *orderArgs); e != nil {
+				logger.Errorf("[SubmitSwap] 保存模拟失败订单失败, strategy: %s, %v", orderArgs.StrategyId, e)
+			}
+			return err
+		}
+
+		// 订单已保存后广播失败, 交易可能已进入交易池, 保持 submitting 状态由 OrderKeeper 对账
+		if orderArgs.ID != 0 {
+			logger.Warnf("[SubmitSwap] 广播交易失败, 等待对账, id: %d, hash: %s, %v", orderArgs.ID, orderArgs.TxHash, err)
+		}
+		return err
+	}
+
+	orderArgs.TxHash = hash
+	orderArgs.Nonce = nonce
+	return nil
+}
+
+func SellToken(ctx context.Context, svcCtx *svc.ServiceContext, strategyRecord *ent.Strategy, title string, uiSellAmount, minSellPrice *decimal.Decimal, exit bool, prepare func(orderArgs *ent.Order)) (ent.Order, error) {
+	return sellToken(ctx, svcCtx, NewEnvironment(svcCtx, strategyRecord), strategyRecord, title, uiSellAmount, minSellPrice, exit, prepare)
+}
+
+// 卖出代币, prepare 用于在广播前补充订单的网格信息
+func sellToken(ctx context.Context, svcCtx *svc.ServiceContext, env Environment, strategyRecord *ent.Strategy, title string, uiSellAmount, minSellPrice *decimal.Decimal, exit bool, prepare func(orderArgs *ent.Order)) (ent.Order, error) {
 	// 获取用户钱包
 	w, err := svcCtx.WalletModel.FindByUserId(ctx, strategyRecord.UserId)
 	if err != nil {
@@ -92,14 +268,14 @@ func SellToken(ctx context.Context, svcCtx *svc.ServiceContext, strategyRecord *
 	}
 
 	// 获取代币余额
-	tokenmeta, err := svcCtx.TokenMetaCache.GetTokenMeta(ctx, strategyRecord.Token)
+	tokenmeta, err := env.GetTokenMeta(ctx, strategyRecord.Token)
 	if err != nil {
 		logger.Debugf("[GridStrategy] %s - 获取元数据失败, token: %s, %v", title, strategyRecord.Token, err)
 		return ent.Order{}, err
 	}
 
 	// 获取代币余额
-	tokenBalance, err := evm.GetTokenBalance(ctx, svcCtx.EthClient, strategyRecord.Token, w.Account)
+	tokenBalance, err := env.GetTokenBalance(ctx, strategyRecord.Token, w.Account)
 	if err != nil {
 		logger.Debugf("[GridStrategy] %s - 获取代币余额失败, token: %s, %v", title, strategyRecord.Token, err)
 		return ent.Order{}, err
@@ -115,8 +291,7 @@ func SellToken(ctx context.Context, svcCtx *svc.ServiceContext, strategyRecord *
 
 	// 获取报价
 	sellAmount := evm.FormatUnits(*uiSellAmount, tokenmeta.Decimals)
-	swapService := swap.NewSwapService(svcCtx, w.UserId)
-	tx, err := swapService.Quote(ctx, strategyRecord.Token, svcCtx.Config.Chain.StablecoinCA, sellAmount, exit)
+	tx, err := env.Quote(ctx, w.UserId, strategyRecord.Token, svcCtx.Config.Chain.StablecoinCA, sellAmount, exit)
 	if err != nil {
 		logger.Errorf("[GridStrategy] %s - 获取报价失败, in: %s, out: %s, amount: %s, %v",
 			title, strategyRecord.Symbol, svcCtx.Config.Chain.StablecoinSymbol, uiSellAmount, err)
@@ -130,17 +305,6 @@ func SellToken(ctx context.Context, svcCtx *svc.ServiceContext, strategyRecord *
 		return ent.Order{}, errors.New("price too low")
 	}
 
-	// 发送交易
-	hash, nonce, err := tx.Swap(ctx)
-	if err != nil {
-		logger.Errorf("[GridStrategy] %s - 发送交易失败, user: %d, inToken: %s, inputAmount: %s, outAmount: %s, hash: %s, %v",
-			title, w.UserId, strategyRecord.Symbol, uiSellAmount, uiOutAmount, hash, err)
-		return ent.Order{}, err
-	}
-
-	logger.Infof("[GridStrategy] %s - 提交交易成功, user: %d, inToken: %s, inputAmount: %s, outAmount: %s, hash: %s",
-		title, w.UserId, strategyRecord.Symbol, uiSellAmount, uiOutAmount, hash)
-
 	// 订单记录
 	orderArgs := ent.Order{
 		Account:    tx.Signer(),
@@ -152,9 +316,34 @@ func SellToken(ctx context.Context, svcCtx *svc.ServiceContext, strategyRecord *
 		FinalPrice: quotePrice,
 		InAmount:   *uiSellAmount,
 		OutAmount:  uiOutAmount,
-		Status:     order.StatusPending,
-		Nonce:      nonce,
-		TxHash:     hash,
+		Paper:      strategyRecord.PaperTrading,
+	}
+	if prepare != nil {
+		prepare(&orderArgs)
+	}
+
+	// 报价过期重新报价时, 重新检查最低卖出价格并更新订单
+	tx.SetPriceGuard(func(outAmount *big.Int) error {
+		uiOutAmount := evm.ParseUnits(outAmount, svcCtx.Config.Chain.StablecoinDecimals)
+		quotePrice := uiOutAmount.Div(*uiSellAmount)
+		if minSellPrice != nil && quotePrice.LessThan(*minSellPrice) {
+			return errors.New("price too low")
+		}
+
+		orderArgs.Price, orderArgs.FinalPrice, orderArgs.OutAmount = quotePrice, quotePrice, uiOutAmount
+		return nil
+	})
+
+	// 发送交易
+	err = SubmitSwap(ctx, svcCtx, tx, &orderArgs)
+	if err != nil {
+		logger.Errorf("[GridStrategy] %s - 发送交易失败, user: %d, inToken: %s, inputAmount: %s, outAmount: %s, hash: %s, %v",
+			title, w.UserId, strategyRecord.Symbol, uiSellAmount, uiOutAmount, orderArgs.TxHash, err)
+		return ent.Order{}, err
 	}
+
+	logger.Infof("[GridStrategy] %s - 提交交易成功, user: %d, inToken: %s, inputAmount: %s, outAmount: %s, hash: %s",
+		title, w.UserId, strategyRecord.Symbol, uiSellAmount, uiOutAmount, orderArgs.TxHash)
+
 	return orderArgs, nil
 }
@@ -0,0 +1,80 @@
+package strategy
+
+import (
+	"testing"
+
+	"github.com/fachebot/evm-grid-bot/internal/ent"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestCalculateOrderSize(t *testing.T) {
+	strategyRecord := &ent.Strategy{
+		InitialOrderSize: decimal.NewFromInt(10),
+		MartinFactor:     2,
+	}
+	sellPrice := decimal.NewFromInt(100)
+
+	tests := []struct {
+		name       string
+		grids      []*ent.Grid
+		gridNumber int
+		gridCount  int
+		expected   string
+	}{
+		{
+			name:       "没有网格",
+			gridNumber: 5,
+			gridCount:  20,
+			expected:   "10",
+		},
+		{
+			name:       "低于首个成交网格两格",
+			grids:      []*ent.Grid{{GridNumber: 8}, {GridNumber: 7}},
+			gridNumber: 6,
+			gridCount:  20,
+			expected:   "40",
+		},
+		{
+			name: "中性网格的库存网格不参与计算",
+			grids: []*ent.Grid{
+				{GridNumber: 18, SellPrice: &sellPrice},
+				{GridNumber: 17, SellPrice: &sellPrice},
+				{GridNumber: 8},
+			},
+			gridNumber: 7,
+			gridCount:  20,
+			expected:   "20",
+		},
+		{
+			name: "只有库存网格时按初始金额买入",
+			grids: []*ent.Grid{
+				{GridNumber: 18, SellPrice: &sellPrice},
+				{GridNumber: 10, SellPrice: &sellPrice},
+			},
+			gridNumber: 9,
+			gridCount:  20,
+			expected:   "10",
+		},
+		{
+			name: "超出网格列表的网格不参与计算",
+			grids: []*ent.Grid{
+				{GridNumber: 20},
+				{GridNumber: -1},
+				{GridNumber: 6},
+			},
+			gridNumber: 5,
+			gridCount:  20,
+			expected:   "20",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := calculateOrderSize(strategyRecord, tt.grids, tt.gridCount, tt.gridNumber)
+			if !result.Equal(decimal.RequireFromString(tt.expected)) {
+				t.Errorf("calculateOrderSize() = %v, expected %v", result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package strategy
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/fachebot/evm-grid-bot/internal/cache"
+	"github.com/fachebot/evm-grid-bot/internal/ent"
+	"github.com/fachebot/evm-grid-bot/internal/ent/grid"
+	"github.com/fachebot/evm-grid-bot/internal/svc"
+	"github.com/fachebot/evm-grid-bot/internal/swap"
+	"github.com/fachebot/evm-grid-bot/internal/utils"
+	"github.com/fachebot/evm-grid-bot/internal/utils/evm"
+
+	"github.com/shopspring/decimal"
+)
+
+type ExitRule string
+
+const (
+	ExitRuleWaterfallDrop       ExitRule = "waterfallDrop"
+	ExitRuleUpperBoundExit      ExitRule = "upperBoundExit"
+	ExitRuleGlobalTakeProfit    ExitRule = "globalTakeProfit"
+	ExitRuleTakeProfitAtTarget  ExitRule = "takeProfitAtTarget"
+	ExitRuleStopLossAtThreshold ExitRule = "stopLossAtThreshold"
+	ExitRulePriceRangeStopLoss  ExitRule = "priceRangeStopLoss"
+)
+
+// 策略运行环境, 回测时使用模拟实现
+type Environment interface {
+	GetTokenMeta(ctx context.Context, token string) (cache.TokenMeta, error)
+	GetTokenBalance(ctx context.Context, token, account string) (*big.Int, error)
+	Quote(ctx context.Context, userId int64, inputToken, outputToken string, amount *big.Int, exit bool) (swap.SwapTransaction, error)
+	SendMessage(ctx context.Context, userId int64, text string) error
+	StopStrategy(ctx context.Context, strategyId string, rule ExitRule)
+}
+
+type liveEnvironment struct {
+	svcCtx *svc.ServiceContext
+}
+
+func NewEnvironment(svcCtx *svc.ServiceContext, s *ent.Strategy) Environment {
+	if s.PaperTrading {
+		return NewPaperEnvironment(svcCtx, s.GUID)
+	}
+	return NewLiveEnvironment(svcCtx)
+}
+
+func NewLiveEnvironment(svcCtx *svc.ServiceContext) Environment {
+	return &liveEnvironment{svcCtx: svcCtx}
+}
+
+func (env *liveEnvironment) GetTokenMeta(ctx context.Context, token string) (cache.TokenMeta, error) {
+	return env.svcCtx.TokenMetaCache.GetTokenMeta(ctx, token)
+}
+
+func (env *liveEnvironment) GetTokenBalance(ctx context.Context, token, account string) (*big.Int, error) {
+	return evm.GetTokenBalance(ctx, env.svcCtx.EthClient, token, account)
+}
+
+func (env *liveEnvironment) Quote(ctx context.Context, userId int64, inputToken, outputToken string, amount *big.Int, exit bool) (swap.SwapTransaction, error) {
+	return swap.NewSwapService(env.svcCtx, userId).Quote(ctx, inputToken, outputToken, amount, exit)
+}
+
+func (env *liveEnvironment) SendMessage(ctx context.Context, userId int64, text string) error {
+	_, err := utils.SendMessage(env.svcCtx.BotApi, userId, text)
+	return err
+}
+
+func (env *liveEnvironment) StopStrategy(ctx context.Context, strategyId string, rule ExitRule) {
+	env.svcCtx.Engine.StopStrategy(strategyId)
+}
+
+type paperEnvironment struct {
+	liveEnvironment
+	strategyId string
+}
+
+func NewPaperEnvironment(svcCtx *svc.ServiceContext, strategyId string) Environment {
+	return &paperEnvironment{liveEnvironment: liveEnvironment{svcCtx: svcCtx}, strategyId: strategyId}
+}
+
+func (env *paperEnvironment) GetTokenBalance(ctx context.Context, token, account string) (*big.Int, error) {
+	tokenmeta, err := env.GetTokenMeta(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	// 模拟持仓等于已买入网格数量
+	gridRecords, err := env.svcCtx.GridModel.FindByStrategyId(ctx, env.strategyId)
+	if err != nil {
+		return nil, err
+	}
+
+	uiBalance := decimal.Zero
+	for _, item := range gridRecords {
+		if item.Status != grid.StatusBought {
+			continue
+		}
+		uiBalance = uiBalance.Add(item.Quantity)
+	}
+	return evm.FormatUnits(uiBalance, tokenmeta.Decimals), nil
+}
+
+func (env *paperEnvironment) Quote(ctx context.Context, userId int64, inputToken, outputToken string, amount *big.Int, exit bool) (swap.SwapTransaction, error) {
+	return swap.NewPaperSwapService(env.svcCtx, userId).Quote(ctx, inputToken, outputToken, amount, exit)
+}
@@ -0,0 +1,134 @@
+package strategy
+
+import (
+	"context"
+	"time"
+
+	"github.com/fachebot/evm-grid-bot/internal/charts"
+	"github.com/fachebot/evm-grid-bot/internal/ent"
+	"github.com/fachebot/evm-grid-bot/internal/ent/grid"
+	entstrategy "github.com/fachebot/evm-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/evm-grid-bot/internal/logger"
+	"github.com/fachebot/evm-grid-bot/internal/model"
+	"github.com/fachebot/evm-grid-bot/internal/utils"
+
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	adaptiveAtrPeriod     = 14
+	adaptiveAtrMultiplier = 2
+	adaptiveInterval      = 30 * time.Minute
+	// 未设置最小间隔时的默认最小间隔%, 避免间隔过小生成过多网格
+	defaultAdaptiveMinRatio = 0.5
+)
+
+// 根据ATR计算网格间隔%, 间隔为 ATR 占价格比例的倍数, 并限制在最小和最大间隔之间
+func CalculateAdaptiveRatio(strategyRecord *ent.Strategy, ohlcs []charts.Ohlc) (volatility, ratio decimal.Decimal, ok bool) {
+	if len(ohlcs) <= adaptiveAtrPeriod {
+		return decimal.Zero, decimal.Zero, false
+	}
+	latestPrice := ohlcs[len(ohlcs)-1].Close
+	if latestPrice.LessThanOrEqual(decimal.Zero) {
+		return decimal.Zero, decimal.Zero, false
+	}
+
+	atr := charts.CalculateATR(ohlcs, adaptiveAtrPeriod)
+	volatility = decimal.NewFromFloat(atr[len(atr)-1]).Div(latestPrice).Mul(decimal.NewFromInt(100))
+	ratio = volatility.Mul(decimal.NewFromInt(adaptiveAtrMultiplier)).Round(2)
+	minRatio := decimal.NewFromFloat(defaultAdaptiveMinRatio)
+	if strategyRecord.AdaptiveMinRatio != nil {
+		minRatio = *strategyRecord.AdaptiveMinRatio
+	}
+	if ratio.LessThan(minRatio) {
+		ratio = minRatio
+	}
+	if strategyRecord.AdaptiveMaxRatio != nil && ratio.GreaterThan(*strategyRecord.AdaptiveMaxRatio) {
+		ratio = *strategyRecord.AdaptiveMaxRatio
+	}
+	return volatility, ratio, ratio.GreaterThan(decimal.Zero)
+}
+
+// 定期根据波动率调整网格间隔, 已买入网格保持原止盈价格, 调整后返回 true
+func (s *GridStrategy) handleAdaptiveSpacing(ctx context.Context, strategyRecord *ent.Strategy, gridRecords []*ent.Grid, ohlcs []charts.Ohlc) bool {
+	if !strategyRecord.AdaptiveSpacing || strategyRecord.GridType == entstrategy.GridTypeCustom {
+		return false
+	}
+
+	now := ohlcs[len(ohlcs)-1].Time
+	if strategyRecord.AdaptiveUpdateTime != nil && now.Sub(*strategyRecord.AdaptiveUpdateTime) < adaptiveInterval {
+		return false
+	}
+
+	// 买入中的网格成交价格未知, 成交后再调整
+	if lo.SomeBy(gridRecords, func(item *ent.Grid) bool { return item.Status == grid.StatusBuying }) {
+		return false
+	}
+
+	volatility, ratio, ok := CalculateAdaptiveRatio(strategyRecord, ohlcs)
+	if !ok {
+		return false
+	}
+
+	// 计算新的网格间隔
+	latestPrice := ohlcs[len(ohlcs)-1].Close
+	newRecord := *strategyRecord
+	if strategyRecord.GridType == entstrategy.GridTypeArithmetic {
+		priceStep := roundSignificant(latestPrice.Mul(ratio).Div(decimal.NewFromInt(100)), trailingSignificantNum)
+		newRecord.PriceStep = &priceStep
+	} else {
+		newRecord.TakeProfitRatio = ratio
+	}
+	changed := !newRecord.TakeProfitRatio.Equal(strategyRecord.TakeProfitRatio) ||
+		!CalculatePriceStep(&newRecord).Equal(CalculatePriceStep(strategyRecord))
+
+	gridList, err := GenerateGridList(&newRecord)
+	if err != nil {
+		logger.Errorf("[GridStrategy] 自适应间隔 - 生成网格列表失败, strategy: %s, ratio: %v, %v", strategyRecord.GUID, ratio, err)
+
+		// 记录本次调整时间, 避免每次都重新计算
+		err = s.svcCtx.StrategyModel.UpdateAdaptiveVolatility(ctx, strategyRecord.ID, volatility.Round(4), now)
+		if err != nil {
+			logger.Errorf("[GridStrategy] 自适应间隔 - 更新波动率失败, strategy: %s, %v", strategyRecord.GUID, err)
+		}
+		return false
+	}
+
+	err = utils.Tx(ctx, s.svcCtx.DbClient, func(tx *ent.Tx) error {
+		strategyModel := model.NewStrategyModel(tx.Strategy)
+		if err := strategyModel.UpdateAdaptiveVolatility(ctx, strategyRecord.ID, volatility.Round(4), now); err != nil {
+			return err
+		}
+		if !changed {
+			return nil
+		}
+
+		// 固定已买入网格的止盈价格
+		gridModel := model.NewGridModel(tx.Grid)
+		if err := fixTakeProfitPrices(ctx, gridModel, strategyRecord, gridRecords); err != nil {
+			return err
+		}
+
+		if strategyRecord.GridType == entstrategy.GridTypeArithmetic {
+			if err := strategyModel.UpdatePriceStep(ctx, strategyRecord.ID, *newRecord.PriceStep); err != nil {
+				return err
+			}
+		} else {
+			if err := strategyModel.UpdateTakeProfitRatio(ctx, strategyRecord.ID, ratio); err != nil {
+				return err
+			}
+		}
+		return renumberGrids(ctx, gridModel, gridRecords, gridList)
+	})
+	if err != nil {
+		logger.Errorf("[GridStrategy] 自适应间隔 - 更新网格间隔失败, strategy: %s, %v", strategyRecord.GUID, err)
+		return false
+	}
+
+	logger.Infof("[GridStrategy] 自适应间隔, strategy: %s, price: %v, volatility: %v%%, takeProfitRatio: %v -> %v, priceStep: %v -> %v, grids: %d",
+		strategyRecord.GUID, latestPrice, volatility.Round(4), strategyRecord.TakeProfitRatio, newRecord.TakeProfitRatio,
+		CalculatePriceStep(strategyRecord), CalculatePriceStep(&newRecord), len(gridList))
+
+	return changed
+}
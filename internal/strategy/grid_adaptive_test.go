@@ -0,0 +1,83 @@
+package strategy
+
+import (
+	"testing"
+
+	"github.com/fachebot/evm-grid-bot/internal/charts"
+	"github.com/fachebot/evm-grid-bot/internal/ent"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestCalculateAdaptiveRatio(t *testing.T) {
+	// 生成价格为 100, 每根K线波动为 spread 的K线
+	newOhlcs := func(spread string) []charts.Ohlc {
+		ohlcs := make([]charts.Ohlc, 0, adaptiveAtrPeriod+5)
+		half := decimal.RequireFromString(spread).Div(decimal.NewFromInt(2))
+		for range adaptiveAtrPeriod + 5 {
+			price := decimal.NewFromInt(100)
+			ohlcs = append(ohlcs, charts.Ohlc{Open: price, Close: price, High: price.Add(half), Low: price.Sub(half)})
+		}
+		return ohlcs
+	}
+	ptr := func(s string) *decimal.Decimal {
+		d := decimal.RequireFromString(s)
+		return &d
+	}
+
+	tests := []struct {
+		name     string
+		record   *ent.Strategy
+		ohlcs    []charts.Ohlc
+		expected string
+		ok       bool
+	}{
+		{
+			name:     "K线数量不足",
+			record:   &ent.Strategy{},
+			ohlcs:    newOhlcs("1")[:adaptiveAtrPeriod],
+			expected: "0",
+			ok:       false,
+		},
+		{
+			name:     "间隔为波动率的倍数",
+			record:   &ent.Strategy{},
+			ohlcs:    newOhlcs("1"),
+			expected: "2",
+			ok:       true,
+		},
+		{
+			name:     "未设置最小间隔时使用默认最小间隔",
+			record:   &ent.Strategy{},
+			ohlcs:    newOhlcs("0.005"),
+			expected: "0.5",
+			ok:       true,
+		},
+		{
+			name:     "限制最小间隔",
+			record:   &ent.Strategy{AdaptiveMinRatio: ptr("3")},
+			ohlcs:    newOhlcs("1"),
+			expected: "3",
+			ok:       true,
+		},
+		{
+			name:     "限制最大间隔",
+			record:   &ent.Strategy{AdaptiveMaxRatio: ptr("1.5")},
+			ohlcs:    newOhlcs("1"),
+			expected: "1.5",
+			ok:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, ratio, ok := CalculateAdaptiveRatio(tt.record, tt.ohlcs)
+			if ok != tt.ok {
+				t.Fatalf("CalculateAdaptiveRatio() ok = %v, expected %v", ok, tt.ok)
+			}
+			if !ratio.Equal(decimal.RequireFromString(tt.expected)) {
+				t.Errorf("CalculateAdaptiveRatio() = %v, expected %v", ratio, tt.expected)
+			}
+		})
+	}
+}
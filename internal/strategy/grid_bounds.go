@@ -0,0 +1,53 @@
+package strategy
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/fachebot/evm-grid-bot/internal/charts"
+
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	SuggestMinCandles     = 30
+	suggestLowPercentile  = 0.05
+	suggestHighPercentile = 0.95
+	suggestAtrMultiplier  = 2
+	suggestSignificantNum = 5
+)
+
+// 计算分位数
+func percentile(values []decimal.Decimal, p float64) decimal.Decimal {
+	sorted := slices.Clone(values)
+	slices.SortFunc(sorted, func(a, b decimal.Decimal) int {
+		return a.Cmp(b)
+	})
+	return sorted[int(float64(len(sorted)-1)*p)]
+}
+
+// 根据最近K线建议价格区间: 最低价和最高价的 5%~95% 分位, 再向外扩展 2 倍ATR
+func SuggestPriceBounds(ohlcs []charts.Ohlc) (lowerPriceBound, upperPriceBound decimal.Decimal, err error) {
+	if len(ohlcs) < SuggestMinCandles {
+		return decimal.Zero, decimal.Zero, errors.New("not enough candles")
+	}
+
+	lows := lo.Map(ohlcs, func(item charts.Ohlc, idx int) decimal.Decimal { return item.Low })
+	highs := lo.Map(ohlcs, func(item charts.Ohlc, idx int) decimal.Decimal { return item.High })
+	low := percentile(lows, suggestLowPercentile)
+	high := percentile(highs, suggestHighPercentile)
+	if low.LessThanOrEqual(decimal.Zero) || high.LessThanOrEqual(low) {
+		return decimal.Zero, decimal.Zero, errors.New("invalid candles")
+	}
+
+	atr := charts.CalculateATR(ohlcs, adaptiveAtrPeriod)
+	margin := decimal.NewFromFloat(atr[len(atr)-1]).Mul(decimal.NewFromInt(suggestAtrMultiplier))
+	lowerPriceBound = low.Sub(margin)
+	if lowerPriceBound.LessThanOrEqual(decimal.Zero) {
+		lowerPriceBound = low.Div(decimal.NewFromInt(2))
+	}
+	upperPriceBound = high.Add(margin)
+
+	return roundSignificant(lowerPriceBound, suggestSignificantNum), roundSignificant(upperPriceBound, suggestSignificantNum), nil
+}
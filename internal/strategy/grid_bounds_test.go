@@ -0,0 +1,117 @@
+package strategy
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/fachebot/evm-grid-bot/internal/charts"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestPercentile(t *testing.T) {
+	values := []decimal.Decimal{
+		decimal.NewFromInt(5), decimal.NewFromInt(1), decimal.NewFromInt(4), decimal.NewFromInt(2), decimal.NewFromInt(3),
+	}
+	original := slices.Clone(values)
+
+	tests := []struct {
+		name     string
+		p        float64
+		expected string
+	}{
+		{
+			name:     "最小值",
+			p:        0,
+			expected: "1",
+		},
+		{
+			name:     "中位数",
+			p:        0.5,
+			expected: "3",
+		},
+		{
+			name:     "向下取整",
+			p:        0.95,
+			expected: "4",
+		},
+		{
+			name:     "最大值",
+			p:        1,
+			expected: "5",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := percentile(values, tt.p)
+			if !result.Equal(decimal.RequireFromString(tt.expected)) {
+				t.Errorf("percentile() = %v, expected %v", result, tt.expected)
+			}
+			if !slices.Equal(values, original) {
+				t.Errorf("percentile() modified input: %v", values)
+			}
+		})
+	}
+}
+
+func TestSuggestPriceBounds(t *testing.T) {
+	// 生成 count 根最低价为 low, 最高价为 high 的K线
+	newOhlcs := func(count int, low, high string) []charts.Ohlc {
+		ohlcs := make([]charts.Ohlc, 0, count)
+		lowPrice, highPrice := decimal.RequireFromString(low), decimal.RequireFromString(high)
+		closePrice := lowPrice.Add(highPrice).Div(decimal.NewFromInt(2))
+		for range count {
+			ohlcs = append(ohlcs, charts.Ohlc{Open: closePrice, Close: closePrice, High: highPrice, Low: lowPrice})
+		}
+		return ohlcs
+	}
+
+	tests := []struct {
+		name     string
+		ohlcs    []charts.Ohlc
+		expected [2]string
+		err      bool
+	}{
+		{
+			name:     "向外扩展两倍ATR",
+			ohlcs:    newOhlcs(SuggestMinCandles, "95", "105"),
+			expected: [2]string{"75", "125"},
+		},
+		{
+			name:     "下限扩展后不为正数时取最低价的一半",
+			ohlcs:    newOhlcs(SuggestMinCandles, "10", "50"),
+			expected: [2]string{"5", "130"},
+		},
+		{
+			name:     "保留五位有效数字",
+			ohlcs:    newOhlcs(SuggestMinCandles, "0.00123456", "0.00133456"),
+			expected: [2]string{"0.0010346", "0.0015346"},
+		},
+		{
+			name:  "K线数量不足",
+			ohlcs: newOhlcs(SuggestMinCandles-1, "95", "105"),
+			err:   true,
+		},
+		{
+			name:  "最低价为零",
+			ohlcs: newOhlcs(SuggestMinCandles, "0", "105"),
+			err:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lower, upper, err := SuggestPriceBounds(tt.ohlcs)
+			if (err != nil) != tt.err {
+				t.Fatalf("SuggestPriceBounds() error = %v, expected error %v", err, tt.err)
+			}
+			if tt.err {
+				return
+			}
+			if !lower.Equal(decimal.RequireFromString(tt.expected[0])) || !upper.Equal(decimal.RequireFromString(tt.expected[1])) {
+				t.Errorf("SuggestPriceBounds() = %v, %v, expected %v, %v", lower, upper, tt.expected[0], tt.expected[1])
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package strategy
+
+import (
+	"fmt"
+
+	"github.com/fachebot/evm-grid-bot/internal/charts"
+	"github.com/fachebot/evm-grid-bot/internal/ent"
+	entstrategy "github.com/fachebot/evm-grid-bot/internal/ent/strategy"
+)
+
+const (
+	defaultEmaPeriod = 20
+	rsiPeriod        = 14
+	macdCandles      = 26 + 9
+)
+
+func GetEmaPeriod(strategyRecord *ent.Strategy) int {
+	if strategyRecord.EmaPeriod > 0 {
+		return strategyRecord.EmaPeriod
+	}
+	return defaultEmaPeriod
+}
+
+// 检查指标过滤条件, 返回暂停买入的原因, 全部满足时返回空字符串
+func checkBuyFilters(strategyRecord *ent.Strategy, ohlcs []charts.Ohlc) string {
+	if len(ohlcs) == 0 {
+		return ""
+	}
+	latestPrice := ohlcs[len(ohlcs)-1].Close.InexactFloat64()
+
+	// RSI 低于阈值才允许买入
+	if strategyRecord.RsiThreshold != nil && strategyRecord.RsiThreshold.IsPositive() {
+		if len(ohlcs) <= rsiPeriod {
+			return "K线数据不足, 无法计算RSI"
+		}
+		rsi := charts.CalculateRSI(ohlcs)
+		threshold := strategyRecord.RsiThreshold.InexactFloat64()
+		if latest := rsi[len(rsi)-1]; latest >= threshold {
+			return fmt.Sprintf("RSI %.2f 不低于 %v", latest, threshold)
+		}
+	}
+
+	// 价格位于 EMA 上方或下方
+	if strategyRecord.EmaFilter != entstrategy.EmaFilterOff && strategyRecord.EmaFilter != "" {
+		period := GetEmaPeriod(strategyRecord)
+		if len(ohlcs) < period {
+			return fmt.Sprintf("K线数据不足, 无法计算EMA(%d)", period)
+		}
+		ema := charts.CalculateEMA(ohlcs, period)
+		latest := ema[len(ema)-1]
+		if strategyRecord.EmaFilter == entstrategy.EmaFilterAbove && latestPrice <= latest {
+			return fmt.Sprintf("价格不在 EMA(%d) 上方", period)
+		}
+		if strategyRecord.EmaFilter == entstrategy.EmaFilterBelow && latestPrice >= latest {
+			return fmt.Sprintf("价格不在 EMA(%d) 下方", period)
+		}
+	}
+
+	// MACD 柱状线下降时不买入
+	if strategyRecord.MacdFilter {
+		if len(ohlcs) <= macdCandles {
+			return "K线数据不足, 无法计算MACD"
+		}
+		hist := charts.CalculateMACDHist(ohlcs)
+		if hist[len(hist)-1] < hist[len(hist)-2] {
+			return "MACD 柱状线下降"
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,84 @@
+package strategy
+
+import (
+	"context"
+
+	"github.com/fachebot/evm-grid-bot/internal/ent"
+	"github.com/fachebot/evm-grid-bot/internal/ent/grid"
+	"github.com/fachebot/evm-grid-bot/internal/logger"
+	"github.com/fachebot/evm-grid-bot/internal/model"
+	"github.com/fachebot/evm-grid-bot/internal/utils"
+	"github.com/fachebot/evm-grid-bot/internal/utils/evm"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// 中性网格: 将钱包已有代币平均分配到当前价格上方的卖出档位
+func (s *GridStrategy) handleSeedInventory(ctx context.Context, strategyRecord *ent.Strategy, gridRecords []*ent.Grid, gridList []decimal.Decimal, gridNumber int, latestPrice decimal.Decimal) {
+	w, err := s.svcCtx.WalletModel.FindByUserId(ctx, strategyRecord.UserId)
+	if err != nil {
+		logger.Errorf("[GridStrategy] 初始化库存网格 - 获取用户钱包失败, userId: %d, %v", strategyRecord.UserId, err)
+		return
+	}
+
+	tokenMeta, err := s.env.GetTokenMeta(ctx, strategyRecord.Token)
+	if err != nil {
+		logger.Errorf("[GridStrategy] 初始化库存网格 - 获取Token元信息失败, token: %s, %v", strategyRecord.Token, err)
+		return
+	}
+
+	balance, err := s.env.GetTokenBalance(ctx, strategyRecord.Token, w.Account)
+	if err != nil {
+		logger.Errorf("[GridStrategy] 初始化库存网格 - 获取代币余额失败, token: %s, %v", strategyRecord.Token, err)
+		return
+	}
+
+	// 扣除网格已持有的代币
+	uiBalance := evm.ParseUnits(balance, tokenMeta.Decimals)
+	for _, item := range gridRecords {
+		if item.Status == grid.StatusBought {
+			uiBalance = uiBalance.Sub(item.Quantity)
+		}
+	}
+
+	// 按卖出档位平分库存, 卖出价格为 gridList[i], 网格编号为 i-1
+	var gridArgsList []ent.Grid
+	levels := len(gridList) - gridNumber - 1
+	if levels > 0 && uiBalance.GreaterThan(decimal.Zero) {
+		quantity := uiBalance.Div(decimal.NewFromInt(int64(levels))).Truncate(int32(tokenMeta.Decimals))
+		for idx := gridNumber + 1; idx < len(gridList) && quantity.GreaterThan(decimal.Zero); idx++ {
+			sellPrice := gridList[idx]
+			gridArgsList = append(gridArgsList, ent.Grid{
+				GUID:       uuid.NewString(),
+				Account:    w.Account,
+				Token:      strategyRecord.Token,
+				Symbol:     strategyRecord.Symbol,
+				StrategyId: strategyRecord.GUID,
+				GridNumber: idx - 1,
+				OrderPrice: latestPrice,
+				FinalPrice: latestPrice,
+				Amount:     quantity.Mul(latestPrice),
+				Quantity:   quantity,
+				SellPrice:  &sellPrice,
+				Status:     grid.StatusBought,
+			})
+		}
+	}
+
+	err = utils.Tx(ctx, s.svcCtx.DbClient, func(tx *ent.Tx) error {
+		for _, gridArgs := range gridArgsList {
+			if _, err := model.NewGridModel(tx.Grid).Save(ctx, gridArgs); err != nil {
+				return err
+			}
+		}
+		return model.NewStrategyModel(tx.Strategy).UpdateInventorySeeded(ctx, strategyRecord.ID, true)
+	})
+	if err != nil {
+		logger.Errorf("[GridStrategy] 初始化库存网格 - 保存网格失败, strategy: %s, %v", strategyRecord.GUID, err)
+		return
+	}
+
+	logger.Infof("[GridStrategy] 初始化库存网格, strategy: %s, balance: %v, levels: %d, price: %v",
+		strategyRecord.GUID, uiBalance, len(gridArgsList), latestPrice)
+}
@@ -0,0 +1,118 @@
+package strategy
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/fachebot/evm-grid-bot/internal/ent"
+	entstrategy "github.com/fachebot/evm-grid-bot/internal/ent/strategy"
+
+	"github.com/shopspring/decimal"
+)
+
+// 自定义网格档位
+type GridLevel struct {
+	Price           decimal.Decimal
+	TakeProfitRatio decimal.Decimal
+	OrderSize       decimal.Decimal
+}
+
+// 解析用户输入的价格阶梯, 每行格式: 价格 [止盈%] [单笔金额]
+func ParseGridLadder(text string, strategyRecord *ent.Strategy) ([]GridLevel, error) {
+	levels := make([]GridLevel, 0)
+	for _, line := range strings.Split(text, "\n") {
+		fields := strings.FieldsFunc(line, func(r rune) bool {
+			return r == ' ' || r == '\t' || r == ',' || r == '，' || r == '\r'
+		})
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) > 3 {
+			return nil, fmt.Errorf("invalid line: %s", line)
+		}
+
+		level := GridLevel{
+			TakeProfitRatio: strategyRecord.TakeProfitRatio,
+			OrderSize:       strategyRecord.InitialOrderSize,
+		}
+		values := []*decimal.Decimal{&level.Price, &level.TakeProfitRatio, &level.OrderSize}
+		for idx, field := range fields {
+			d, err := decimal.NewFromString(strings.TrimSuffix(field, "%"))
+			if err != nil {
+				return nil, fmt.Errorf("invalid number: %s", field)
+			}
+			*values[idx] = d
+		}
+		levels = append(levels, level)
+	}
+
+	return levels, ValidateGridLadder(levels, strategyRecord.LowerPriceBound, strategyRecord.UpperPriceBound)
+}
+
+// 校验价格阶梯: 价格递增, 数值为正, 且在价格区间内
+func ValidateGridLadder(levels []GridLevel, lowerPriceBound, upperPriceBound decimal.Decimal) error {
+	if len(levels) == 0 {
+		return errors.New("grid ladder is empty")
+	}
+
+	for idx, level := range levels {
+		if level.Price.LessThanOrEqual(decimal.Zero) ||
+			level.TakeProfitRatio.LessThanOrEqual(decimal.Zero) ||
+			level.OrderSize.LessThanOrEqual(decimal.Zero) {
+			return fmt.Errorf("level %d must be positive", idx+1)
+		}
+		if idx > 0 && level.Price.LessThanOrEqual(levels[idx-1].Price) {
+			return fmt.Errorf("level %d is not sorted", idx+1)
+		}
+		if level.Price.LessThan(lowerPriceBound) || level.Price.GreaterThan(upperPriceBound) {
+			return fmt.Errorf("level %d is out of bounds", idx+1)
+		}
+	}
+	return nil
+}
+
+func EncodeGridLadder(levels []GridLevel) string {
+	lines := make([]string, 0, len(levels))
+	for _, level := range levels {
+		lines = append(lines, fmt.Sprintf("%s %s %s", level.Price, level.TakeProfitRatio, level.OrderSize))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func DecodeGridLadder(gridLadder *string) []GridLevel {
+	if gridLadder == nil || *gridLadder == "" {
+		return nil
+	}
+
+	levels := make([]GridLevel, 0)
+	for _, line := range strings.Split(*gridLadder, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			return nil
+		}
+
+		var level GridLevel
+		var err error
+		for idx, value := range []*decimal.Decimal{&level.Price, &level.TakeProfitRatio, &level.OrderSize} {
+			if *value, err = decimal.NewFromString(fields[idx]); err != nil {
+				return nil
+			}
+		}
+		levels = append(levels, level)
+	}
+	return levels
+}
+
+// 获取网格档位, 非自定义阶梯时返回 false
+func getGridLevel(strategyRecord *ent.Strategy, gridNumber int) (GridLevel, bool) {
+	if strategyRecord.GridType != entstrategy.GridTypeCustom {
+		return GridLevel{}, false
+	}
+
+	levels := DecodeGridLadder(strategyRecord.GridLadder)
+	if gridNumber < 0 || gridNumber >= len(levels) {
+		return GridLevel{}, false
+	}
+	return levels[gridNumber], true
+}
@@ -0,0 +1,140 @@
+package strategy
+
+import (
+	"testing"
+
+	"github.com/fachebot/evm-grid-bot/internal/ent"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestParseGridLadder(t *testing.T) {
+	strategyRecord := &ent.Strategy{
+		LowerPriceBound:  decimal.NewFromInt(1),
+		UpperPriceBound:  decimal.NewFromInt(10),
+		TakeProfitRatio:  decimal.NewFromInt(2),
+		InitialOrderSize: decimal.NewFromInt(50),
+	}
+
+	tests := []struct {
+		name     string
+		text     string
+		expected string
+		err      bool
+	}{
+		{
+			name:     "只有价格时使用策略默认值",
+			text:     "1\n2.5",
+			expected: "1 2 50\n2.5 2 50",
+		},
+		{
+			name:     "指定止盈和金额",
+			text:     "1 3% 100\n2 1.5",
+			expected: "1 3 100\n2 1.5 50",
+		},
+		{
+			name:     "支持逗号和空行",
+			text:     "1,3,100\r\n\n2，4%，20\n",
+			expected: "1 3 100\n2 4 20",
+		},
+		{
+			name: "字段过多",
+			text: "1 2 3 4",
+			err:  true,
+		},
+		{
+			name: "无效数字",
+			text: "1 abc",
+			err:  true,
+		},
+		{
+			name: "价格未递增",
+			text: "2\n2",
+			err:  true,
+		},
+		{
+			name: "价格超出区间",
+			text: "1\n11",
+			err:  true,
+		},
+		{
+			name: "金额不为正数",
+			text: "1 2 0",
+			err:  true,
+		},
+		{
+			name: "空输入",
+			text: " \n",
+			err:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			levels, err := ParseGridLadder(tt.text, strategyRecord)
+			if (err != nil) != tt.err {
+				t.Fatalf("ParseGridLadder() error = %v, expected error %v", err, tt.err)
+			}
+			if tt.err {
+				return
+			}
+			if result := EncodeGridLadder(levels); result != tt.expected {
+				t.Errorf("ParseGridLadder() = %q, expected %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestValidateGridLadder(t *testing.T) {
+	newLevel := func(price, takeProfitRatio, orderSize string) GridLevel {
+		return GridLevel{
+			Price:           decimal.RequireFromString(price),
+			TakeProfitRatio: decimal.RequireFromString(takeProfitRatio),
+			OrderSize:       decimal.RequireFromString(orderSize),
+		}
+	}
+
+	tests := []struct {
+		name   string
+		levels []GridLevel
+		err    bool
+	}{
+		{
+			name:   "有效阶梯",
+			levels: []GridLevel{newLevel("1", "1", "10"), newLevel("10", "1", "10")},
+		},
+		{
+			name: "空阶梯",
+			err:  true,
+		},
+		{
+			name:   "止盈不为正数",
+			levels: []GridLevel{newLevel("1", "0", "10")},
+			err:    true,
+		},
+		{
+			name:   "价格递减",
+			levels: []GridLevel{newLevel("5", "1", "10"), newLevel("4", "1", "10")},
+			err:    true,
+		},
+		{
+			name:   "价格低于下限",
+			levels: []GridLevel{newLevel("0.5", "1", "10")},
+			err:    true,
+		},
+		{
+			name:   "价格高于上限",
+			levels: []GridLevel{newLevel("10.1", "1", "10")},
+			err:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateGridLadder(tt.levels, decimal.NewFromInt(1), decimal.NewFromInt(10))
+			if (err != nil) != tt.err {
+				t.Errorf("ValidateGridLadder() error = %v, expected error %v", err, tt.err)
+			}
+		})
+	}
+}
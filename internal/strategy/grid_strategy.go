@@ -2,11 +2,14 @@ package strategy
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
+	"math/big"
 	"time"
 
 	"github.com/fachebot/evm-grid-bot/internal/charts"
+	"github.com/fachebot/evm-grid-bot/internal/dexagg"
 	"github.com/fachebot/evm-grid-bot/internal/ent"
 	"github.com/fachebot/evm-grid-bot/internal/ent/grid"
 	"github.com/fachebot/evm-grid-bot/internal/ent/order"
@@ -14,27 +17,37 @@ import (
 	"github.com/fachebot/evm-grid-bot/internal/logger"
 	"github.com/fachebot/evm-grid-bot/internal/model"
 	"github.com/fachebot/evm-grid-bot/internal/svc"
-	"github.com/fachebot/evm-grid-bot/internal/swap"
 	"github.com/fachebot/evm-grid-bot/internal/utils"
 	"github.com/fachebot/evm-grid-bot/internal/utils/evm"
 	"github.com/fachebot/evm-grid-bot/internal/utils/format"
 
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
 	"github.com/google/uuid"
 	"github.com/samber/lo"
 	"github.com/shopspring/decimal"
 )
 
+// 买入交易模拟执行失败后, 暂停自动买入的时间
+const simulationFailureCooldown = 10 * time.Minute
+
 type GridStrategy struct {
-	svcCtx       *svc.ServiceContext
-	strategyId   string
-	tokenAddress string
+	svcCtx         *svc.ServiceContext
+	strategyId     string
+	tokenAddress   string
+	env            Environment
+	buyPausedUntil time.Time
 }
 
 func NewGridStrategy(svcCtx *svc.ServiceContext, s *ent.Strategy) *GridStrategy {
+	return NewGridStrategyWithEnvironment(svcCtx, s, NewEnvironment(svcCtx, s))
+}
+
+func NewGridStrategyWithEnvironment(svcCtx *svc.ServiceContext, s *ent.Strategy, env Environment) *GridStrategy {
 	return &GridStrategy{
 		svcCtx:       svcCtx,
 		strategyId:   s.GUID,
 		tokenAddress: s.Token,
+		env:          env,
 	}
 }
 
@@ -43,7 +56,7 @@ func calculateTotalProfit(ctx context.Context, svcCtx *svc.ServiceContext, strat
 	var err error
 	var realizedProfit decimal.Decimal
 	if strategyRecord.FirstOrderId != nil {
-		realizedProfit, err = svcCtx.OrderModel.TotalProfit(ctx, strategyRecord.GUID, *strategyRecord.FirstOrderId)
+		realizedProfit, err = svcCtx.StrategyCache.GetRealizedProfit(ctx, strategyRecord.GUID, *strategyRecord.FirstOrderId)
 		if err != nil {
 			return decimal.Zero, nil
 		}
@@ -74,8 +87,12 @@ func (s *GridStrategy) TokenAddress() string {
 }
 
 func (s *GridStrategy) OnTick(ctx context.Context, ohlcs []charts.Ohlc) error {
+	// 与手动操作互斥
+	s.svcCtx.StrategyLocker.Lock(s.strategyId)
+	defer s.svcCtx.StrategyLocker.Unlock(s.strategyId)
+
 	// 获取策略信息
-	strategyRecord, err := s.svcCtx.StrategyModel.FindByGUID(ctx, s.strategyId)
+	strategyRecord, err := s.svcCtx.StrategyCache.GetStrategy(ctx, s.strategyId)
 	if err != nil {
 		logger.Errorf("[GridStrategy] 查询策略记录失败, strategy: %v, %v", s.strategyId, err)
 		return err
@@ -87,14 +104,18 @@ func (s *GridStrategy) OnTick(ctx context.Context, ohlcs []charts.Ohlc) error {
 	}
 
 	// 获取网格列表
-	gridRecords, err := s.svcCtx.GridModel.FindByStrategyId(ctx, s.strategyId)
+	gridRecords, err := s.svcCtx.StrategyCache.GetGrids(ctx, s.strategyId)
 	if err != nil {
 		logger.Errorf("[GridStrategy] 查询网格列表失败, strategy: %v, %v", s.strategyId, err)
 		return err
 	}
+	// 留仓网格只由全局离场规则卖出, 不参与网格交易
+	activeGrids := lo.Filter(gridRecords, func(item *ent.Grid, _ int) bool { return !item.Runner })
 	gridMapper := make(map[int]*ent.Grid)
-	for _, item := range gridRecords {
-		gridMapper[item.GridNumber] = item
+	for _, item := range activeGrids {
+		if isGridInRange(item) {
+			gridMapper[item.GridNumber] = item
+		}
 	}
 
 	// 处理瀑布下跌
@@ -151,7 +172,7 @@ func (s *GridStrategy) OnTick(ctx context.Context, ohlcs []charts.Ohlc) error {
 	}
 
 	// 计算网格止盈
-	for _, item := range gridRecords {
+	for _, item := range activeGrids {
 		if item.Status != grid.StatusBought {
 			continue
 		}
@@ -160,9 +181,13 @@ func (s *GridStrategy) OnTick(ctx context.Context, ohlcs []charts.Ohlc) error {
 		s.handleTakeProfit(ctx, latestPrice, strategyRecord, item)
 	}
 
+	// 自适应网格间隔
+	if s.handleAdaptiveSpacing(ctx, strategyRecord, activeGrids, ohlcs) {
+		return nil
+	}
+
 	// 生成网格列表
-	gridList, err := utils.GenerateGrid(
-		strategyRecord.LowerPriceBound, strategyRecord.UpperPriceBound, strategyRecord.TakeProfitRatio.Div(decimal.NewFromInt(100)))
+	gridList, err := GenerateGridList(strategyRecord)
 	if err != nil {
 		logger.Errorf("[GridStrategy] 生成网格列表失败, strategy: %v, lowerPriceBound: %v, upperPriceBound: %v, takeProfitRatio: %v, %v",
 			s.strategyId, strategyRecord.LowerPriceBound, strategyRecord.UpperPriceBound, strategyRecord.TakeProfitRatio, err)
@@ -181,33 +206,58 @@ func (s *GridStrategy) OnTick(ctx context.Context, ohlcs []charts.Ohlc) error {
 		gridTrend = updateGridTrend(gridTrend, math.MaxInt)
 	}
 	gridTrend = updateGridTrend(gridTrend, gridNumber)
-	err = s.svcCtx.StrategyModel.UpdateGridTrend(ctx, strategyRecord.ID, encodeGridTrend(gridTrend))
-	if err != nil {
-		logger.Errorf("[GridStrategy] 更新交易趋势失败, strategy: %v, gridTrend: %v, %v",
-			s.strategyId, gridTrend, err)
+	if encoded := encodeGridTrend(gridTrend); encoded != lo.FromPtr(strategyRecord.GridTrend) {
+		err = s.svcCtx.StrategyModel.UpdateGridTrend(ctx, strategyRecord.ID, encoded)
+		if err != nil {
+			logger.Errorf("[GridStrategy] 更新交易趋势失败, strategy: %v, gridTrend: %v, %v",
+				s.strategyId, gridTrend, err)
+		}
+	}
+
+	// 处理网格追踪
+	latestTime := ohlcs[len(ohlcs)-1].Time
+	exitPrice := strategyRecord.LowerPriceBound.Sub(calculateGridProfit(strategyRecord, 0, strategyRecord.LowerPriceBound))
+	breakUp := !ok && latestPrice.GreaterThan(strategyRecord.UpperPriceBound)
+	breakDown := ok && gridNumber == 0 && latestPrice.LessThan(exitPrice)
+	if (breakUp && strategyRecord.TrailingUp) || (breakDown && strategyRecord.TrailingDown) {
+		if breakUp {
+			s.sendUpperThresholdAlert(ctx, strategyRecord, latestPrice)
+		}
+		s.handleTrailing(ctx, strategyRecord, activeGrids, latestPrice, latestTime)
+		return nil
+	}
+	if strategyRecord.OutOfRangeTime != nil {
+		err = s.svcCtx.StrategyModel.ClearOutOfRangeTime(ctx, strategyRecord.ID)
+		if err != nil {
+			logger.Errorf("[GridStrategy] 清除离开区间时间失败, strategy: %s, %v", strategyRecord.GUID, err)
+		}
+	}
+
+	// 初始化库存网格
+	if ok && strategyRecord.GridMode == entstrategy.GridModeNeutral && !strategyRecord.InventorySeeded {
+		s.handleSeedInventory(ctx, strategyRecord, gridRecords, gridList, gridNumber, latestPrice)
+		return nil
 	}
 
 	if !ok {
 		logger.Debugf("[GridStrategy] 超出网格范围, strategy: %v, price: %v, lowerPriceBound: %v, upperPriceBound: %v",
 			s.strategyId, latestPrice, strategyRecord.LowerPriceBound, strategyRecord.UpperPriceBound)
 
-		if latestPrice.GreaterThan(strategyRecord.UpperPriceBound) {
+		if breakUp {
 			s.sendUpperThresholdAlert(ctx, strategyRecord, latestPrice)
 		}
 		return nil
 	}
 
 	// 处理网格交易
-	takeProfitRatio := strategyRecord.TakeProfitRatio.Div(decimal.NewFromInt(100))
-	exitPrice := strategyRecord.LowerPriceBound.Sub(strategyRecord.LowerPriceBound.Mul(takeProfitRatio))
-	if gridNumber == 0 && latestPrice.LessThan(exitPrice) {
+	if breakDown {
 		s.handlepriceRangeStopLoss(ctx, strategyRecord, gridRecords, latestPrice)
 		return nil
 	} else {
 		// 处理动态止损
 		if strategyRecord.DynamicStopLoss && strategyRecord.MaxGridLimit != nil {
-			for _, item := range gridRecords {
-				if item.Status != grid.StatusBought {
+			for _, item := range activeGrids {
+				if item.Status != grid.StatusBought || item.SellPrice != nil || !isGridInRange(item) {
 					continue
 				}
 
@@ -220,7 +270,7 @@ func (s *GridStrategy) OnTick(ctx context.Context, ohlcs []charts.Ohlc) error {
 		}
 
 		// 是否下跌趋势
-		if len(gridTrend) >= 2 && /*(!isDowntrend(gridTrend)) ||*/ !isMinGridNumber(gridRecords, gridNumber) {
+		if len(gridTrend) >= 2 && /*(!isDowntrend(gridTrend)) ||*/ !isMinGridNumber(activeGrids, gridNumber) {
 			logger.Debugf("[GridStrategy] 不是下跌趋势, strategy: %v, price: %v, gridTrend: %v", s.strategyId, latestPrice, gridTrend)
 			return nil
 		}
@@ -233,7 +283,20 @@ func (s *GridStrategy) OnTick(ctx context.Context, ohlcs []charts.Ohlc) error {
 			return nil
 		}
 
-		s.handleGridBuy(ctx, strategyRecord, ohlcs, gridRecords, gridNumber, gridList[gridNumber])
+		// 检查指标过滤
+		reason := checkBuyFilters(strategyRecord, ohlcs)
+		if reason != lo.FromPtr(strategyRecord.BuyFilterReason) {
+			err = s.svcCtx.StrategyModel.UpdateBuyFilterReason(ctx, strategyRecord.ID, reason)
+			if err != nil {
+				logger.Errorf("[GridStrategy] 更新买入过滤原因失败, strategy: %v, reason: %s, %v", s.strategyId, reason, err)
+			}
+		}
+		if reason != "" {
+			logger.Debugf("[GridStrategy] 取消网格买入, 不满足指标过滤条件, strategy: %v, price: %v, reason: %s", s.strategyId, latestPrice, reason)
+			return nil
+		}
+
+		s.handleGridBuy(ctx, strategyRecord, ohlcs, activeGrids, gridList, gridNumber)
 	}
 
 	return nil
@@ -251,7 +314,7 @@ func (s *GridStrategy) sendUpperThresholdAlert(ctx context.Context, strategyReco
 	text := "🚨*%s* 突破价格上限!\n\n`%s`\n\n💥 当前价格: %s (上限设定: %s)\n📈 已突破上限: %s%%\n\n✅ 止盈功能仍正常运行中!\n⚠️ 系统已自动暂停新买入订单!"
 	text = fmt.Sprintf(text, strategyRecord.Symbol, strategyRecord.Token, format.Price(latestPrice, 5), strategyRecord.UpperPriceBound, percentage.Truncate(2))
 
-	_, err := utils.SendMessage(s.svcCtx.BotApi, strategyRecord.UserId, text)
+	err := s.env.SendMessage(ctx, strategyRecord.UserId, text)
 	if err != nil {
 		logger.Warnf("[GridStrategy] 发送电报通知失败, userId: %d, text: %s, %v", strategyRecord.UserId, text, err)
 		return
@@ -277,7 +340,7 @@ func (s *GridStrategy) sendLowerThresholdAlert(ctx context.Context, strategyReco
 	text := "🚨*%s* 跌破价格下限!\n\n`%s`\n\n💥 当前价格: %s (下限设定: %s)\n📈 已跌破下限: %s%%\n\n✅ 止盈功能仍正常运行中!\n⚠️ 系统已自动暂停新买入订单!"
 	text = fmt.Sprintf(text, strategyRecord.Symbol, strategyRecord.Token, format.Price(latestPrice, 5), strategyRecord.LowerPriceBound, percentage.Truncate(2))
 
-	_, err := utils.SendMessage(s.svcCtx.BotApi, strategyRecord.UserId, text)
+	err := s.env.SendMessage(ctx, strategyRecord.UserId, text)
 	if err != nil {
 		logger.Warnf("[GridStrategy] 发送电报通知失败, userId: %d, text: %s, %v", strategyRecord.UserId, text, err)
 		return
@@ -291,15 +354,16 @@ func (s *GridStrategy) sendLowerThresholdAlert(ctx context.Context, strategyReco
 	}
 }
 
-func (s *GridStrategy) handleGridBuy(ctx context.Context, strategyRecord *ent.Strategy, ohlcs []charts.Ohlc, gridList []*ent.Grid, gridNumber int, gridPrice decimal.Decimal) {
-	if !strategyRecord.EnableAutoBuy {
+func (s *GridStrategy) handleGridBuy(ctx context.Context, strategyRecord *ent.Strategy, ohlcs []charts.Ohlc, gridRecords []*ent.Grid, gridList []decimal.Decimal, gridNumber int) {
+	gridPrice := gridList[gridNumber]
+	if !strategyRecord.EnableAutoBuy || time.Now().Before(s.buyPausedUntil) {
 		return
 	}
 
-	// 是否超过上限
+	// 是否超过上限, 库存网格不计入
 	if strategyRecord.MaxGridLimit != nil &&
 		*strategyRecord.MaxGridLimit > 0 &&
-		len(gridList) >= *strategyRecord.MaxGridLimit {
+		lo.CountBy(gridRecords, func(item *ent.Grid) bool { return item.SellPrice == nil }) >= *strategyRecord.MaxGridLimit {
 		return
 	}
 
@@ -335,25 +399,27 @@ func (s *GridStrategy) handleGridBuy(ctx context.Context, strategyRecord *ent.St
 		}
 	}
 
-	tokenMeta, err := s.svcCtx.TokenMetaCache.GetTokenMeta(ctx, strategyRecord.Token)
+	tokenMeta, err := s.env.GetTokenMeta(ctx, strategyRecord.Token)
 	if err != nil {
 		logger.Errorf("[GridStrategy] 获取Token元信息失败, token: %s, %v", strategyRecord.Token, err)
 		return
 	}
 
+	// 计算买入金额
+	orderSize := calculateOrderSize(strategyRecord, gridRecords, len(gridList), gridNumber).Truncate(int32(s.svcCtx.Config.Chain.StablecoinDecimals))
+
 	// 获取报价
-	amount := evm.FormatUnits(strategyRecord.InitialOrderSize, s.svcCtx.Config.Chain.StablecoinDecimals)
-	swapService := swap.NewSwapService(s.svcCtx, strategyRecord.UserId)
-	tx, err := swapService.Quote(ctx, s.svcCtx.Config.Chain.StablecoinCA, strategyRecord.Token, amount)
+	amount := evm.FormatUnits(orderSize, s.svcCtx.Config.Chain.StablecoinDecimals)
+	tx, err := s.env.Quote(ctx, strategyRecord.UserId, s.svcCtx.Config.Chain.StablecoinCA, strategyRecord.Token, amount, false)
 	if err != nil {
 		logger.Errorf("[GridStrategy] 获取报价失败, in: %s, out: %s, amount: %s, %v",
-			s.svcCtx.Config.Chain.StablecoinSymbol, strategyRecord.Symbol, strategyRecord.InitialOrderSize, err)
+			s.svcCtx.Config.Chain.StablecoinSymbol, strategyRecord.Symbol, orderSize, err)
 		return
 	}
 
 	bottomPrice := gridPrice
 	uiOutAmount := evm.ParseUnits(tx.OutAmount(), tokenMeta.Decimals)
-	quotePrice := strategyRecord.InitialOrderSize.Div(uiOutAmount)
+	quotePrice := orderSize.Div(uiOutAmount)
 	logger.Debugf("[GridStrategy] 买入网格, token: %s, latestPrice: %s, gridPrice: %s, quotePrice: %s, bottomPrice: %s",
 		strategyRecord.Symbol, latestPrice, gridPrice, quotePrice, bottomPrice)
 
@@ -362,18 +428,7 @@ func (s *GridStrategy) handleGridBuy(ctx context.Context, strategyRecord *ent.St
 		return
 	}
 
-	// 发送交易
-	hash, nonce, err := tx.Swap(ctx)
-	if err != nil {
-		logger.Errorf("[GridStrategy] 买入网格 - 发送交易失败, user: %d, inputAmount: %s, outToken: %s, outAmount: %s, hash: %s, %v",
-			strategyRecord.UserId, strategyRecord.InitialOrderSize, strategyRecord.Symbol, uiOutAmount, hash, err)
-		return
-	}
-
-	logger.Infof("[GridStrategy] 买入网格 - 提交交易成功, user: %d, strategy: %s, gridNumber: %d, hash: %s",
-		strategyRecord.UserId, strategyRecord.GUID, gridNumber, hash)
-
-	// 保存网格和订单
+	// 网格和订单
 	gridArgs := ent.Grid{
 		GUID:       guid.String(),
 		Account:    tx.Signer(),
@@ -383,7 +438,7 @@ func (s *GridStrategy) handleGridBuy(ctx context.Context, strategyRecord *ent.St
 		GridNumber: gridNumber,
 		OrderPrice: quotePrice,
 		FinalPrice: quotePrice,
-		Amount:     strategyRecord.InitialOrderSize,
+		Amount:     orderSize,
 		Quantity:   uiOutAmount,
 		Status:     grid.StatusBuying,
 	}
@@ -400,19 +455,49 @@ func (s *GridStrategy) handleGridBuy(ctx context.Context, strategyRecord *ent.St
 		FinalPrice: gridArgs.FinalPrice,
 		InAmount:   gridArgs.Amount,
 		OutAmount:  gridArgs.Quantity,
-		Status:     order.StatusPending,
-		Nonce:      nonce,
-		TxHash:     hash,
+		Paper:      strategyRecord.PaperTrading,
 	}
 
+	// 报价过期重新报价时, 重新检查底价并更新网格和订单
+	tx.SetPriceGuard(func(outAmount *big.Int) error {
+		uiOutAmount := evm.ParseUnits(outAmount, tokenMeta.Decimals)
+		if uiOutAmount.LessThanOrEqual(decimal.Zero) {
+			return errors.New("invalid out amount")
+		}
+		quotePrice := orderSize.Div(uiOutAmount)
+		if quotePrice.GreaterThan(bottomPrice) {
+			return fmt.Errorf("quote price %s above bottom price %s", quotePrice, bottomPrice)
+		}
+
+		gridArgs.OrderPrice, gridArgs.FinalPrice, gridArgs.Quantity = quotePrice, quotePrice, uiOutAmount
+		orderArgs.Price, orderArgs.FinalPrice, orderArgs.OutAmount = quotePrice, quotePrice, uiOutAmount
+		return nil
+	})
+
+	// 发送交易
+	err = SubmitSwap(ctx, s.svcCtx, tx, &orderArgs)
+	if err != nil {
+		logger.Errorf("[GridStrategy] 买入网格 - 发送交易失败, user: %d, inputAmount: %s, outToken: %s, outAmount: %s, hash: %s, %v",
+			strategyRecord.UserId, orderSize, strategyRecord.Symbol, uiOutAmount, orderArgs.TxHash, err)
+
+		var simErr *dexagg.SimulationError
+		if errors.As(err, &simErr) {
+			s.handleBuySimulationFailure(ctx, strategyRecord, gridNumber, orderSize, simErr.Reason)
+		}
+		return
+	}
+
+	logger.Infof("[GridStrategy] 买入网格 - 提交交易成功, user: %d, strategy: %s, gridNumber: %d, hash: %s",
+		strategyRecord.UserId, strategyRecord.GUID, gridNumber, orderArgs.TxHash)
+
+	// 保存网格并更新订单状态
 	err = utils.Tx(ctx, s.svcCtx.DbClient, func(tx *ent.Tx) error {
-		_, err = model.NewGridModel(tx.Grid).Save(ctx, gridArgs)
+		_, err := model.NewGridModel(tx.Grid).Save(ctx, gridArgs)
 		if err != nil {
 			return err
 		}
 
-		_, err = model.NewOrderModel(tx.Order).Save(ctx, orderArgs)
-		return nil
+		return model.NewOrderModel(tx.Order).SetOrderPendingStatus(ctx, orderArgs.ID)
 	})
 	if err != nil {
 		logger.Errorf("[GridStrategy] 买入网格 - 保存网格和订单失败, grid: %+v, order: %+v, %v", gridArgs, orderArgs, err)
@@ -420,6 +505,22 @@ func (s *GridStrategy) handleGridBuy(ctx context.Context, strategyRecord *ent.St
 	}
 }
 
+// 买入交易模拟执行失败, 可能是貔貅盘、转账税或报价过期, 暂停自动买入并通知用户
+func (s *GridStrategy) handleBuySimulationFailure(ctx context.Context, strategyRecord *ent.Strategy, gridNumber int, orderSize decimal.Decimal, reason string) {
+	s.buyPausedUntil = time.Now().Add(simulationFailureCooldown)
+
+	if !strategyRecord.EnablePushNotification {
+		return
+	}
+
+	text := fmt.Sprintf("⚠️ 网格 `#%d` 买入 %sU *%s* 已跳过, 交易模拟执行失败: %s\n\n⏸ 自动买入暂停 %d 分钟",
+		gridNumber, orderSize.Truncate(2), strategyRecord.Symbol, tgbotapi.EscapeText(tgbotapi.ModeMarkdown, reason), int(simulationFailureCooldown.Minutes()))
+	err := s.env.SendMessage(ctx, strategyRecord.UserId, text)
+	if err != nil {
+		logger.Warnf("[GridStrategy] 发送电报通知失败, userId: %d, text: %s, %v", strategyRecord.UserId, text, err)
+	}
+}
+
 func (s *GridStrategy) handleTakeProfit(ctx context.Context, latestPrice decimal.Decimal, strategyRecord *ent.Strategy, gridRecord *ent.Grid) {
 	if !strategyRecord.EnableAutoSell {
 		return
@@ -428,21 +529,44 @@ func (s *GridStrategy) handleTakeProfit(ctx context.Context, latestPrice decimal
 		return
 	}
 
-	// 计算利润
-	profit := gridRecord.FinalPrice.Mul(strategyRecord.TakeProfitRatio.Div(decimal.NewFromInt(100)))
-	if latestPrice.LessThan(gridRecord.FinalPrice.Add(profit)) {
+	// 计算利润, 库存网格按预设卖出价格止盈, 调整间隔前买入的网格按原止盈价格
+	bottomPrice := calculateTakeProfitPrice(strategyRecord, gridRecord)
+	if strategyRecord.TrailingTakeProfit != nil && strategyRecord.TrailingTakeProfit.GreaterThan(decimal.Zero) {
+		if !s.handleTrailingTakeProfit(ctx, strategyRecord, gridRecord, latestPrice, bottomPrice) {
+			return
+		}
+	} else if latestPrice.LessThan(bottomPrice) {
 		return
 	}
 
+	// 部分止盈, 剩余数量作为留仓
+	quantity := gridRecord.Quantity
+	if strategyRecord.TakeProfitPortion != nil && strategyRecord.TakeProfitPortion.LessThan(decimal.NewFromInt(100)) {
+		tokenMeta, err := s.env.GetTokenMeta(ctx, strategyRecord.Token)
+		if err != nil {
+			logger.Errorf("[GridStrategy] 止盈网格 - 获取Token元信息失败, token: %s, %v", strategyRecord.Token, err)
+			return
+		}
+		quantity = quantity.Mul(*strategyRecord.TakeProfitPortion).Div(decimal.NewFromInt(100)).Truncate(int32(tokenMeta.Decimals))
+		if quantity.IsZero() {
+			quantity = gridRecord.Quantity
+		}
+	}
+
 	// 卖出代币
-	bottomPrice := gridRecord.FinalPrice.Add(profit)
-	orderArgs, err := SellToken(ctx, s.svcCtx, strategyRecord, "止盈网格", &gridRecord.Quantity, &bottomPrice, false)
+	orderArgs, err := sellToken(ctx, s.svcCtx, s.env, strategyRecord, "止盈网格", &quantity, &bottomPrice, false, func(orderArgs *ent.Order) {
+		orderArgs.GridId = &gridRecord.GUID
+		orderArgs.GridNumber = &gridRecord.GridNumber
+		orderArgs.GridBuyCost = &gridRecord.Amount
+		if quantity.LessThan(gridRecord.Quantity) {
+			// 按卖出数量分摊买入成本
+			cost := gridRecord.Amount.Mul(orderArgs.InAmount).Div(gridRecord.Quantity)
+			orderArgs.GridBuyCost = &cost
+		}
+	})
 	if err != nil {
 		return
 	}
-	orderArgs.GridId = &gridRecord.GUID
-	orderArgs.GridNumber = &gridRecord.GridNumber
-	orderArgs.GridBuyCost = &gridRecord.Amount
 
 	// 更新数据状态
 	err = utils.Tx(ctx, s.svcCtx.DbClient, func(tx *ent.Tx) error {
@@ -451,7 +575,7 @@ func (s *GridStrategy) handleTakeProfit(ctx context.Context, latestPrice decimal
 			return err
 		}
 
-		_, err = model.NewOrderModel(tx.Order).Save(ctx, orderArgs)
+		err = model.NewOrderModel(tx.Order).SetOrderPendingStatus(ctx, orderArgs.ID)
 		if err != nil {
 			return err
 		}
@@ -478,6 +602,33 @@ func (s *GridStrategy) handleTakeProfit(ctx context.Context, latestPrice decimal
 	gridRecord.Status = grid.StatusSelling
 }
 
+// 追踪止盈: 达到止盈价格后记录最高价, 从最高价回撤达到阈值时卖出, 且不低于止盈价格
+func (s *GridStrategy) handleTrailingTakeProfit(ctx context.Context, strategyRecord *ent.Strategy, gridRecord *ent.Grid, latestPrice, targetPrice decimal.Decimal) bool {
+	if gridRecord.PeakPrice == nil && latestPrice.LessThan(targetPrice) {
+		return false
+	}
+
+	// 更新最高价格
+	if gridRecord.PeakPrice == nil || latestPrice.GreaterThan(*gridRecord.PeakPrice) {
+		err := s.svcCtx.GridModel.UpdatePeakPrice(ctx, gridRecord.GUID, latestPrice)
+		if err != nil {
+			logger.Errorf("[GridStrategy] 追踪止盈 - 更新最高价格失败, strategy: %s, grid: %s, %v", strategyRecord.GUID, gridRecord.GUID, err)
+			return false
+		}
+		gridRecord.PeakPrice = &latestPrice
+	}
+
+	pullback := strategyRecord.TrailingTakeProfit.Div(decimal.NewFromInt(100))
+	stopPrice := decimal.Max(gridRecord.PeakPrice.Mul(decimal.NewFromInt(1).Sub(pullback)), targetPrice)
+	if latestPrice.GreaterThan(stopPrice) {
+		return false
+	}
+
+	logger.Debugf("[GridStrategy] 追踪止盈, strategy: %s, gridNumber: %d, peakPrice: %v, stopPrice: %v, latestPrice: %v",
+		strategyRecord.GUID, gridRecord.GridNumber, *gridRecord.PeakPrice, stopPrice, latestPrice)
+	return true
+}
+
 func (s *GridStrategy) handlepriceRangeStopLoss(ctx context.Context, strategyRecord *ent.Strategy, gridRecords []*ent.Grid, latestPrice decimal.Decimal) {
 	// 计算总仓位
 	uiTotalAmount := decimal.Zero
@@ -507,11 +658,12 @@ func (s *GridStrategy) handlepriceRangeStopLoss(ctx context.Context, strategyRec
 
 	// 卖出所有代币
 	minSellPrice := latestPrice.Sub(latestPrice.Mul(decimal.NewFromFloat(0.01)))
-	orderArgs, err := SellToken(ctx, s.svcCtx, strategyRecord, "跌破清仓", nil, &minSellPrice, true)
+	orderArgs, err := sellToken(ctx, s.svcCtx, s.env, strategyRecord, "跌破清仓", nil, &minSellPrice, true, func(orderArgs *ent.Order) {
+		orderArgs.GridBuyCost = &uiTotalAmount
+	})
 	if err != nil {
 		return
 	}
-	orderArgs.GridBuyCost = &uiTotalAmount
 
 	// 更新数据状态
 	err = utils.Tx(ctx, s.svcCtx.DbClient, func(tx *ent.Tx) error {
@@ -520,7 +672,7 @@ func (s *GridStrategy) handlepriceRangeStopLoss(ctx context.Context, strategyRec
 			return err
 		}
 
-		_, err = model.NewOrderModel(tx.Order).Save(ctx, orderArgs)
+		err = model.NewOrderModel(tx.Order).SetOrderPendingStatus(ctx, orderArgs.ID)
 		if err != nil {
 			return err
 		}
@@ -545,7 +697,7 @@ func (s *GridStrategy) handlepriceRangeStopLoss(ctx context.Context, strategyRec
 	}
 
 	// 停止策略运行
-	s.svcCtx.Engine.StopStrategy(strategyRecord.GUID)
+	s.env.StopStrategy(ctx, strategyRecord.GUID, ExitRulePriceRangeStopLoss)
 }
 
 func (s *GridStrategy) handleWaterfallDrop(ctx context.Context, strategyRecord *ent.Strategy, gridRecords []*ent.Grid, ohlcs []charts.Ohlc) (bool, error) {
@@ -595,12 +747,13 @@ func (s *GridStrategy) handleWaterfallDrop(ctx context.Context, strategyRecord *
 	var orderArgs *ent.Order
 	if len(gridRecords) > 0 && uiTotalQuantity.GreaterThan(decimal.Zero) {
 		minSellPrice := latestPrice.Sub(latestPrice.Mul(decimal.NewFromFloat(0.01)))
-		ord, err := SellToken(ctx, s.svcCtx, strategyRecord, "防瀑布机制", nil, &minSellPrice, true)
+		ord, err := sellToken(ctx, s.svcCtx, s.env, strategyRecord, "防瀑布机制", nil, &minSellPrice, true, func(orderArgs *ent.Order) {
+			orderArgs.GridBuyCost = &uiTotalAmount
+		})
 		if err != nil {
 			return false, err
 		}
 		orderArgs = &ord
-		orderArgs.GridBuyCost = &uiTotalAmount
 	}
 
 	// 更新数据状态
@@ -611,7 +764,7 @@ func (s *GridStrategy) handleWaterfallDrop(ctx context.Context, strategyRecord *
 		}
 
 		if orderArgs != nil {
-			_, err = model.NewOrderModel(tx.Order).Save(ctx, *orderArgs)
+			err = model.NewOrderModel(tx.Order).SetOrderPendingStatus(ctx, orderArgs.ID)
 			if err != nil {
 				return err
 			}
@@ -637,12 +790,12 @@ func (s *GridStrategy) handleWaterfallDrop(ctx context.Context, strategyRecord *
 	}
 
 	// 停止策略运行
-	s.svcCtx.Engine.StopStrategy(strategyRecord.GUID)
+	s.env.StopStrategy(ctx, strategyRecord.GUID, ExitRuleWaterfallDrop)
 
 	// 发送电报通知
 	text := "🚨*%s* 触发防瀑布机制!\n\n`%s`\n\n🎯 跌幅阈值: %s%%\n💥 当前跌幅: %s%%\n\n✅ 已自动清仓并停止策略!"
 	text = fmt.Sprintf(text, strategyRecord.Symbol, strategyRecord.Token, strategyRecord.DropThreshold.Truncate(2), drop.Truncate(2))
-	_, err = utils.SendMessage(s.svcCtx.BotApi, strategyRecord.UserId, text)
+	err = s.env.SendMessage(ctx, strategyRecord.UserId, text)
 	if err != nil {
 		logger.Warnf("[GridStrategy] 发送电报通知失败, userId: %d, text: %s, %v", strategyRecord.UserId, text, err)
 	}
@@ -675,12 +828,13 @@ func (s *GridStrategy) handleUpperBoundExit(ctx context.Context, strategyRecord
 	var orderArgs *ent.Order
 	if len(gridRecords) > 0 && uiTotalQuantity.GreaterThan(decimal.Zero) {
 		minSellPrice := latestPrice.Sub(latestPrice.Mul(decimal.NewFromFloat(0.01)))
-		ord, err := SellToken(ctx, s.svcCtx, strategyRecord, "突破退场目标价格", nil, &minSellPrice, true)
+		ord, err := sellToken(ctx, s.svcCtx, s.env, strategyRecord, "突破退场目标价格", nil, &minSellPrice, true, func(orderArgs *ent.Order) {
+			orderArgs.GridBuyCost = &uiTotalAmount
+		})
 		if err != nil {
 			return false, err
 		}
 		orderArgs = &ord
-		orderArgs.GridBuyCost = &uiTotalAmount
 	}
 
 	// 更新数据状态
@@ -691,7 +845,7 @@ func (s *GridStrategy) handleUpperBoundExit(ctx context.Context, strategyRecord
 		}
 
 		if orderArgs != nil {
-			_, err = model.NewOrderModel(tx.Order).Save(ctx, *orderArgs)
+			err = model.NewOrderModel(tx.Order).SetOrderPendingStatus(ctx, orderArgs.ID)
 			if err != nil {
 				return err
 			}
@@ -717,12 +871,12 @@ func (s *GridStrategy) handleUpperBoundExit(ctx context.Context, strategyRecord
 	}
 
 	// 停止策略运行
-	s.svcCtx.Engine.StopStrategy(strategyRecord.GUID)
+	s.env.StopStrategy(ctx, strategyRecord.GUID, ExitRuleUpperBoundExit)
 
 	// 发送电报通知
 	text := "🚨*%s* 突破退场目标价格!\n\n`%s`\n\n🎯 目标价格: %sU\n💥 当前价格: %sU\n\n✅ 已自动清仓并停止策略!"
 	text = fmt.Sprintf(text, strategyRecord.Symbol, strategyRecord.Token, *strategyRecord.UpperBoundExit, format.Price(latestPrice, 5))
-	_, err = utils.SendMessage(s.svcCtx.BotApi, strategyRecord.UserId, text)
+	err = s.env.SendMessage(ctx, strategyRecord.UserId, text)
 	if err != nil {
 		logger.Warnf("[GridStrategy] 发送电报通知失败, userId: %d, text: %s, %v", strategyRecord.UserId, text, err)
 	}
@@ -741,23 +895,23 @@ func (s *GridStrategy) handleDynamicStopLoss(ctx context.Context, strategyRecord
 	logger.Infof("[GridStrategy] 动态止损, strategy: %v, token: %s, price: %v, gridNumber: %d, currentGridNumber: %d",
 		s.strategyId, strategyRecord.Symbol, latestPrice, gridRecord.GridNumber, gridNumber)
 	minSellPrice := latestPrice.Sub(latestPrice.Mul(decimal.NewFromFloat(0.01)))
-	orderArgs, err := SellToken(ctx, s.svcCtx, strategyRecord, "动态止损", &gridRecord.Quantity, &minSellPrice, true)
+	orderArgs, err := sellToken(ctx, s.svcCtx, s.env, strategyRecord, "动态止损", &gridRecord.Quantity, &minSellPrice, true, func(orderArgs *ent.Order) {
+		orderArgs.GridId = &gridRecord.GUID
+		orderArgs.GridNumber = &gridRecord.GridNumber
+		orderArgs.GridBuyCost = &gridRecord.Amount
+	})
 	if err != nil {
 		return
 	}
 
 	// 更新数据状态
-	orderArgs.GridId = &gridRecord.GUID
-	orderArgs.GridNumber = &gridRecord.GridNumber
-	orderArgs.GridBuyCost = &gridRecord.Amount
 	err = utils.Tx(ctx, s.svcCtx.DbClient, func(tx *ent.Tx) error {
 		err = model.NewGridModel(tx.Grid).SetSellingStatus(ctx, gridRecord.GUID)
 		if err != nil {
 			return err
 		}
 
-		_, err = model.NewOrderModel(tx.Order).Save(ctx, orderArgs)
-		return err
+		return model.NewOrderModel(tx.Order).SetOrderPendingStatus(ctx, orderArgs.ID)
 	})
 	if err != nil {
 		logger.Errorf("[GridStrategy] 动态止损 - 保存订单失败, order: %+v, %v", orderArgs, err)
@@ -771,7 +925,7 @@ func (s *GridStrategy) handleDynamicStopLoss(ctx context.Context, strategyRecord
 	priceDrop := gridRecord.Amount.Sub(uiOutAmount).Div(gridRecord.Amount).Mul(decimal.NewFromInt(100)).Truncate(2)
 	text := fmt.Sprintf("🚨*%s* 网格 `#%d` 执行动态止损\n\n当前跌幅: *%v%%*, 预计亏损: *%sU*",
 		strategyRecord.Symbol, gridRecord.GridNumber, priceDrop, gridRecord.Amount.Sub(uiOutAmount).Truncate(2))
-	_, err = utils.SendMessage(s.svcCtx.BotApi, strategyRecord.UserId, text)
+	err = s.env.SendMessage(ctx, strategyRecord.UserId, text)
 	if err != nil {
 		logger.Warnf("[GridStrategy] 发送电报通知失败, userId: %d, text: %s, %v", strategyRecord.UserId, text, err)
 	}
@@ -810,12 +964,13 @@ func (s *GridStrategy) handleGlobalTakeProfit(ctx context.Context, strategyRecor
 	var orderArgs *ent.Order
 	if len(gridRecords) > 0 && uiTotalQuantity.GreaterThan(decimal.Zero) {
 		minSellPrice := latestPrice.Sub(latestPrice.Mul(decimal.NewFromFloat(0.01)))
-		ord, err := SellToken(ctx, s.svcCtx, strategyRecord, "触发全局止盈", nil, &minSellPrice, true)
+		ord, err := sellToken(ctx, s.svcCtx, s.env, strategyRecord, "触发全局止盈", nil, &minSellPrice, true, func(orderArgs *ent.Order) {
+			orderArgs.GridBuyCost = &uiTotalAmount
+		})
 		if err != nil {
 			return false, err
 		}
 		orderArgs = &ord
-		orderArgs.GridBuyCost = &uiTotalAmount
 	}
 
 	// 更新数据状态
@@ -826,7 +981,7 @@ func (s *GridStrategy) handleGlobalTakeProfit(ctx context.Context, strategyRecor
 		}
 
 		if orderArgs != nil {
-			_, err = model.NewOrderModel(tx.Order).Save(ctx, *orderArgs)
+			err = model.NewOrderModel(tx.Order).SetOrderPendingStatus(ctx, orderArgs.ID)
 			if err != nil {
 				return err
 			}
@@ -852,12 +1007,12 @@ func (s *GridStrategy) handleGlobalTakeProfit(ctx context.Context, strategyRecor
 	}
 
 	// 停止策略运行
-	s.svcCtx.Engine.StopStrategy(strategyRecord.GUID)
+	s.env.StopStrategy(ctx, strategyRecord.GUID, ExitRuleGlobalTakeProfit)
 
 	// 发送电报通知
 	text := "🚨*%s* 触发全局止盈!\n\n`%s`\n\n🎯 目标涨幅: %s%%\n💥 当前价格: %sU\n\n✅ 已自动清仓并停止策略!"
 	text = fmt.Sprintf(text, strategyRecord.Symbol, strategyRecord.Token, ratio.Mul(decimal.NewFromInt(100)).Truncate(2), format.Price(latestPrice, 5))
-	_, err = utils.SendMessage(s.svcCtx.BotApi, strategyRecord.UserId, text)
+	err = s.env.SendMessage(ctx, strategyRecord.UserId, text)
 	if err != nil {
 		logger.Warnf("[GridStrategy] 发送电报通知失败, userId: %d, text: %s, %v", strategyRecord.UserId, text, err)
 	}
@@ -890,12 +1045,13 @@ func (s *GridStrategy) handleTakeProfitAtTarget(ctx context.Context, strategyRec
 	var orderArgs *ent.Order
 	if len(gridRecords) > 0 && uiTotalQuantity.GreaterThan(decimal.Zero) {
 		minSellPrice := latestPrice.Sub(latestPrice.Mul(decimal.NewFromFloat(0.01)))
-		ord, err := SellToken(ctx, s.svcCtx, strategyRecord, "达到盈利目标", nil, &minSellPrice, true)
+		ord, err := sellToken(ctx, s.svcCtx, s.env, strategyRecord, "达到盈利目标", nil, &minSellPrice, true, func(orderArgs *ent.Order) {
+			orderArgs.GridBuyCost = &uiTotalAmount
+		})
 		if err != nil {
 			return false, err
 		}
 		orderArgs = &ord
-		orderArgs.GridBuyCost = &uiTotalAmount
 	}
 
 	// 更新数据状态
@@ -906,7 +1062,7 @@ func (s *GridStrategy) handleTakeProfitAtTarget(ctx context.Context, strategyRec
 		}
 
 		if orderArgs != nil {
-			_, err = model.NewOrderModel(tx.Order).Save(ctx, *orderArgs)
+			err = model.NewOrderModel(tx.Order).SetOrderPendingStatus(ctx, orderArgs.ID)
 			if err != nil {
 				return err
 			}
@@ -932,12 +1088,12 @@ func (s *GridStrategy) handleTakeProfitAtTarget(ctx context.Context, strategyRec
 	}
 
 	// 停止策略运行
-	s.svcCtx.Engine.StopStrategy(strategyRecord.GUID)
+	s.env.StopStrategy(ctx, strategyRecord.GUID, ExitRuleTakeProfitAtTarget)
 
 	// 发送电报通知
 	text := "🚨*%s* 达到盈利目标!\n\n`%s`\n\n🎯 盈利目标: %sU\n💥 预计盈利: %sU\n\n✅ 已自动清仓并停止策略!"
 	text = fmt.Sprintf(text, strategyRecord.Symbol, strategyRecord.Token, *strategyRecord.TakeProfitExit, totalProfit.Truncate(2))
-	_, err = utils.SendMessage(s.svcCtx.BotApi, strategyRecord.UserId, text)
+	err = s.env.SendMessage(ctx, strategyRecord.UserId, text)
 	if err != nil {
 		logger.Warnf("[GridStrategy] 发送电报通知失败, userId: %d, text: %s, %v", strategyRecord.UserId, text, err)
 	}
@@ -970,12 +1126,13 @@ func (s *GridStrategy) handleStopLossAtThreshold(ctx context.Context, strategyRe
 	var orderArgs *ent.Order
 	if len(gridRecords) > 0 && uiTotalQuantity.GreaterThan(decimal.Zero) {
 		minSellPrice := latestPrice.Sub(latestPrice.Mul(decimal.NewFromFloat(0.01)))
-		ord, err := SellToken(ctx, s.svcCtx, strategyRecord, "亏损达到预设金额", nil, &minSellPrice, true)
+		ord, err := sellToken(ctx, s.svcCtx, s.env, strategyRecord, "亏损达到预设金额", nil, &minSellPrice, true, func(orderArgs *ent.Order) {
+			orderArgs.GridBuyCost = &uiTotalAmount
+		})
 		if err != nil {
 			return false, err
 		}
 		orderArgs = &ord
-		orderArgs.GridBuyCost = &uiTotalAmount
 	}
 
 	// 更新数据状态
@@ -986,7 +1143,7 @@ func (s *GridStrategy) handleStopLossAtThreshold(ctx context.Context, strategyRe
 		}
 
 		if orderArgs != nil {
-			_, err = model.NewOrderModel(tx.Order).Save(ctx, *orderArgs)
+			err = model.NewOrderModel(tx.Order).SetOrderPendingStatus(ctx, orderArgs.ID)
 			if err != nil {
 				return err
 			}
@@ -1012,12 +1169,12 @@ func (s *GridStrategy) handleStopLossAtThreshold(ctx context.Context, strategyRe
 	}
 
 	// 停止策略运行
-	s.svcCtx.Engine.StopStrategy(strategyRecord.GUID)
+	s.env.StopStrategy(ctx, strategyRecord.GUID, ExitRuleStopLossAtThreshold)
 
 	// 发送电报通知
 	text := "🚨*%s* 亏损达到预设金额!\n\n`%s`\n\n🎯 亏损金额: %sU\n💥 当前价格: %sU\n\n✅ 已自动清仓并停止策略!"
 	text = fmt.Sprintf(text, strategyRecord.Symbol, strategyRecord.Token, totalProfit, format.Price(latestPrice, 5))
-	_, err = utils.SendMessage(s.svcCtx.BotApi, strategyRecord.UserId, text)
+	err = s.env.SendMessage(ctx, strategyRecord.UserId, text)
 	if err != nil {
 		logger.Warnf("[GridStrategy] 发送电报通知失败, userId: %d, text: %s, %v", strategyRecord.UserId, text, err)
 	}
@@ -0,0 +1,185 @@
+package strategy
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/fachebot/evm-grid-bot/internal/ent"
+	entstrategy "github.com/fachebot/evm-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/evm-grid-bot/internal/logger"
+	"github.com/fachebot/evm-grid-bot/internal/model"
+	"github.com/fachebot/evm-grid-bot/internal/utils"
+	"github.com/fachebot/evm-grid-bot/internal/utils/format"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	defaultTrailingDwell   = 30 * time.Minute
+	trailingSignificantNum = 8
+	// 重新居中后超出价格区间的网格编号, 不占用网格档位, 只等待止盈卖出
+	outOfRangeGridNumber = -1
+)
+
+// 网格编号是否在网格列表范围内
+func isGridInRange(item *ent.Grid) bool {
+	return item.GridNumber != outOfRangeGridNumber
+}
+
+// 保留有效数字
+func roundSignificant(d decimal.Decimal, digits int32) decimal.Decimal {
+	if d.IsZero() {
+		return d
+	}
+	exp := int32(math.Floor(math.Log10(math.Abs(d.InexactFloat64()))))
+	return d.Round(digits - 1 - exp)
+}
+
+// 按比例平移价格区间, 使当前价格位于区间中心
+func recenterStrategy(strategyRecord *ent.Strategy, latestPrice decimal.Decimal) (ent.Strategy, error) {
+	center := math.Sqrt(strategyRecord.LowerPriceBound.InexactFloat64() * strategyRecord.UpperPriceBound.InexactFloat64())
+	if center <= 0 || math.IsInf(center, 0) || math.IsNaN(center) {
+		return ent.Strategy{}, fmt.Errorf("invalid price bounds")
+	}
+
+	factor := latestPrice.Div(decimal.NewFromFloat(center))
+	shift := func(price decimal.Decimal) decimal.Decimal {
+		return roundSignificant(price.Mul(factor), trailingSignificantNum)
+	}
+
+	newRecord := *strategyRecord
+	newRecord.LowerPriceBound = shift(strategyRecord.LowerPriceBound)
+	newRecord.UpperPriceBound = shift(strategyRecord.UpperPriceBound)
+	if strategyRecord.PriceStep != nil {
+		priceStep := shift(*strategyRecord.PriceStep)
+		newRecord.PriceStep = &priceStep
+	}
+	if strategyRecord.GridType == entstrategy.GridTypeCustom {
+		levels := DecodeGridLadder(strategyRecord.GridLadder)
+		for idx := range levels {
+			levels[idx].Price = shift(levels[idx].Price)
+		}
+		gridLadder := EncodeGridLadder(levels)
+		newRecord.GridLadder = &gridLadder
+	}
+
+	if _, err := GenerateGridList(&newRecord); err != nil {
+		return ent.Strategy{}, err
+	}
+	return newRecord, nil
+}
+
+// 网格列表变化后, 按买入价格重新计算网格编号, 超出价格区间的网格使用 outOfRangeGridNumber
+func renumberGrids(ctx context.Context, gridModel *model.GridModel, gridRecords []*ent.Grid, gridList []decimal.Decimal) error {
+	for _, item := range gridRecords {
+		price := item.OrderPrice
+		if item.SellPrice != nil {
+			price = *item.SellPrice
+		}
+
+		gridNumber := outOfRangeGridNumber
+		if len(gridList) > 0 && price.GreaterThanOrEqual(gridList[0]) && price.LessThanOrEqual(gridList[len(gridList)-1]) {
+			gridNumber, _ = utils.CalculateGridPosition(gridList, price)
+			if item.SellPrice != nil {
+				// 库存网格编号为卖出价格的下一格
+				gridNumber = max(gridNumber-1, 0)
+			}
+		}
+		if err := gridModel.UpdateGridNumber(ctx, item.GUID, gridNumber); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 价格离开区间超过等待时间后, 重新居中价格区间, 已买入网格保持不变
+func (s *GridStrategy) handleTrailing(ctx context.Context, strategyRecord *ent.Strategy, gridRecords []*ent.Grid, latestPrice decimal.Decimal, now time.Time) {
+	// 记录离开区间时间
+	if strategyRecord.OutOfRangeTime == nil {
+		err := s.svcCtx.StrategyModel.UpdateOutOfRangeTime(ctx, strategyRecord.ID, now)
+		if err != nil {
+			logger.Errorf("[GridStrategy] 更新离开区间时间失败, strategy: %s, %v", strategyRecord.GUID, err)
+		}
+		return
+	}
+
+	dwell := defaultTrailingDwell
+	if strategyRecord.TrailingDwellMinutes > 0 {
+		dwell = time.Duration(strategyRecord.TrailingDwellMinutes) * time.Minute
+	}
+	if now.Sub(*strategyRecord.OutOfRangeTime) < dwell {
+		return
+	}
+
+	// 计算新的价格区间
+	newRecord, err := recenterStrategy(strategyRecord, latestPrice)
+	if err != nil {
+		logger.Errorf("[GridStrategy] 网格追踪 - 计算价格区间失败, strategy: %s, price: %v, %v", strategyRecord.GUID, latestPrice, err)
+		return
+	}
+	gridList, err := GenerateGridList(&newRecord)
+	if err != nil {
+		return
+	}
+
+	// 更新价格区间和网格编号
+	err = utils.Tx(ctx, s.svcCtx.DbClient, func(tx *ent.Tx) error {
+		strategyModel := model.NewStrategyModel(tx.Strategy)
+		if err := strategyModel.UpdateLowerPriceBound(ctx, strategyRecord.ID, newRecord.LowerPriceBound); err != nil {
+			return err
+		}
+		if err := strategyModel.UpdateUpperPriceBound(ctx, strategyRecord.ID, newRecord.UpperPriceBound); err != nil {
+			return err
+		}
+		if newRecord.PriceStep != nil {
+			if err := strategyModel.UpdatePriceStep(ctx, strategyRecord.ID, *newRecord.PriceStep); err != nil {
+				return err
+			}
+		}
+		if newRecord.GridLadder != nil {
+			if err := strategyModel.UpdateGridLadder(ctx, strategyRecord.ID, *newRecord.GridLadder); err != nil {
+				return err
+			}
+		}
+		if err := strategyModel.UpdateGridTrend(ctx, strategyRecord.ID, ""); err != nil {
+			return err
+		}
+		if err := strategyModel.ClearOutOfRangeTime(ctx, strategyRecord.ID); err != nil {
+			return err
+		}
+		if err := strategyModel.ClearLastLowerThresholdAlertTime(ctx, strategyRecord.ID); err != nil {
+			return err
+		}
+		if err := strategyModel.ClearLastUpperThresholdAlertTime(ctx, strategyRecord.ID); err != nil {
+			return err
+		}
+
+		// 固定已买入网格的止盈价格
+		gridModel := model.NewGridModel(tx.Grid)
+		if err := fixTakeProfitPrices(ctx, gridModel, strategyRecord, gridRecords); err != nil {
+			return err
+		}
+		return renumberGrids(ctx, gridModel, gridRecords, gridList)
+	})
+	if err != nil {
+		logger.Errorf("[GridStrategy] 网格追踪 - 更新价格区间失败, strategy: %s, %v", strategyRecord.GUID, err)
+		return
+	}
+
+	logger.Infof("[GridStrategy] 网格追踪, strategy: %s, price: %v, bounds: %v ~ %v -> %v ~ %v",
+		strategyRecord.GUID, latestPrice, strategyRecord.LowerPriceBound, strategyRecord.UpperPriceBound, newRecord.LowerPriceBound, newRecord.UpperPriceBound)
+
+	if !strategyRecord.EnablePushNotification {
+		return
+	}
+
+	text := "🔁 *%s* 网格已自动追踪价格!\n\n`%s`\n\n💥 当前价格: %s\n⬅️ 原价格区间: $%s ~ $%s\n➡️ 新价格区间: $%s ~ $%s\n\n✅ 已买入网格保持不变"
+	text = fmt.Sprintf(text, strategyRecord.Symbol, strategyRecord.Token, format.Price(latestPrice, 5),
+		strategyRecord.LowerPriceBound, strategyRecord.UpperPriceBound, newRecord.LowerPriceBound, newRecord.UpperPriceBound)
+	err = s.env.SendMessage(ctx, strategyRecord.UserId, text)
+	if err != nil {
+		logger.Warnf("[GridStrategy] 发送电报通知失败, userId: %d, text: %s, %v", strategyRecord.UserId, text, err)
+	}
+}
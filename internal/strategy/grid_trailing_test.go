@@ -0,0 +1,115 @@
+package strategy
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/fachebot/evm-grid-bot/internal/ent"
+	"github.com/fachebot/evm-grid-bot/internal/ent/enttest"
+	"github.com/fachebot/evm-grid-bot/internal/ent/grid"
+	entstrategy "github.com/fachebot/evm-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/evm-grid-bot/internal/model"
+	"github.com/fachebot/evm-grid-bot/internal/svc"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/shopspring/decimal"
+)
+
+func TestHandleTrailingKeepsTakeProfitPrice(t *testing.T) {
+	priceStep := decimal.RequireFromString("0.1")
+
+	tests := []struct {
+		name      string
+		gridType  entstrategy.GridType
+		priceStep *decimal.Decimal
+	}{
+		{
+			name:     "等比网格",
+			gridType: entstrategy.GridTypeGeometric,
+		},
+		{
+			name:      "等差网格",
+			gridType:  entstrategy.GridTypeArithmetic,
+			priceStep: &priceStep,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&_fk=1", t.Name()))
+			defer client.Close()
+
+			svcCtx := &svc.ServiceContext{
+				DbClient:      client,
+				GridModel:     model.NewGridModel(client.Grid),
+				StrategyModel: model.NewStrategyModel(client.Strategy),
+			}
+
+			outOfRangeTime := time.Now().Add(-time.Hour)
+			strategyRecord, err := svcCtx.StrategyModel.Save(ctx, ent.Strategy{
+				GUID:             "trailing",
+				UserId:           1,
+				Token:            "0x0000000000000000000000000000000000001234",
+				Symbol:           "TEST",
+				MartinFactor:     1,
+				GridMode:         entstrategy.DefaultGridMode,
+				GridType:         tt.gridType,
+				PriceStep:        tt.priceStep,
+				TakeProfitRatio:  decimal.NewFromInt(5),
+				LowerPriceBound:  decimal.NewFromInt(1),
+				UpperPriceBound:  decimal.NewFromInt(2),
+				InitialOrderSize: decimal.NewFromInt(10),
+				TrailingUp:       true,
+				EmaFilter:        entstrategy.DefaultEmaFilter,
+				Status:           entstrategy.StatusActive,
+				OutOfRangeTime:   &outOfRangeTime,
+			})
+			if err != nil {
+				t.Fatalf("StrategyModel.Save() error = %v", err)
+			}
+
+			gridRecord, err := svcCtx.GridModel.Save(ctx, ent.Grid{
+				GUID:       "bought",
+				Account:    "0x0000000000000000000000000000000000005678",
+				Token:      strategyRecord.Token,
+				Symbol:     strategyRecord.Symbol,
+				StrategyId: strategyRecord.GUID,
+				GridNumber: 5,
+				OrderPrice: decimal.RequireFromString("1.5"),
+				FinalPrice: decimal.RequireFromString("1.5"),
+				Amount:     decimal.NewFromInt(10),
+				Quantity:   decimal.RequireFromString("6.666"),
+				Status:     grid.StatusBought,
+			})
+			if err != nil {
+				t.Fatalf("GridModel.Save() error = %v", err)
+			}
+			expected := calculateTakeProfitPrice(strategyRecord, gridRecord)
+
+			s := &GridStrategy{svcCtx: svcCtx, strategyId: strategyRecord.GUID}
+			s.handleTrailing(ctx, strategyRecord, []*ent.Grid{gridRecord}, decimal.NewFromInt(3), time.Now())
+
+			newRecord, err := svcCtx.StrategyModel.FindByGUID(ctx, strategyRecord.GUID)
+			if err != nil {
+				t.Fatalf("StrategyModel.FindByGUID() error = %v", err)
+			}
+			if newRecord.LowerPriceBound.Equal(strategyRecord.LowerPriceBound) {
+				t.Fatalf("handleTrailing() did not recenter, bounds: %v ~ %v", newRecord.LowerPriceBound, newRecord.UpperPriceBound)
+			}
+
+			newGridRecord, err := svcCtx.GridModel.FindByGuid(ctx, gridRecord.GUID)
+			if err != nil {
+				t.Fatalf("GridModel.FindByGuid() error = %v", err)
+			}
+			if newGridRecord.GridNumber != outOfRangeGridNumber {
+				t.Errorf("GridNumber = %d, expected %d", newGridRecord.GridNumber, outOfRangeGridNumber)
+			}
+			if actual := calculateTakeProfitPrice(newRecord, newGridRecord); !actual.Equal(expected) {
+				t.Errorf("calculateTakeProfitPrice() = %v, expected %v", actual, expected)
+			}
+		})
+	}
+}
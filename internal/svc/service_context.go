@@ -37,12 +37,14 @@ type ServiceContext struct {
 	EthClient      *ethclient.Client
 	MessageCache   *cache.MessageCache
 	TokenMetaCache *cache.TokenMetaCache
+	StrategyCache  *cache.StrategyCache
 	GridModel      *model.GridModel
 	OrderModel     *model.OrderModel
 	SettingsModel  *model.SettingsModel
 	StrategyModel  *model.StrategyModel
 	WalletModel    *model.WalletModel
 	NonceManager   *eth.NonceManager
+	StrategyLocker *utils.KeyedLocker
 }
 
 func NewServiceContext(c *config.Config, strategyEngine *engine.StrategyEngine, ethClient *ethclient.Client) *ServiceContext {
@@ -122,12 +124,14 @@ func NewServiceContext(c *config.Config, strategyEngine *engine.StrategyEngine,
 		TransportProxy: transportProxy,
 		MessageCache:   cache.NewMessageCache(),
 		TokenMetaCache: cache.NewTokenMetaCache(ethClient),
+		StrategyCache:  cache.NewStrategyCache(client),
 		GridModel:      model.NewGridModel(client.Grid),
 		OrderModel:     model.NewOrderModel(client.Order),
 		SettingsModel:  model.NewSettingsModel(client.Settings),
 		StrategyModel:  model.NewStrategyModel(client.Strategy),
 		WalletModel:    model.NewWalletModel(client.Wallet),
 		NonceManager:   eth.NewNonceManager(client, ethClient),
+		StrategyLocker: utils.NewKeyedLocker(),
 	}
 
 	return svcCtx
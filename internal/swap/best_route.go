@@ -0,0 +1,177 @@
+package swap
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fachebot/evm-grid-bot/internal/dexagg/onchain"
+	"github.com/fachebot/evm-grid-bot/internal/ent/settings"
+	"github.com/fachebot/evm-grid-bot/internal/logger"
+	"github.com/fachebot/evm-grid-bot/internal/utils/evm"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// 单个聚合器报价超时时间, 超时后忽略该聚合器
+	bestRouteQuoteTimeout = 5 * time.Second
+	// 链上路由每跳预估 gas
+	onchainV2HopGas = 110000
+	onchainV3HopGas = 150000
+)
+
+// 最优路由报价, 覆盖原交易的路由信息
+type routedSwapTransaction struct {
+	SwapTransaction
+	route RouteInfo
+}
+
+func (tx *routedSwapTransaction) Route() RouteInfo {
+	return tx.route
+}
+
+// 扣除费用后的报价
+type routeCandidate struct {
+	quoteResult
+	valueUsd decimal.Decimal
+	netOut   decimal.Decimal
+}
+
+// 已配置的聚合器
+func (s *SwapService) enabledAggregators() []settings.DexAggregator {
+	c := s.svcCtx.Config
+	aggregators := []settings.DexAggregator{settings.DexAggregatorRelay}
+	if c.OkxWeb3.Apikey != "" {
+		aggregators = append(aggregators, settings.DexAggregatorOkx)
+	}
+	if c.Chain.OnchainDex.V2.Router != "" || c.Chain.OnchainDex.V3.Router != "" {
+		aggregators = append(aggregators, settings.DexAggregatorOnchain)
+	}
+	return aggregators
+}
+
+// 并行获取所有聚合器报价, 选择扣除 gas 和手续费后净输出最多的路由
+func (s *SwapService) quoteBest(ctx context.Context, req quoteRequest) (SwapTransaction, error) {
+	aggregators := s.enabledAggregators()
+	results := make([]quoteResult, len(aggregators))
+	errs := make([]error, len(aggregators))
+
+	var waitGroup sync.WaitGroup
+	for idx, aggregator := range aggregators {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			quoteCtx, cancel := context.WithTimeout(ctx, bestRouteQuoteTimeout)
+			defer cancel()
+			results[idx], errs[idx] = s.quoteAggregator(quoteCtx, aggregator, req, true)
+		}()
+	}
+	waitGroup.Wait()
+
+	candidates := make([]routeCandidate, 0, len(aggregators))
+	for idx, aggregator := range aggregators {
+		if errs[idx] != nil {
+			logger.Warnf("[SwapService] 获取报价失败, aggregator: %s, %s -> %s, amount: %s, %v",
+				aggregator, req.inputToken, req.outputToken, req.amount, errs[idx])
+			continue
+		}
+
+		outAmount := results[idx].tx.OutAmount()
+		if outAmount == nil || outAmount.Sign() <= 0 {
+			continue
+		}
+		candidates = append(candidates, s.newRouteCandidate(req, results[idx]))
+	}
+
+	if len(candidates) == 0 {
+		if err := errors.Join(errs...); err != nil {
+			return nil, err
+		}
+		return nil, errors.New("no available quote")
+	}
+
+	best, route := selectBestRoute(candidates)
+	logger.Infof("[SwapService] 最优路由报价, %s -> %s, amount: %s, route: %s, runnerUp: %s, spreadUsd: %v, candidates: %d",
+		req.inputToken, req.outputToken, req.amount, route.Route, route.RunnerUp, route.SpreadUsd, len(candidates))
+
+	return &routedSwapTransaction{SwapTransaction: best.tx, route: route}, nil
+}
+
+// 按净输出从高到低排序, 返回最优报价及其与次优报价的差价
+func selectBestRoute(candidates []routeCandidate) (routeCandidate, RouteInfo) {
+	slices.SortFunc(candidates, func(a, b routeCandidate) int {
+		return b.netOut.Cmp(a.netOut)
+	})
+
+	best := candidates[0]
+	route := RouteInfo{Route: best.tx.Route().Route}
+	if len(candidates) > 1 {
+		runnerUp := candidates[1]
+		route.RunnerUp = runnerUp.tx.Route().Route
+		if best.valueUsd.IsPositive() {
+			// 净输出差值按最优报价的价格折算为 USD
+			outAmount := decimal.NewFromBigInt(best.tx.OutAmount(), 0)
+			spreadUsd := best.netOut.Sub(runnerUp.netOut).Mul(best.valueUsd).Div(outAmount).Round(6)
+			route.SpreadUsd = &spreadUsd
+		}
+	}
+	return best, route
+}
+
+// 计算扣除费用后的净输出, 费用按报价隐含价格折算为输出代币数量
+func (s *SwapService) newRouteCandidate(req quoteRequest, result quoteResult) routeCandidate {
+	outAmount := result.tx.OutAmount()
+	candidate := routeCandidate{
+		quoteResult: result,
+		netOut:      decimal.NewFromBigInt(outAmount, 0),
+	}
+
+	// 输入或输出为稳定币时才能估算交易价值, 否则只比较输出数量
+	c := s.svcCtx.Config.Chain
+	switch {
+	case strings.EqualFold(req.outputToken, c.StablecoinCA):
+		candidate.valueUsd = evm.ParseUnits(outAmount, c.StablecoinDecimals)
+	case strings.EqualFold(req.inputToken, c.StablecoinCA):
+		candidate.valueUsd = evm.ParseUnits(req.amount, c.StablecoinDecimals)
+	}
+
+	if candidate.valueUsd.IsPositive() {
+		ratio := decimal.NewFromInt(1).Sub(result.feeUsd.Div(candidate.valueUsd))
+		candidate.netOut = candidate.netOut.Mul(ratio)
+	}
+	return candidate
+}
+
+// 估算链上路由的 gas 费用(USD), 原生代币价格通过链上报价获取
+func (s *SwapService) estimateOnchainFeeUsd(ctx context.Context, client *onchain.Client, route *onchain.Route) (decimal.Decimal, error) {
+	c := s.svcCtx.Config.Chain
+	if c.OnchainDex.WrappedNative == "" {
+		return decimal.Zero, errors.New("wrapped native token not configured")
+	}
+
+	hopGas := int64(onchainV2HopGas)
+	if route.Version == onchain.V3 {
+		hopGas = onchainV3HopGas
+	}
+	gas := big.NewInt(hopGas * int64(len(route.Tokens)-1))
+
+	gasPrice, err := s.svcCtx.EthClient.SuggestGasPrice(ctx)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	one := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(c.NativeCurrency.Decimals)), nil)
+	nativeRoute, err := client.Quote(ctx, c.OnchainDex.WrappedNative, c.StablecoinCA, one)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	nativePrice := evm.ParseUnits(nativeRoute.AmountOut, c.StablecoinDecimals)
+	fee := evm.ParseUnits(new(big.Int).Mul(gas, gasPrice), c.NativeCurrency.Decimals)
+	return fee.Mul(nativePrice), nil
+}
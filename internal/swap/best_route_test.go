@@ -0,0 +1,175 @@
+package swap
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/fachebot/evm-grid-bot/internal/config"
+	"github.com/fachebot/evm-grid-bot/internal/svc"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	testStablecoin = "0x833589fCD6eDb6E08f4c7C32D4f71b54bdA02913"
+	testToken      = "0x4200000000000000000000000000000000000006"
+)
+
+func newTestSwapService() *SwapService {
+	c := &config.Config{Chain: config.Chain{StablecoinCA: testStablecoin, StablecoinDecimals: 6}}
+	return NewSwapService(&svc.ServiceContext{Config: c}, 0)
+}
+
+func newTestQuoteResult(route string, outAmount int64, feeUsd string) quoteResult {
+	return quoteResult{
+		tx:     NewPaperSwapTransaction(route, "", big.NewInt(outAmount), 0),
+		feeUsd: decimal.RequireFromString(feeUsd),
+	}
+}
+
+func TestNewRouteCandidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		inputToken  string
+		outputToken string
+		amount      int64
+		outAmount   int64
+		feeUsd      string
+		valueUsd    string
+		netOut      string
+	}{
+		{
+			name:        "输出为稳定币",
+			inputToken:  testToken,
+			outputToken: testStablecoin,
+			amount:      1000,
+			outAmount:   100_000_000,
+			feeUsd:      "1",
+			valueUsd:    "100",
+			netOut:      "99000000",
+		},
+		{
+			name:        "输入为稳定币",
+			inputToken:  testStablecoin,
+			outputToken: testToken,
+			amount:      50_000_000,
+			outAmount:   1000,
+			feeUsd:      "0.5",
+			valueUsd:    "50",
+			netOut:      "990",
+		},
+		{
+			name:        "稳定币地址不区分大小写",
+			inputToken:  testToken,
+			outputToken: "0x833589fcd6edb6e08f4c7c32d4f71b54bda02913",
+			amount:      1000,
+			outAmount:   10_000_000,
+			feeUsd:      "0.1",
+			valueUsd:    "10",
+			netOut:      "9900000",
+		},
+		{
+			name:        "无法估算交易价值时只比较输出数量",
+			inputToken:  testToken,
+			outputToken: "0x0000000000000000000000000000000000000001",
+			amount:      1000,
+			outAmount:   2000,
+			feeUsd:      "1",
+			valueUsd:    "0",
+			netOut:      "2000",
+		},
+	}
+
+	s := newTestSwapService()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := quoteRequest{inputToken: tt.inputToken, outputToken: tt.outputToken, amount: big.NewInt(tt.amount)}
+			candidate := s.newRouteCandidate(req, newTestQuoteResult("relay", tt.outAmount, tt.feeUsd))
+			if !candidate.valueUsd.Equal(decimal.RequireFromString(tt.valueUsd)) {
+				t.Errorf("newRouteCandidate() valueUsd = %v, expected %v", candidate.valueUsd, tt.valueUsd)
+			}
+			if !candidate.netOut.Equal(decimal.RequireFromString(tt.netOut)) {
+				t.Errorf("newRouteCandidate() netOut = %v, expected %v", candidate.netOut, tt.netOut)
+			}
+		})
+	}
+}
+
+func TestSelectBestRoute(t *testing.T) {
+	sell := quoteRequest{inputToken: testToken, outputToken: testStablecoin, amount: big.NewInt(1000)}
+	buy := quoteRequest{inputToken: testToken, outputToken: "0x0000000000000000000000000000000000000001", amount: big.NewInt(1000)}
+
+	tests := []struct {
+		name      string
+		req       quoteRequest
+		results   []quoteResult
+		route     string
+		runnerUp  string
+		spreadUsd string
+	}{
+		{
+			name:    "只有一个报价",
+			req:     sell,
+			results: []quoteResult{newTestQuoteResult("relay", 100_000_000, "1")},
+			route:   "relay",
+		},
+		{
+			name: "扣除费用后输出更多的报价胜出",
+			req:  sell,
+			results: []quoteResult{
+				newTestQuoteResult("relay", 100_500_000, "2"),
+				newTestQuoteResult("okx", 100_000_000, "1"),
+			},
+			route:     "okx",
+			runnerUp:  "relay",
+			spreadUsd: "0.5",
+		},
+		{
+			name: "三个报价时与第二名比较",
+			req:  sell,
+			results: []quoteResult{
+				newTestQuoteResult("onchain-v2", 90_000_000, "0"),
+				newTestQuoteResult("relay", 99_800_000, "0"),
+				newTestQuoteResult("okx", 100_000_000, "0"),
+			},
+			route:     "okx",
+			runnerUp:  "relay",
+			spreadUsd: "0.2",
+		},
+		{
+			name: "无法估算交易价值时不计算差价",
+			req:  buy,
+			results: []quoteResult{
+				newTestQuoteResult("relay", 1000, "1"),
+				newTestQuoteResult("okx", 2000, "1"),
+			},
+			route:    "okx",
+			runnerUp: "relay",
+		},
+	}
+
+	s := newTestSwapService()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			candidates := make([]routeCandidate, 0, len(tt.results))
+			for _, result := range tt.results {
+				candidates = append(candidates, s.newRouteCandidate(tt.req, result))
+			}
+
+			best, route := selectBestRoute(candidates)
+			if best.tx.Route().Route != tt.route || route.Route != tt.route || route.RunnerUp != tt.runnerUp {
+				t.Errorf("selectBestRoute() = %s, runnerUp %s, expected %s, runnerUp %s", route.Route, route.RunnerUp, tt.route, tt.runnerUp)
+			}
+
+			if tt.spreadUsd == "" {
+				if route.SpreadUsd != nil {
+					t.Errorf("selectBestRoute() spreadUsd = %v, expected nil", route.SpreadUsd)
+				}
+				return
+			}
+			if route.SpreadUsd == nil || !route.SpreadUsd.Equal(decimal.RequireFromString(tt.spreadUsd)) {
+				t.Errorf("selectBestRoute() spreadUsd = %v, expected %v", route.SpreadUsd, tt.spreadUsd)
+			}
+		})
+	}
+}
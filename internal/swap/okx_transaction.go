@@ -0,0 +1,205 @@
+package swap
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/fachebot/evm-grid-bot/internal/dexagg"
+	"github.com/fachebot/evm-grid-bot/internal/dexagg/okxweb3"
+	"github.com/fachebot/evm-grid-bot/internal/logger"
+	"github.com/fachebot/evm-grid-bot/internal/utils/evm"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/shopspring/decimal"
+)
+
+// OKX 返回的 gas 为预估值, 发送时适当放大
+const okxGasLimitBps = 12000
+
+type OkxSwapTransaction struct {
+	client      *okxweb3.Client
+	service     *SwapService
+	signer      string
+	inputToken  string
+	outputToken string
+	amount      *big.Int
+	slippageBps int
+	outAmount   *big.Int
+	quoteTime   time.Time
+	quoteTTL    time.Duration
+	guard       PriceGuard
+
+	infiniteApproval bool
+}
+
+func NewOkxSwapTransaction(service *SwapService, client *okxweb3.Client, signer, inputToken, outputToken string, amount *big.Int, slippageBps int, infiniteApproval bool, quote *okxweb3.RouterResult) *OkxSwapTransaction {
+	return &OkxSwapTransaction{
+		client:           client,
+		service:          service,
+		signer:           signer,
+		inputToken:       inputToken,
+		outputToken:      outputToken,
+		amount:           amount,
+		slippageBps:      slippageBps,
+		outAmount:        quote.ToTokenAmount.BigInt(),
+		quoteTime:        time.Now(),
+		quoteTTL:         service.quoteTTL(),
+		infiniteApproval: infiniteApproval,
+	}
+}
+
+func (tx *OkxSwapTransaction) Route() RouteInfo {
+	return RouteInfo{Route: "okx"}
+}
+
+func (tx *OkxSwapTransaction) Signer() string {
+	return tx.signer
+}
+
+func (tx *OkxSwapTransaction) OutAmount() *big.Int {
+	return tx.outAmount
+}
+
+func (tx *OkxSwapTransaction) SlippageBps() int {
+	return tx.slippageBps
+}
+
+func (tx *OkxSwapTransaction) QuoteTime() time.Time {
+	return tx.quoteTime
+}
+
+func (tx *OkxSwapTransaction) QuoteTTL() time.Duration {
+	return tx.quoteTTL
+}
+
+func (tx *OkxSwapTransaction) SetPriceGuard(guard PriceGuard) {
+	tx.guard = guard
+}
+
+func (tx *OkxSwapTransaction) chainIndex() string {
+	return strconv.FormatInt(tx.service.svcCtx.Config.Chain.Id, 10)
+}
+
+func (tx *OkxSwapTransaction) Swap(ctx context.Context, submit dexagg.SubmitFunc) (string, uint64, error) {
+	svcCtx := tx.service.svcCtx
+	userWallet, err := tx.service.getUserWallet(ctx)
+	if err != nil {
+		return "", 0, err
+	}
+
+	// 检查余额
+	inTokenBal, err := evm.GetTokenBalance(ctx, svcCtx.EthClient, tx.inputToken, tx.signer)
+	if err != nil {
+		return "", 0, err
+	}
+	if inTokenBal.Cmp(tx.amount) < 0 {
+		return "", 0, dexagg.ErrInsufficientBalance
+	}
+
+	// 授权额度不足时先发送授权交易, 并等待打包
+	if err = tx.approve(ctx); err != nil {
+		return "", 0, err
+	}
+
+	for attempt := 0; ; attempt++ {
+		// 获取兑换交易数据, 并重新检查价格保护
+		swapResult, err := tx.client.Swap(ctx, tx.chainIndex(), tx.inputToken, tx.outputToken, tx.amount, tx.slippageBps, tx.signer)
+		if err != nil {
+			return "", 0, err
+		}
+		outAmount := swapResult.RouterResult.ToTokenAmount.BigInt()
+		if tx.guard != nil {
+			if err = tx.guard(outAmount); err != nil {
+				return "", 0, err
+			}
+		}
+		tx.outAmount = outAmount
+		tx.quoteTime = time.Now()
+
+		req, err := tx.newTxRequest(ctx, swapResult.Tx.To, swapResult.Tx.Data, swapResult.Tx.Value.BigInt(), swapResult.Tx.Gas, swapResult.Tx.MaxPriorityFeePerGas)
+		if err != nil {
+			return "", 0, err
+		}
+
+		opts := dexagg.SendOptions{
+			Simulate: true,
+			ExpireAt: tx.quoteTime.Add(tx.quoteTTL),
+			Submit:   submit,
+		}
+		hash, nonce, err := dexagg.SendTransaction(ctx, svcCtx, userWallet, req, opts)
+		if !errors.Is(err, dexagg.ErrQuoteExpired) || attempt+1 >= maxRequotes {
+			return hash, nonce, err
+		}
+		logger.Debugf("[OkxSwapTransaction] 报价已过期, 重新报价, signer: %s, quoteTime: %v, attempt: %d", tx.signer, tx.quoteTime, attempt+1)
+	}
+}
+
+// 检查授权额度, 不足时发送授权交易并等待打包
+func (tx *OkxSwapTransaction) approve(ctx context.Context) error {
+	svcCtx := tx.service.svcCtx
+	approveAmount := tx.amount
+	if tx.infiniteApproval {
+		approveAmount = evm.MaxUint256
+	}
+
+	approveTx, err := tx.client.ApproveTransaction(ctx, tx.chainIndex(), tx.inputToken, approveAmount)
+	if err != nil {
+		return err
+	}
+
+	allowance, err := evm.GetTokenAllowance(ctx, svcCtx.EthClient, tx.inputToken, tx.signer, approveTx.DexContractAddress)
+	if err != nil {
+		return err
+	}
+	if allowance.Cmp(tx.amount) >= 0 {
+		return nil
+	}
+
+	userWallet, err := tx.service.getUserWallet(ctx)
+	if err != nil {
+		return err
+	}
+
+	req, err := tx.newTxRequest(ctx, tx.inputToken, approveTx.Data, big.NewInt(0), approveTx.GasLimit, decimal.Zero)
+	if err != nil {
+		return err
+	}
+
+	hash, _, err := dexagg.SendTransaction(ctx, svcCtx, userWallet, req, dexagg.SendOptions{Simulate: true})
+	if err != nil {
+		return err
+	}
+
+	logger.Infof("[OkxSwapTransaction] 发送授权交易, signer: %s, token: %s, spender: %s, amount: %s, hash: %s",
+		tx.signer, tx.inputToken, approveTx.DexContractAddress, approveAmount, hash)
+	return dexagg.WaitMined(ctx, svcCtx, hash)
+}
+
+func (tx *OkxSwapTransaction) newTxRequest(ctx context.Context, to, data string, value *big.Int, gas, priorityFee decimal.Decimal) (dexagg.TxRequest, error) {
+	input, err := hexutil.Decode(data)
+	if err != nil {
+		return dexagg.TxRequest{}, err
+	}
+
+	gasTipCap, minFeeCap, err := evm.SuggestGasFees(ctx, tx.service.svcCtx.EthClient)
+	if err != nil {
+		return dexagg.TxRequest{}, err
+	}
+	if priorityFee.GreaterThan(decimal.Zero) && priorityFee.BigInt().Cmp(gasTipCap) > 0 {
+		minFeeCap.Add(minFeeCap, new(big.Int).Sub(priorityFee.BigInt(), gasTipCap))
+		gasTipCap = priorityFee.BigInt()
+	}
+
+	return dexagg.TxRequest{
+		To:        common.HexToAddress(to),
+		Data:      input,
+		Value:     value,
+		Gas:       gas.Mul(decimal.NewFromInt(okxGasLimitBps)).Div(decimal.NewFromInt(10000)).BigInt().Uint64(),
+		GasTipCap: gasTipCap,
+		GasFeeCap: minFeeCap,
+	}, nil
+}
@@ -0,0 +1,239 @@
+package swap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/fachebot/evm-grid-bot/internal/dexagg"
+	"github.com/fachebot/evm-grid-bot/internal/dexagg/onchain"
+	"github.com/fachebot/evm-grid-bot/internal/logger"
+	"github.com/fachebot/evm-grid-bot/internal/utils/evm"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// 预估 gas 失败时使用默认值
+	defaultApproveGas = 100000
+	defaultSwapGas    = 500000
+	// 预估 gas 放大比例
+	onchainGasLimitBps = 12000
+	// 兑换交易截止时间
+	onchainSwapDeadline = 5 * time.Minute
+)
+
+type OnchainSwapTransaction struct {
+	client      *onchain.Client
+	service     *SwapService
+	signer      string
+	inputToken  string
+	outputToken string
+	route       *onchain.Route
+	slippageBps int
+	quoteTime   time.Time
+	quoteTTL    time.Duration
+	guard       PriceGuard
+
+	infiniteApproval bool
+}
+
+func NewOnchainSwapTransaction(service *SwapService, client *onchain.Client, signer, inputToken, outputToken string, route *onchain.Route, slippageBps int, infiniteApproval bool) *OnchainSwapTransaction {
+	return &OnchainSwapTransaction{
+		client:           client,
+		service:          service,
+		signer:           signer,
+		inputToken:       inputToken,
+		outputToken:      outputToken,
+		route:            route,
+		slippageBps:      slippageBps,
+		quoteTime:        time.Now(),
+		quoteTTL:         service.quoteTTL(),
+		infiniteApproval: infiniteApproval,
+	}
+}
+
+func (tx *OnchainSwapTransaction) Route() RouteInfo {
+	return RouteInfo{Route: fmt.Sprintf("onchain-v%d", tx.route.Version)}
+}
+
+func (tx *OnchainSwapTransaction) Signer() string {
+	return tx.signer
+}
+
+func (tx *OnchainSwapTransaction) OutAmount() *big.Int {
+	return tx.route.AmountOut
+}
+
+func (tx *OnchainSwapTransaction) SlippageBps() int {
+	return tx.slippageBps
+}
+
+func (tx *OnchainSwapTransaction) QuoteTime() time.Time {
+	return tx.quoteTime
+}
+
+func (tx *OnchainSwapTransaction) QuoteTTL() time.Duration {
+	return tx.quoteTTL
+}
+
+func (tx *OnchainSwapTransaction) SetPriceGuard(guard PriceGuard) {
+	tx.guard = guard
+}
+
+func (tx *OnchainSwapTransaction) Swap(ctx context.Context, submit dexagg.SubmitFunc) (string, uint64, error) {
+	svcCtx := tx.service.svcCtx
+	userWallet, err := tx.service.getUserWallet(ctx)
+	if err != nil {
+		return "", 0, err
+	}
+
+	// 检查余额
+	inTokenBal, err := evm.GetTokenBalance(ctx, svcCtx.EthClient, tx.inputToken, tx.signer)
+	if err != nil {
+		return "", 0, err
+	}
+	if inTokenBal.Cmp(tx.route.AmountIn) < 0 {
+		return "", 0, dexagg.ErrInsufficientBalance
+	}
+
+	approvedRouters := make(map[common.Address]bool)
+	for attempt := 0; ; attempt++ {
+		// 报价过期前完成签名, 否则重新报价
+		if time.Since(tx.quoteTime) > tx.quoteTTL {
+			if attempt >= maxRequotes {
+				return "", 0, dexagg.ErrQuoteExpired
+			}
+			if err = tx.refreshQuote(ctx); err != nil {
+				return "", 0, err
+			}
+		}
+
+		// 授权额度不足时先发送授权交易并等待打包, 重新报价后不重复授权同一路由
+		router := tx.client.Router(tx.route)
+		if !approvedRouters[router] {
+			if err = tx.approve(ctx); err != nil {
+				return "", 0, err
+			}
+			approvedRouters[router] = true
+		}
+
+		req, err := tx.newSwapRequest(ctx)
+		if err != nil {
+			return "", 0, err
+		}
+
+		opts := dexagg.SendOptions{
+			Simulate: true,
+			ExpireAt: tx.quoteTime.Add(tx.quoteTTL),
+			Submit:   submit,
+		}
+		hash, nonce, err := dexagg.SendTransaction(ctx, svcCtx, userWallet, req, opts)
+		if !errors.Is(err, dexagg.ErrQuoteExpired) {
+			return hash, nonce, err
+		}
+		logger.Debugf("[OnchainSwapTransaction] 报价已过期, 重新报价, signer: %s, quoteTime: %v, attempt: %d", tx.signer, tx.quoteTime, attempt+1)
+	}
+}
+
+// 重新报价, 并重新检查价格保护
+func (tx *OnchainSwapTransaction) refreshQuote(ctx context.Context) error {
+	route, err := tx.client.Quote(ctx, tx.inputToken, tx.outputToken, tx.route.AmountIn)
+	if err != nil {
+		return err
+	}
+
+	if tx.guard != nil {
+		if err = tx.guard(route.AmountOut); err != nil {
+			return err
+		}
+	}
+
+	tx.route = route
+	tx.quoteTime = time.Now()
+	return nil
+}
+
+// 检查路由合约的授权额度, 不足时发送授权交易并等待打包
+func (tx *OnchainSwapTransaction) approve(ctx context.Context) error {
+	svcCtx := tx.service.svcCtx
+	router := tx.client.Router(tx.route)
+	allowance, err := evm.GetTokenAllowance(ctx, svcCtx.EthClient, tx.inputToken, tx.signer, router.Hex())
+	if err != nil {
+		return err
+	}
+	if allowance.Cmp(tx.route.AmountIn) >= 0 {
+		return nil
+	}
+
+	approveAmount := tx.route.AmountIn
+	if tx.infiniteApproval {
+		approveAmount = evm.MaxUint256
+	}
+	data, err := evm.EncodeERC20ApproveInput(router.Hex(), approveAmount)
+	if err != nil {
+		return err
+	}
+
+	token := common.HexToAddress(tx.inputToken)
+	req, err := tx.newTxRequest(ctx, token, data, defaultApproveGas)
+	if err != nil {
+		return err
+	}
+
+	userWallet, err := tx.service.getUserWallet(ctx)
+	if err != nil {
+		return err
+	}
+	hash, _, err := dexagg.SendTransaction(ctx, svcCtx, userWallet, req, dexagg.SendOptions{Simulate: true})
+	if err != nil {
+		return err
+	}
+
+	logger.Infof("[OnchainSwapTransaction] 发送授权交易, signer: %s, token: %s, spender: %s, amount: %s, hash: %s",
+		tx.signer, tx.inputToken, router, approveAmount, hash)
+	return dexagg.WaitMined(ctx, svcCtx, hash)
+}
+
+func (tx *OnchainSwapTransaction) newSwapRequest(ctx context.Context) (dexagg.TxRequest, error) {
+	amountOutMin := new(big.Int).Mul(tx.route.AmountOut, big.NewInt(int64(10000-tx.slippageBps)))
+	amountOutMin.Div(amountOutMin, big.NewInt(10000))
+	deadline := big.NewInt(time.Now().Add(onchainSwapDeadline).Unix())
+
+	recipient := common.HexToAddress(tx.signer)
+	data, err := tx.client.BuildSwapCalldata(tx.route, recipient, amountOutMin, deadline)
+	if err != nil {
+		return dexagg.TxRequest{}, err
+	}
+	return tx.newTxRequest(ctx, tx.client.Router(tx.route), data, defaultSwapGas)
+}
+
+func (tx *OnchainSwapTransaction) newTxRequest(ctx context.Context, to common.Address, data []byte, defaultGas uint64) (dexagg.TxRequest, error) {
+	ethClient := tx.service.svcCtx.EthClient
+	gas := defaultGas
+	estimated, err := ethClient.EstimateGas(ctx, ethereum.CallMsg{
+		From: common.HexToAddress(tx.signer),
+		To:   &to,
+		Data: data,
+	})
+	if err == nil {
+		gas = estimated * onchainGasLimitBps / 10000
+	}
+
+	gasTipCap, gasFeeCap, err := evm.SuggestGasFees(ctx, ethClient)
+	if err != nil {
+		return dexagg.TxRequest{}, err
+	}
+
+	return dexagg.TxRequest{
+		To:        to,
+		Data:      data,
+		Value:     big.NewInt(0),
+		Gas:       gas,
+		GasTipCap: gasTipCap,
+		GasFeeCap: gasFeeCap,
+	}, nil
+}
@@ -0,0 +1,68 @@
+package swap
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/fachebot/evm-grid-bot/internal/dexagg"
+
+	"github.com/google/uuid"
+)
+
+type PaperSwapTransaction struct {
+	route       string
+	signer      string
+	outAmount   *big.Int
+	slippageBps int
+	quoteTime   time.Time
+}
+
+func NewPaperSwapTransaction(route, signer string, outAmount *big.Int, slippageBps int) *PaperSwapTransaction {
+	return &PaperSwapTransaction{
+		route:       route,
+		signer:      signer,
+		outAmount:   outAmount,
+		slippageBps: slippageBps,
+		quoteTime:   time.Now(),
+	}
+}
+
+func (tx *PaperSwapTransaction) Route() RouteInfo {
+	return RouteInfo{Route: tx.route}
+}
+
+func (tx *PaperSwapTransaction) Signer() string {
+	return tx.signer
+}
+
+func (tx *PaperSwapTransaction) OutAmount() *big.Int {
+	return tx.outAmount
+}
+
+func (tx *PaperSwapTransaction) SlippageBps() int {
+	return tx.slippageBps
+}
+
+func (tx *PaperSwapTransaction) QuoteTime() time.Time {
+	return tx.quoteTime
+}
+
+func (tx *PaperSwapTransaction) QuoteTTL() time.Duration {
+	return defaultQuoteTTL
+}
+
+// 模拟交易按报价立即成交, 不会重新报价
+func (tx *PaperSwapTransaction) SetPriceGuard(guard PriceGuard) {
+}
+
+func (tx *PaperSwapTransaction) Swap(ctx context.Context, submit dexagg.SubmitFunc) (string, uint64, error) {
+	// 模拟交易, 不广播到链上
+	hash := "paper-" + uuid.NewString()
+	if submit != nil {
+		if err := submit(ctx, hash, 0, ""); err != nil {
+			return "", 0, err
+		}
+	}
+	return hash, 0, nil
+}
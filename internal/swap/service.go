@@ -4,9 +4,14 @@ import (
 	"context"
 	"crypto/ecdsa"
 	"errors"
+	"fmt"
 	"math/big"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/fachebot/evm-grid-bot/internal/dexagg/okxweb3"
+	"github.com/fachebot/evm-grid-bot/internal/dexagg/onchain"
 	"github.com/fachebot/evm-grid-bot/internal/dexagg/relaylink"
 	"github.com/fachebot/evm-grid-bot/internal/ent"
 	"github.com/fachebot/evm-grid-bot/internal/ent/settings"
@@ -15,6 +20,7 @@ import (
 	"github.com/fachebot/evm-grid-bot/internal/utils/evm"
 
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/shopspring/decimal"
 )
 
 type SwapService struct {
@@ -22,12 +28,33 @@ type SwapService struct {
 	userId   int64
 	prv      *ecdsa.PrivateKey
 	settings *ent.Settings
+	paper    bool
 }
 
 func NewSwapService(svcCtx *svc.ServiceContext, userId int64) *SwapService {
 	return &SwapService{svcCtx: svcCtx, userId: userId}
 }
 
+func NewPaperSwapService(svcCtx *svc.ServiceContext, userId int64) *SwapService {
+	return &SwapService{svcCtx: svcCtx, userId: userId, paper: true}
+}
+
+// 报价参数
+type quoteRequest struct {
+	user             string
+	inputToken       string
+	outputToken      string
+	amount           *big.Int
+	slippageBps      int
+	infiniteApproval bool
+}
+
+// 单个聚合器的报价结果, feeUsd 为报价输出之外需要额外支付的费用
+type quoteResult struct {
+	tx     SwapTransaction
+	feeUsd decimal.Decimal
+}
+
 func (s *SwapService) Quote(ctx context.Context, inputToken, outputToken string, amount *big.Int, exit ...bool) (SwapTransaction, error) {
 	userWallet, err := s.getUserWallet(ctx)
 	if err != nil {
@@ -48,28 +75,116 @@ func (s *SwapService) Quote(ctx context.Context, inputToken, outputToken string,
 		}
 	}
 
-	switch userSettings.DexAggregator {
+	user, err := evm.GetAddress(userWallet)
+	if err != nil {
+		return nil, err
+	}
+
+	req := quoteRequest{
+		user:             user.Hex(),
+		inputToken:       inputToken,
+		outputToken:      outputToken,
+		amount:           amount,
+		slippageBps:      slippageBps,
+		infiniteApproval: userSettings.EnableInfiniteApproval != nil && *userSettings.EnableInfiniteApproval,
+	}
+
+	if userSettings.DexAggregator == settings.DexAggregatorBest {
+		return s.quoteBest(ctx, req)
+	}
+
+	result, err := s.quoteAggregator(ctx, userSettings.DexAggregator, req, false)
+	if err != nil {
+		return nil, err
+	}
+	return result.tx, nil
+}
+
+func (s *SwapService) quoteAggregator(ctx context.Context, aggregator settings.DexAggregator, req quoteRequest, estimateFee bool) (quoteResult, error) {
+	switch aggregator {
 	case settings.DexAggregatorRelay:
-		user, err := evm.GetAddress(userWallet)
-		if err != nil {
-			return nil, err
-		}
+		return s.quoteRelay(ctx, req)
+	case settings.DexAggregatorOkx:
+		return s.quoteOkx(ctx, req)
+	case settings.DexAggregatorOnchain:
+		return s.quoteOnchain(ctx, req, estimateFee)
+	default:
+		return quoteResult{}, errors.New("unsupported aggregator")
+	}
+}
 
-		var enableInfiniteApproval bool
-		if userSettings.EnableInfiniteApproval != nil && *userSettings.EnableInfiniteApproval {
-			enableInfiniteApproval = true
-		}
+func (s *SwapService) quoteRelay(ctx context.Context, req quoteRequest) (quoteResult, error) {
+	relaylinkClient := relaylink.NewRelaylinkClient(s.svcCtx.TransportProxy)
+	quote := func(ctx context.Context) (*relaylink.QuoteResponse, error) {
+		return relaylinkClient.Quote(ctx, s.svcCtx.Config.Chain.Id, req.user, req.inputToken, req.outputToken, req.amount, req.slippageBps, req.infiniteApproval)
+	}
+	quoteResponse, err := quote(ctx)
+	if err != nil {
+		return quoteResult{}, err
+	}
+
+	// 报价输出已扣除中继和应用费用, 只需额外扣除 gas 费用
+	result := quoteResult{feeUsd: quoteResponse.Fees.Gas.AmountUsd}
+
+	// 模拟盘按实时报价成交
+	if s.paper {
+		result.tx = NewPaperSwapTransaction("relay", req.user, quoteResponse.Details.CurrencyOut.Amount.BigInt(), req.slippageBps)
+	} else {
+		result.tx = NewRelaySwapTransaction(s, quoteResponse, req.user, quote)
+	}
+	return result, nil
+}
+
+func (s *SwapService) quoteOkx(ctx context.Context, req quoteRequest) (quoteResult, error) {
+	c := s.svcCtx.Config.OkxWeb3
+	okxClient := okxweb3.NewClient(c.Apikey, c.Secretkey, c.Passphrase, s.svcCtx.TransportProxy)
+	chainIndex := strconv.FormatInt(s.svcCtx.Config.Chain.Id, 10)
+	quote, err := okxClient.Quote(ctx, chainIndex, req.inputToken, req.outputToken, req.amount)
+	if err != nil {
+		return quoteResult{}, err
+	}
+
+	// tradeFee 为预估网络费用(USD)
+	result := quoteResult{feeUsd: quote.TradeFee}
 
-		relaylinkClient := relaylink.NewRelaylinkClient(s.svcCtx.TransportProxy)
-		quoteResponse, err := relaylinkClient.Quote(ctx, s.svcCtx.Config.Chain.Id, user.Hex(), inputToken, outputToken, amount, slippageBps, enableInfiniteApproval)
+	// 模拟盘按实时报价成交
+	if s.paper {
+		result.tx = NewPaperSwapTransaction("okx", req.user, quote.ToTokenAmount.BigInt(), req.slippageBps)
+	} else {
+		result.tx = NewOkxSwapTransaction(s, okxClient, req.user, req.inputToken, req.outputToken, req.amount, req.slippageBps, req.infiniteApproval, quote)
+	}
+	return result, nil
+}
+
+func (s *SwapService) quoteOnchain(ctx context.Context, req quoteRequest, estimateFee bool) (quoteResult, error) {
+	onchainClient := onchain.NewClient(s.svcCtx.EthClient, s.svcCtx.Config.Chain.OnchainDex)
+	route, err := onchainClient.Quote(ctx, req.inputToken, req.outputToken, req.amount)
+	if err != nil {
+		return quoteResult{}, err
+	}
+
+	var result quoteResult
+	if estimateFee {
+		result.feeUsd, err = s.estimateOnchainFeeUsd(ctx, onchainClient, route)
 		if err != nil {
-			return nil, err
+			return quoteResult{}, err
 		}
-		return NewRelaySwapTransaction(s, quoteResponse, user.Hex()), nil
-	default:
-		return nil, errors.New("unsupported aggregator")
 	}
 
+	// 模拟盘按实时报价成交
+	if s.paper {
+		result.tx = NewPaperSwapTransaction(fmt.Sprintf("onchain-v%d", route.Version), req.user, route.AmountOut, req.slippageBps)
+	} else {
+		result.tx = NewOnchainSwapTransaction(s, onchainClient, req.user, req.inputToken, req.outputToken, route, req.slippageBps, req.infiniteApproval)
+	}
+	return result, nil
+}
+
+func (s *SwapService) quoteTTL() time.Duration {
+	if s.svcCtx.Config.Chain.QuoteTTLSeconds > 0 {
+		return time.Duration(s.svcCtx.Config.Chain.QuoteTTLSeconds) * time.Second
+	}
+	return defaultQuoteTTL
 }
 
 func (s *SwapService) getUserWallet(ctx context.Context) (*ecdsa.PrivateKey, error) {
@@ -2,32 +2,70 @@ package swap
 
 import (
 	"context"
+	"errors"
 	"math/big"
+	"time"
 
+	"github.com/fachebot/evm-grid-bot/internal/dexagg"
 	"github.com/fachebot/evm-grid-bot/internal/dexagg/relaylink"
+	"github.com/fachebot/evm-grid-bot/internal/logger"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	defaultQuoteTTL = 15 * time.Second
+	maxRequotes     = 3
 )
 
+// 价格保护, 重新报价后检查新的输出数量, 返回错误时取消交易
+type PriceGuard func(outAmount *big.Int) error
+
+// 成交路由, 最优路由模式下记录次优路由及其净输出差值
+type RouteInfo struct {
+	Route     string
+	RunnerUp  string
+	SpreadUsd *decimal.Decimal
+}
+
 type SwapTransaction interface {
+	Route() RouteInfo
 	Signer() string
 	OutAmount() *big.Int
 	SlippageBps() int
-	Swap(ctx context.Context) (string, uint64, error)
+	QuoteTime() time.Time
+	QuoteTTL() time.Duration
+	SetPriceGuard(guard PriceGuard)
+	Swap(ctx context.Context, submit dexagg.SubmitFunc) (string, uint64, error)
 }
 
 type RelaySwapTransaction struct {
-	quote   *relaylink.QuoteResponse
-	service *SwapService
-	signer  string
+	quote     *relaylink.QuoteResponse
+	quoteTime time.Time
+	quoteTTL  time.Duration
+	requote   func(ctx context.Context) (*relaylink.QuoteResponse, error)
+	guard     PriceGuard
+	service   *SwapService
+	signer    string
+	sentSteps map[string]string // 已广播的步骤及交易哈希
 }
 
-func NewRelaySwapTransaction(service *SwapService, quoteResponse *relaylink.QuoteResponse, signer string) *RelaySwapTransaction {
+func NewRelaySwapTransaction(service *SwapService, quoteResponse *relaylink.QuoteResponse, signer string, requote func(ctx context.Context) (*relaylink.QuoteResponse, error)) *RelaySwapTransaction {
 	return &RelaySwapTransaction{
-		quote:   quoteResponse,
-		service: service,
-		signer:  signer,
+		quote:     quoteResponse,
+		quoteTime: time.Now(),
+		quoteTTL:  service.quoteTTL(),
+		requote:   requote,
+		service:   service,
+		signer:    signer,
+		sentSteps: make(map[string]string),
 	}
 }
 
+func (tx *RelaySwapTransaction) Route() RouteInfo {
+	return RouteInfo{Route: "relay"}
+}
+
 func (tx *RelaySwapTransaction) Signer() string {
 	return tx.signer
 }
@@ -40,13 +78,58 @@ func (tx *RelaySwapTransaction) SlippageBps() int {
 	return int(tx.quote.Details.SlippageTolerance.Origin.Percent.RoundUp(0).IntPart())
 }
 
-func (tx *RelaySwapTransaction) Swap(ctx context.Context) (string, uint64, error) {
+func (tx *RelaySwapTransaction) QuoteTime() time.Time {
+	return tx.quoteTime
+}
+
+func (tx *RelaySwapTransaction) QuoteTTL() time.Duration {
+	return tx.quoteTTL
+}
+
+func (tx *RelaySwapTransaction) SetPriceGuard(guard PriceGuard) {
+	tx.guard = guard
+}
+
+func (tx *RelaySwapTransaction) Swap(ctx context.Context, submit dexagg.SubmitFunc) (string, uint64, error) {
 	userWallet, err := tx.service.getUserWallet(ctx)
 	if err != nil {
 		return "", 0, err
 	}
 
 	relayClient := relaylink.NewRelaylinkClient(tx.service.svcCtx.TransportProxy)
-	hash, nonce, err := relayClient.SendSwapTransaction(ctx, tx.service.svcCtx, userWallet, tx.quote)
-	return hash, nonce, err
+	for attempt := 0; ; attempt++ {
+		// 报价过期前完成签名, 否则重新报价
+		if time.Since(tx.quoteTime) > tx.quoteTTL {
+			if attempt >= maxRequotes {
+				return "", 0, dexagg.ErrQuoteExpired
+			}
+			if err = tx.refreshQuote(ctx); err != nil {
+				return "", 0, err
+			}
+		}
+
+		hash, nonce, err := relayClient.SendSwapTransaction(ctx, tx.service.svcCtx, userWallet, tx.quote, tx.sentSteps, tx.quoteTime.Add(tx.quoteTTL), submit)
+		if !errors.Is(err, dexagg.ErrQuoteExpired) {
+			return hash, nonce, err
+		}
+		logger.Debugf("[RelaySwapTransaction] 报价已过期, 重新报价, signer: %s, quoteTime: %v, attempt: %d", tx.signer, tx.quoteTime, attempt+1)
+	}
+}
+
+// 重新报价, 并重新检查价格保护
+func (tx *RelaySwapTransaction) refreshQuote(ctx context.Context) error {
+	quote, err := tx.requote(ctx)
+	if err != nil {
+		return err
+	}
+
+	if tx.guard != nil {
+		if err = tx.guard(quote.Details.CurrencyOut.Amount.BigInt()); err != nil {
+			return err
+		}
+	}
+
+	tx.quote = quote
+	tx.quoteTime = time.Now()
+	return nil
 }
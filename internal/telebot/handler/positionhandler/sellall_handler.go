@@ -11,6 +11,7 @@ import (
 	"github.com/fachebot/evm-grid-bot/internal/ent/order"
 	"github.com/fachebot/evm-grid-bot/internal/ent/strategy"
 	"github.com/fachebot/evm-grid-bot/internal/logger"
+	gridstrategy "github.com/fachebot/evm-grid-bot/internal/strategy"
 	"github.com/fachebot/evm-grid-bot/internal/svc"
 	"github.com/fachebot/evm-grid-bot/internal/swap"
 	"github.com/fachebot/evm-grid-bot/internal/telebot/handler/wallethandler"
@@ -135,18 +136,6 @@ func (h *SellAllHandler) handleSellAll(ctx context.Context, userId int64, chatId
 	// 发送交易
 	uiOutAmount := evm.ParseUnits(tx.OutAmount(), h.svcCtx.Config.Chain.StablecoinDecimals)
 	quotePrice := uiOutAmount.Div(uiAmount)
-	hash, nonce, err := tx.Swap(ctx)
-	if err != nil {
-		logger.Errorf("[SellAllHandler] 清仓代币 - 发送交易失败, user: %d, inToken: %s, inputAmount: %s, outAmount: %s, hash: %s, %v",
-			userId, token, uiAmount, uiOutAmount, hash, err)
-		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 清仓失败, 请手动清仓", 1)
-		return
-	}
-
-	logger.Infof("[SellAllHandler] 清仓代币 - 提交交易成功, user: %d, token: %s, totalAmount: %s, hash: %s",
-		userId, uiAmount, uiOutAmount, hash)
-
-	// 保存订单记录
 	orderArgs := ent.Order{
 		Account:    tx.Signer(),
 		Token:      token,
@@ -157,12 +146,29 @@ func (h *SellAllHandler) handleSellAll(ctx context.Context, userId int64, chatId
 		FinalPrice: quotePrice,
 		InAmount:   uiAmount,
 		OutAmount:  uiOutAmount,
-		Status:     order.StatusPending,
-		Nonce:      nonce,
-		TxHash:     hash,
 	}
 
-	_, err = h.svcCtx.OrderModel.Save(ctx, orderArgs)
+	// 清仓不设底价, 重新报价后只更新订单
+	tx.SetPriceGuard(func(outAmount *big.Int) error {
+		uiOutAmount := evm.ParseUnits(outAmount, h.svcCtx.Config.Chain.StablecoinDecimals)
+		orderArgs.OutAmount = uiOutAmount
+		orderArgs.Price = uiOutAmount.Div(uiAmount)
+		orderArgs.FinalPrice = orderArgs.Price
+		return nil
+	})
+	err = gridstrategy.SubmitSwap(ctx, h.svcCtx, tx, &orderArgs)
+	if err != nil {
+		logger.Errorf("[SellAllHandler] 清仓代币 - 发送交易失败, user: %d, inToken: %s, inputAmount: %s, outAmount: %s, hash: %s, %v",
+			userId, token, uiAmount, uiOutAmount, orderArgs.TxHash, err)
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 清仓失败, 请手动清仓", 1)
+		return
+	}
+
+	logger.Infof("[SellAllHandler] 清仓代币 - 提交交易成功, user: %d, token: %s, totalAmount: %s, hash: %s",
+		userId, uiAmount, uiOutAmount, orderArgs.TxHash)
+
+	// 更新订单状态
+	err = h.svcCtx.OrderModel.SetOrderPendingStatus(ctx, orderArgs.ID)
 	if err != nil {
 		logger.Errorf("[SellAllHandler] 清仓网格 - 保存订单失败, order: %+v, %v", orderArgs, err)
 	}
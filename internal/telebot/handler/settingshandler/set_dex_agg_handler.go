@@ -42,6 +42,11 @@ func (h *SetDexAggHandler) handle(ctx context.Context, vars map[string]string, u
 		markup := tgbotapi.NewInlineKeyboardMarkup(
 			tgbotapi.NewInlineKeyboardRow(
 				tgbotapi.NewInlineKeyboardButtonData("relay", h.FormatPath(settings.DexAggregatorRelay)),
+				tgbotapi.NewInlineKeyboardButtonData("okx", h.FormatPath(settings.DexAggregatorOkx)),
+				tgbotapi.NewInlineKeyboardButtonData("onchain", h.FormatPath(settings.DexAggregatorOnchain)),
+			),
+			tgbotapi.NewInlineKeyboardRow(
+				tgbotapi.NewInlineKeyboardButtonData("best(最优路由)", h.FormatPath(settings.DexAggregatorBest)),
 			),
 		)
 		_, err := utils.ReplyMessage(h.botApi, update, text, markup)
@@ -72,6 +72,11 @@ func (h *ClosePositionyHandler) handle(ctx context.Context, vars map[string]stri
 		return err
 	} else {
 		chatId, _ := utils.GetChatId(&update)
+		if !TryLockStrategy(h.svcCtx, h.botApi, chatId, record.GUID) {
+			return nil
+		}
+		defer h.svcCtx.StrategyLocker.Unlock(record.GUID)
+
 		data, err := h.svcCtx.GridModel.FindByStrategyId(ctx, record.GUID)
 		if err != nil {
 			logger.Errorf("[ClosePositionyHandler] 获取网格列表失败, strategy: %s, %v", record.GUID, err)
@@ -85,6 +85,7 @@ func (h *DeleteStrategyHandler) handle(ctx context.Context, vars map[string]stri
 			text = fmt.Sprintf("❌ *%s* 策略删除失败, 请稍后再试", strings.TrimRight(record.Symbol, "\u0000"))
 			logger.Errorf("[DeleteStrategyHandler] 删除策略失败, id: %d, token: %s, %v", record.ID, record.Token, err)
 		} else {
+			h.svcCtx.StrategyCache.Remove(record.GUID)
 			err = DisplayStrategyHomeMenu(ctx, h.svcCtx, h.botApi, userId, update, 1)
 			if err != nil {
 				logger.Warnf("[DeleteStrategyHandler] 处理主页失败, %v", err)
@@ -16,7 +16,6 @@ import (
 
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
 	"github.com/google/uuid"
-	"github.com/shopspring/decimal"
 )
 
 type NewStrategyHandler struct {
@@ -137,6 +136,13 @@ func (h *NewStrategyHandler) handle(ctx context.Context, vars map[string]string,
 		// 	return nil
 		// }
 
+		// 计算建议价格区间
+		lowerPriceBound, upperPriceBound, err := SuggestPriceBounds(ctx, h.svcCtx, tokenAddress)
+		suggested := err == nil
+		if !suggested {
+			logger.Warnf("[NewStrategyHandler] 计算建议价格区间失败, token: %s, %v", tokenAddress, err)
+		}
+
 		c := h.svcCtx.Config.DefaultGridSettings
 		args := ent.Strategy{
 			GUID:                   guid.String(),
@@ -144,9 +150,12 @@ func (h *NewStrategyHandler) handle(ctx context.Context, vars map[string]string,
 			Token:                  tokenAddress,
 			Symbol:                 strings.TrimRight(tokenMeta.Symbol, "\u0000"),
 			MartinFactor:           1,
+			GridMode:               strategy.GridModeLong,
+			GridType:               strategy.GridTypeGeometric,
+			EmaFilter:              strategy.EmaFilterOff,
 			TakeProfitRatio:        c.TakeProfitRatio,
-			UpperPriceBound:        decimal.Zero,
-			LowerPriceBound:        decimal.Zero,
+			UpperPriceBound:        upperPriceBound,
+			LowerPriceBound:        lowerPriceBound,
 			InitialOrderSize:       c.OrderSize,
 			LastKlineVolume:        &c.LastKlineVolume,
 			FiveKlineVolume:        &c.FiveKlineVolume,
@@ -172,16 +181,16 @@ func (h *NewStrategyHandler) handle(ctx context.Context, vars map[string]string,
 		utils.SendMessageAndDelayDeletion(h.botApi, chatId, fmt.Sprintf("✅ %s 网格策略初始化完成", tokenAddress), 3)
 
 		// 更新用户界面
-		if update.Message.ReplyToMessage == nil {
-			return DisplayStrategyDetailsMenu(ctx, h.svcCtx, h.botApi, userId, update, record)
-		} else {
+		if update.Message.ReplyToMessage != nil {
 			route, ok := h.svcCtx.MessageCache.GetRoute(chatId, update.Message.ReplyToMessage.MessageID)
 			if ok && route.Context != nil {
 				update = tgbotapi.Update{Message: route.Context}
-				return DisplayStrategyDetailsMenu(ctx, h.svcCtx, h.botApi, userId, update, record)
 			}
-			return DisplayStrategyDetailsMenu(ctx, h.svcCtx, h.botApi, userId, update, record)
 		}
+		if suggested {
+			return DisplaySuggestedBoundsMenu(h.svcCtx, h.botApi, update, record)
+		}
+		return DisplayStrategyDetailsMenu(ctx, h.svcCtx, h.botApi, userId, update, record)
 	}
 
 	return nil
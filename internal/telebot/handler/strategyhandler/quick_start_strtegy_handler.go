@@ -66,17 +66,28 @@ func (h *QuickStartStrategyHandler) handle(ctx context.Context, vars map[string]
 		return nil
 	}
 
-	// 保存策略信息
+	// 计算建议价格区间
 	c := h.svcCtx.Config.QuickStartSettings
+	lowerPriceBound, upperPriceBound, err := SuggestPriceBounds(ctx, h.svcCtx, tokenAddress)
+	suggested := err == nil
+	if !suggested {
+		logger.Warnf("[QuickStartStrategyHandler] 计算建议价格区间失败, token: %s, %v", tokenAddress, err)
+		lowerPriceBound, upperPriceBound = c.LowerPriceBound, c.UpperPriceBound
+	}
+
+	// 保存策略信息
 	args := ent.Strategy{
 		GUID:                   guid.String(),
 		UserId:                 userId,
 		Token:                  tokenAddress,
 		Symbol:                 strings.TrimRight(tokenMeta.Symbol, "\u0000"),
 		MartinFactor:           1,
+		GridMode:               strategy.GridModeLong,
+		GridType:               strategy.GridTypeGeometric,
+		EmaFilter:              strategy.EmaFilterOff,
 		TakeProfitRatio:        c.TakeProfitRatio,
-		UpperPriceBound:        c.UpperPriceBound,
-		LowerPriceBound:        c.LowerPriceBound,
+		UpperPriceBound:        upperPriceBound,
+		LowerPriceBound:        lowerPriceBound,
 		InitialOrderSize:       c.OrderSize,
 		LastKlineVolume:        &c.LastKlineVolume,
 		FiveKlineVolume:        &c.FiveKlineVolume,
@@ -100,5 +111,8 @@ func (h *QuickStartStrategyHandler) handle(ctx context.Context, vars map[string]
 	utils.SendMessageAndDelayDeletion(h.botApi, chatId, fmt.Sprintf("✅ %s 网格策略初始化完成", tokenAddress), 3)
 
 	// 更新用户界面
+	if suggested {
+		return DisplaySuggestedBoundsMenu(h.svcCtx, h.botApi, update, record)
+	}
 	return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, update, record)
 }
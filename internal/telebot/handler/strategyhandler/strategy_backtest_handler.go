@@ -0,0 +1,140 @@
+package strategyhandler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fachebot/evm-grid-bot/internal/backtest"
+	"github.com/fachebot/evm-grid-bot/internal/ent"
+	"github.com/fachebot/evm-grid-bot/internal/logger"
+	gridstrategy "github.com/fachebot/evm-grid-bot/internal/strategy"
+	"github.com/fachebot/evm-grid-bot/internal/svc"
+	"github.com/fachebot/evm-grid-bot/internal/telebot/pathrouter"
+	"github.com/fachebot/evm-grid-bot/internal/utils"
+	"github.com/fachebot/evm-grid-bot/internal/utils/format"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+const (
+	backtestCandles = 1000
+	backtestFeeBps  = 25
+)
+
+var exitRuleNames = map[gridstrategy.ExitRule]string{
+	gridstrategy.ExitRuleWaterfallDrop:       "防瀑布机制",
+	gridstrategy.ExitRuleUpperBoundExit:      "突破退场目标价格",
+	gridstrategy.ExitRuleGlobalTakeProfit:    "触发全局止盈",
+	gridstrategy.ExitRuleTakeProfitAtTarget:  "达到盈利目标",
+	gridstrategy.ExitRuleStopLossAtThreshold: "亏损达到预设金额",
+	gridstrategy.ExitRulePriceRangeStopLoss:  "跌破清仓",
+}
+
+type StrategyBacktestHandler struct {
+	botApi *tgbotapi.BotAPI
+	svcCtx *svc.ServiceContext
+}
+
+func NewStrategyBacktestHandler(svcCtx *svc.ServiceContext, botApi *tgbotapi.BotAPI) *StrategyBacktestHandler {
+	return &StrategyBacktestHandler{botApi: botApi, svcCtx: svcCtx}
+}
+
+func (h StrategyBacktestHandler) FormatPath(guid string) string {
+	return fmt.Sprintf("/strategy/backtest/%s", guid)
+}
+
+func (h *StrategyBacktestHandler) AddRouter(router *pathrouter.Router) {
+	router.HandleFunc("/strategy/backtest/{uuid}", h.handle)
+}
+
+func (h *StrategyBacktestHandler) handle(ctx context.Context, vars map[string]string, userId int64, update tgbotapi.Update) error {
+	guid, ok := vars["uuid"]
+	if !ok {
+		return nil
+	}
+
+	record, err := h.svcCtx.StrategyModel.FindByUserIdGUID(ctx, userId, guid)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return DisplayStrategyHomeMenu(ctx, h.svcCtx, h.botApi, userId, update, 1)
+		}
+		logger.Errorf("[StrategyBacktestHandler] 查询策略失败, id: %s, %v", guid, err)
+		return nil
+	}
+
+	chatId, ok := utils.GetChatId(&update)
+	if !ok {
+		return nil
+	}
+
+	// 获取历史K线
+	ohlcs, err := FetchTokenCandles(ctx, h.svcCtx, record.Token, time.Now(), "1m", backtestCandles)
+	if err != nil || len(ohlcs) == 0 {
+		logger.Warnf("[StrategyBacktestHandler] 获取 ohlcs 数据失败, token: %s, %v", record.Token, err)
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 获取K线数据失败, 请稍后重试", 1)
+		return nil
+	}
+
+	tokenMeta, err := h.svcCtx.TokenMetaCache.GetTokenMeta(ctx, record.Token)
+	if err != nil {
+		logger.Errorf("[StrategyBacktestHandler] 获取Token元信息失败, token: %s, %v", record.Token, err)
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 查询元数据失败, 请稍后重试", 1)
+		return nil
+	}
+
+	slippageBps := h.svcCtx.Config.Chain.SlippageBps
+	userSettings, err := h.svcCtx.SettingsModel.FindByUserId(ctx, userId)
+	if err == nil {
+		slippageBps = userSettings.SlippageBps
+	}
+
+	// 运行策略回测
+	opts := backtest.Options{
+		SlippageBps:   slippageBps,
+		FeeBps:        backtestFeeBps,
+		TokenDecimals: tokenMeta.Decimals,
+	}
+	report, err := backtest.Run(ctx, *record, ohlcs, opts)
+	if err != nil {
+		logger.Errorf("[StrategyBacktestHandler] 策略回测失败, strategy: %s, %v", record.GUID, err)
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 策略回测失败, 请稍后重试", 1)
+		return nil
+	}
+
+	exitRule := "-"
+	if report.ExitRule != nil {
+		exitRule = fmt.Sprintf("%s (%s)", exitRuleNames[*report.ExitRule], utils.FormaTime(*report.ExitTime))
+	}
+
+	text := fmt.Sprintf("%s 网格机器人 | 策略回测\n\n", utils.GetNetworkName(h.svcCtx.Config.Chain.Id))
+	text += fmt.Sprintf("📊 代币: *%s*\n", record.Symbol)
+	text += fmt.Sprintf("🕒 区间: %s ~ %s (%d根1mK线)\n", utils.FormaTime(ohlcs[0].Time), utils.FormaTime(ohlcs[len(ohlcs)-1].Time), len(ohlcs))
+	text += fmt.Sprintf("⚙️ 滑点: %.2f%%, 手续费: %.2f%%\n\n", float64(opts.SlippageBps)/100, float64(opts.FeeBps)/100)
+	text += fmt.Sprintf("💰 已实现盈亏: %sU\n", report.RealizedProfit.Truncate(4))
+	text += fmt.Sprintf("💰 未实现盈亏: %sU\n", report.UnrealizedProfit.Truncate(4))
+	text += fmt.Sprintf("💰 累计手续费: %sU\n", report.TotalFees.Truncate(4))
+	text += fmt.Sprintf("📉 最大回撤: %sU\n", report.MaxDrawdown.Truncate(4))
+	text += fmt.Sprintf("💵 最大资金占用: %sU\n\n", report.MaxCapitalUsed.Truncate(2))
+	text += fmt.Sprintf("🟢 买入次数: %d\n", report.Buys)
+	text += fmt.Sprintf("🔴 卖出次数: %d\n", report.Sells)
+	text += fmt.Sprintf("🔁 网格套利: %d\n", report.RoundTrips)
+	text += fmt.Sprintf("📦 剩余持仓: %s, 最新价格: %s\n", report.Holding.Truncate(4), format.Price(report.LastPrice, 5))
+	text += fmt.Sprintf("🚪 退出规则: %s\n\n", exitRule)
+	text += "⚠️ 回测结果仅供参考，按K线收盘价模拟成交"
+
+	markup := tgbotapi.NewInlineKeyboardMarkup(
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData("🔄 重新回测", h.FormatPath(record.GUID)),
+		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData("◀️ 返回上级", StrategyDetailsHandler{}.FormatPath(record.GUID)),
+			tgbotapi.NewInlineKeyboardButtonData("⏪ 返回主页", "/home"),
+		),
+	)
+	_, err = utils.ReplyMessage(h.botApi, update, text, markup)
+	if err != nil {
+		logger.Debugf("[StrategyBacktestHandler] 处理策略回测失败, %v", err)
+	}
+	return nil
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/fachebot/evm-grid-bot/internal/ent"
 	"github.com/fachebot/evm-grid-bot/internal/ent/strategy"
 	"github.com/fachebot/evm-grid-bot/internal/logger"
+	"github.com/fachebot/evm-grid-bot/internal/model"
+	gridstrategy "github.com/fachebot/evm-grid-bot/internal/strategy"
 	"github.com/fachebot/evm-grid-bot/internal/svc"
 	"github.com/fachebot/evm-grid-bot/internal/telebot/pathrouter"
 	"github.com/fachebot/evm-grid-bot/internal/utils"
@@ -39,6 +41,26 @@ var (
 	SettingsOptionDropThreshold          SettingsOption = 17
 	SettingsOptionStopLossExit           SettingsOption = 18
 	SettingsOptionGlobalTakeProfitRatio  SettingsOption = 19
+	SettingsOptionPaperTrading           SettingsOption = 20
+	SettingsOptionMartinFactor           SettingsOption = 21
+	SettingsOptionGridType               SettingsOption = 22
+	SettingsOptionGridCount              SettingsOption = 23
+	SettingsOptionPriceStep              SettingsOption = 24
+	SettingsOptionGridLadder             SettingsOption = 25
+	SettingsOptionTrailingUp             SettingsOption = 26
+	SettingsOptionTrailingDown           SettingsOption = 27
+	SettingsOptionTrailingDwellMinutes   SettingsOption = 28
+	SettingsOptionGridMode               SettingsOption = 29
+	SettingsOptionTrailingTakeProfit     SettingsOption = 30
+	SettingsOptionTakeProfitPortion      SettingsOption = 31
+	SettingsOptionRsiThreshold           SettingsOption = 32
+	SettingsOptionEmaFilter              SettingsOption = 33
+	SettingsOptionEmaPeriod              SettingsOption = 34
+	SettingsOptionMacdFilter             SettingsOption = 35
+	SettingsOptionAdaptiveSpacing        SettingsOption = 36
+	SettingsOptionAdaptiveMinRatio       SettingsOption = 37
+	SettingsOptionAdaptiveMaxRatio       SettingsOption = 38
+	SettingsOptionSuggestBounds          SettingsOption = 39
 )
 
 type StrategySettingsHandler struct {
@@ -130,6 +152,46 @@ func (h *StrategySettingsHandler) handle(ctx context.Context, vars map[string]st
 		return h.handleStopLossExit(ctx, update, record)
 	case SettingsOptionGlobalTakeProfitRatio:
 		return h.handleGlobalTakeProfitRatio(ctx, update, record)
+	case SettingsOptionPaperTrading:
+		return h.handlePaperTrading(ctx, update, record)
+	case SettingsOptionMartinFactor:
+		return h.handleMartinFactor(ctx, update, record)
+	case SettingsOptionGridType:
+		return h.handleGridType(ctx, update, record)
+	case SettingsOptionGridCount:
+		return h.handleGridCount(ctx, update, record)
+	case SettingsOptionPriceStep:
+		return h.handlePriceStep(ctx, update, record)
+	case SettingsOptionGridLadder:
+		return h.handleGridLadder(ctx, update, record)
+	case SettingsOptionTrailingUp:
+		return h.handleEnableTrailingUp(ctx, update, record)
+	case SettingsOptionTrailingDown:
+		return h.handleEnableTrailingDown(ctx, update, record)
+	case SettingsOptionTrailingDwellMinutes:
+		return h.handleTrailingDwellMinutes(ctx, update, record)
+	case SettingsOptionGridMode:
+		return h.handleGridMode(ctx, update, record)
+	case SettingsOptionTrailingTakeProfit:
+		return h.handleTrailingTakeProfit(ctx, update, record)
+	case SettingsOptionTakeProfitPortion:
+		return h.handleTakeProfitPortion(ctx, update, record)
+	case SettingsOptionRsiThreshold:
+		return h.handleRsiThreshold(ctx, update, record)
+	case SettingsOptionEmaFilter:
+		return h.handleEmaFilter(ctx, update, record)
+	case SettingsOptionEmaPeriod:
+		return h.handleEmaPeriod(ctx, update, record)
+	case SettingsOptionMacdFilter:
+		return h.handleEnableMacdFilter(ctx, update, record)
+	case SettingsOptionAdaptiveSpacing:
+		return h.handleEnableAdaptiveSpacing(ctx, update, record)
+	case SettingsOptionAdaptiveMinRatio:
+		return h.handleAdaptiveMinRatio(ctx, update, record)
+	case SettingsOptionAdaptiveMaxRatio:
+		return h.handleAdaptiveMaxRatio(ctx, update, record)
+	case SettingsOptionSuggestBounds:
+		return h.handleSuggestBounds(ctx, update, record)
 	}
 
 	return nil
@@ -235,6 +297,87 @@ func (h *StrategySettingsHandler) handleEnableDynamicStopLoss(ctx context.Contex
 	return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, update, record)
 }
 
+func (h *StrategySettingsHandler) handlePaperTrading(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	if update.CallbackQuery == nil {
+		return nil
+	}
+
+	chatId := update.CallbackQuery.Message.Chat.ID
+	if record.Status == strategy.StatusActive {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 策略开启后, 不允许切换模拟盘", 1)
+		return nil
+	}
+
+	// 网格和订单按原模式记账, 切换后无法正确结算
+	gridRecords, err := h.svcCtx.GridModel.FindByStrategyId(ctx, record.GUID)
+	if err != nil {
+		logger.Errorf("[StrategySettingsHandler] 获取网格列表失败, strategy: %s, %v", record.GUID, err)
+		return err
+	}
+	hasActiveOrders, err := h.svcCtx.OrderModel.HasActiveOrdersByStrategyId(ctx, record.GUID)
+	if err != nil {
+		logger.Errorf("[StrategySettingsHandler] 查询未完成订单失败, strategy: %s, %v", record.GUID, err)
+		return err
+	}
+	if len(gridRecords) > 0 || hasActiveOrders {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 策略存在网格或未完成订单, 不允许切换模拟盘", 1)
+		return nil
+	}
+
+	text := "✅ 配置修改成功"
+	err = h.svcCtx.StrategyModel.UpdatePaperTrading(ctx, record.ID, !record.PaperTrading)
+	if err == nil {
+		record.PaperTrading = !record.PaperTrading
+	} else {
+		text = "❌ 配置修改失败, 请稍后重试"
+		logger.Errorf("[StrategySettingsHandler] 更新配置[PaperTrading]失败, %v", err)
+	}
+
+	utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+
+	return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, update, record)
+}
+
+func (h *StrategySettingsHandler) handleEnableTrailingUp(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	if update.CallbackQuery == nil {
+		return nil
+	}
+
+	text := "✅ 配置修改成功"
+	err := h.svcCtx.StrategyModel.UpdateTrailingUp(ctx, record.ID, !record.TrailingUp)
+	if err == nil {
+		record.TrailingUp = !record.TrailingUp
+	} else {
+		text = "❌ 配置修改失败, 请稍后重试"
+		logger.Errorf("[StrategySettingsHandler] 更新配置[TrailingUp]失败, %v", err)
+	}
+
+	chatId := update.CallbackQuery.Message.Chat.ID
+	utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+
+	return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, update, record)
+}
+
+func (h *StrategySettingsHandler) handleEnableTrailingDown(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	if update.CallbackQuery == nil {
+		return nil
+	}
+
+	text := "✅ 配置修改成功"
+	err := h.svcCtx.StrategyModel.UpdateTrailingDown(ctx, record.ID, !record.TrailingDown)
+	if err == nil {
+		record.TrailingDown = !record.TrailingDown
+	} else {
+		text = "❌ 配置修改失败, 请稍后重试"
+		logger.Errorf("[StrategySettingsHandler] 更新配置[TrailingDown]失败, %v", err)
+	}
+
+	chatId := update.CallbackQuery.Message.Chat.ID
+	utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+
+	return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, update, record)
+}
+
 func (h *StrategySettingsHandler) handleOrderSize(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
 	// 步骤1
 	if update.CallbackQuery != nil {
@@ -445,7 +588,7 @@ func (h *StrategySettingsHandler) handleTakeProfitRatio(ctx context.Context, upd
 	return nil
 }
 
-func (h *StrategySettingsHandler) handleUpperPriceBound(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+func (h *StrategySettingsHandler) handleMartinFactor(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
 	chatId, _ := utils.GetChatId(&update)
 	if record.Status == strategy.StatusActive {
 		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 策略开启后, 只允许修改单笔投入金额", 1)
@@ -455,8 +598,7 @@ func (h *StrategySettingsHandler) handleUpperPriceBound(ctx context.Context, upd
 	// 步骤1
 	if update.CallbackQuery != nil {
 		chatId := update.CallbackQuery.Message.Chat.ID
-		text := fmt.Sprintf("🌳 填写网格最高价格（单位: %s）\n\n💵 例: 100 → 代表100 %s",
-			h.svcCtx.Config.Chain.StablecoinSymbol, h.svcCtx.Config.Chain.StablecoinSymbol)
+		text := "🌳 填写马丁倍投系数\n\n💵 例如: 1.5｜代表每向下一格, 买入金额变为上一格的 1.5 倍, 1 代表不加倍"
 		c := tgbotapi.NewMessage(chatId, text)
 		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
 
@@ -466,7 +608,7 @@ func (h *StrategySettingsHandler) handleUpperPriceBound(ctx context.Context, upd
 			return err
 		}
 
-		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionUpperPriceBound), Context: update.CallbackQuery.Message}
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionMartinFactor), Context: update.CallbackQuery.Message}
 		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
 
 		return nil
@@ -481,28 +623,26 @@ func (h *StrategySettingsHandler) handleUpperPriceBound(ctx context.Context, upd
 		}
 		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
 
-		// 检查输入金额
-		d, err := decimal.NewFromString(update.Message.Text)
-		if err != nil || d.LessThanOrEqual(decimal.Zero) {
-			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "⚠️ 请输入有效金额", 1)
-			return nil
-		} else if d.LessThanOrEqual(record.LowerPriceBound) {
-			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "⚠️ 网格最高价格必须大于最低价格", 1)
+		// 检查输入系数
+		d, err := strconv.ParseFloat(update.Message.Text, 64)
+		if err != nil || d < 1 {
+			text := "⚠️ 请输入有效马丁系数, 不能小于 1"
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
 			return nil
 		}
 
-		if d.Equal(record.UpperPriceBound) {
+		if d == record.MartinFactor {
 			return nil
 		}
 
 		// 发送成功提示
 		text := "✅ 配置修改成功"
-		err = h.svcCtx.StrategyModel.UpdateUpperPriceBound(ctx, record.ID, d)
+		err = h.svcCtx.StrategyModel.UpdateMartinFactor(ctx, record.ID, d)
 		if err == nil {
-			record.UpperPriceBound = d
+			record.MartinFactor = d
 		} else {
 			text = "❌ 配置修改失败, 请稍后重试"
-			logger.Errorf("[StrategySettingsHandler] 更新配置[UpperPriceBound]失败, %v", err)
+			logger.Errorf("[StrategySettingsHandler] 更新配置[MartinFactor]失败, %v", err)
 		}
 		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
 
@@ -521,7 +661,73 @@ func (h *StrategySettingsHandler) handleUpperPriceBound(ctx context.Context, upd
 	return nil
 }
 
-func (h *StrategySettingsHandler) handleLowerPriceBound(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+func (h *StrategySettingsHandler) handleGridMode(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	if update.CallbackQuery == nil {
+		return nil
+	}
+
+	chatId := update.CallbackQuery.Message.Chat.ID
+	if record.Status == strategy.StatusActive {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 策略开启后, 只允许修改单笔投入金额", 1)
+		return nil
+	}
+
+	gridMode := strategy.GridModeNeutral
+	if record.GridMode == strategy.GridModeNeutral {
+		gridMode = strategy.GridModeLong
+	}
+
+	text := "✅ 配置修改成功"
+	err := h.svcCtx.StrategyModel.UpdateGridMode(ctx, record.ID, gridMode)
+	if err == nil {
+		record.GridMode = gridMode
+	} else {
+		text = "❌ 配置修改失败, 请稍后重试"
+		logger.Errorf("[StrategySettingsHandler] 更新配置[GridMode]失败, %v", err)
+	}
+
+	utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+
+	return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, update, record)
+}
+
+func (h *StrategySettingsHandler) handleGridType(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	if update.CallbackQuery == nil {
+		return nil
+	}
+
+	chatId := update.CallbackQuery.Message.Chat.ID
+	if record.Status == strategy.StatusActive {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 策略开启后, 只允许修改单笔投入金额", 1)
+		return nil
+	}
+
+	// 等比 -> 等差 -> 自定义阶梯
+	var gridType strategy.GridType
+	switch record.GridType {
+	case strategy.GridTypeGeometric:
+		gridType = strategy.GridTypeArithmetic
+	case strategy.GridTypeArithmetic:
+		gridType = strategy.GridTypeCustom
+	default:
+		gridType = strategy.GridTypeGeometric
+	}
+
+	text := "✅ 配置修改成功"
+	err := h.svcCtx.StrategyModel.UpdateGridType(ctx, record.ID, gridType)
+	if err == nil {
+		record.GridType = gridType
+	} else {
+		text = "❌ 配置修改失败, 请稍后重试"
+		logger.Errorf("[StrategySettingsHandler] 更新配置[GridType]失败, %v", err)
+	}
+
+	utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+
+	return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, update, record)
+}
+
+func (h *StrategySettingsHandler) handleGridCount(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
 	chatId, _ := utils.GetChatId(&update)
 	if record.Status == strategy.StatusActive {
 		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 策略开启后, 只允许修改单笔投入金额", 1)
@@ -530,8 +736,8 @@ func (h *StrategySettingsHandler) handleLowerPriceBound(ctx context.Context, upd
 
 	// 步骤1
 	if update.CallbackQuery != nil {
-		text := fmt.Sprintf("🌳 填写网格最低价格（单位: %s）\n\n💵 例: 100 → 代表100 %s",
-			h.svcCtx.Config.Chain.StablecoinSymbol, h.svcCtx.Config.Chain.StablecoinSymbol)
+		chatId := update.CallbackQuery.Message.Chat.ID
+		text := "🌳 填写等差网格数量\n\n💵 例如: 20｜代表在价格区间内等距划分 20 格, 设置后将清除价格步长"
 		c := tgbotapi.NewMessage(chatId, text)
 		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
 
@@ -541,7 +747,7 @@ func (h *StrategySettingsHandler) handleLowerPriceBound(ctx context.Context, upd
 			return err
 		}
 
-		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionLowerPriceBound), Context: update.CallbackQuery.Message}
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionGridCount), Context: update.CallbackQuery.Message}
 		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
 
 		return nil
@@ -549,34 +755,40 @@ func (h *StrategySettingsHandler) handleLowerPriceBound(ctx context.Context, upd
 
 	// 步骤2
 	if update.Message != nil {
+		chatId := update.Message.Chat.ID
 		deleteMessages := []int{update.Message.MessageID}
 		if update.Message.ReplyToMessage != nil {
 			deleteMessages = append(deleteMessages, update.Message.ReplyToMessage.MessageID)
 		}
 		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
 
-		// 检查输入金额
-		d, err := decimal.NewFromString(update.Message.Text)
-		if err != nil || d.LessThanOrEqual(decimal.Zero) {
-			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "⚠️ 请输入有效金额", 1)
-			return nil
-		} else if d.GreaterThanOrEqual(record.UpperPriceBound) {
-			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "⚠️ 网格最低价格必须小于最高价格", 1)
+		// 检查输入数量
+		d, err := strconv.Atoi(update.Message.Text)
+		if err != nil || d <= 0 {
+			text := "⚠️ 请输入有效的整数"
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
 			return nil
 		}
 
-		if d.Equal(record.LowerPriceBound) {
+		if record.GridCount != nil && d == *record.GridCount && record.PriceStep == nil {
 			return nil
 		}
 
 		// 发送成功提示
 		text := "✅ 配置修改成功"
-		err = h.svcCtx.StrategyModel.UpdateLowerPriceBound(ctx, record.ID, d)
+		err = utils.Tx(ctx, h.svcCtx.DbClient, func(tx *ent.Tx) error {
+			err := model.NewStrategyModel(tx.Strategy).UpdateGridCount(ctx, record.ID, d)
+			if err != nil {
+				return err
+			}
+			return model.NewStrategyModel(tx.Strategy).UpdatePriceStep(ctx, record.ID, decimal.Zero)
+		})
 		if err == nil {
-			record.LowerPriceBound = d
+			record.GridCount = &d
+			record.PriceStep = nil
 		} else {
 			text = "❌ 配置修改失败, 请稍后重试"
-			logger.Errorf("[StrategySettingsHandler] 更新配置[LowerPriceBound]失败, %v", err)
+			logger.Errorf("[StrategySettingsHandler] 更新配置[GridCount]失败, %v", err)
 		}
 		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
 
@@ -595,11 +807,17 @@ func (h *StrategySettingsHandler) handleLowerPriceBound(ctx context.Context, upd
 	return nil
 }
 
-func (h *StrategySettingsHandler) handleLastKlineVolume(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+func (h *StrategySettingsHandler) handlePriceStep(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	chatId, _ := utils.GetChatId(&update)
+	if record.Status == strategy.StatusActive {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 策略开启后, 只允许修改单笔投入金额", 1)
+		return nil
+	}
+
 	// 步骤1
 	if update.CallbackQuery != nil {
 		chatId := update.CallbackQuery.Message.Chat.ID
-		text := "🌳 填写近期的最小交易量, 如果交易量小于此值则不会买入, 0 表示不受限制"
+		text := "🌳 填写等差网格价格步长\n\n💵 例如: 0.001｜代表每格价格相差 0.001U, 填 0 则按网格数量划分"
 		c := tgbotapi.NewMessage(chatId, text)
 		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
 
@@ -609,7 +827,7 @@ func (h *StrategySettingsHandler) handleLastKlineVolume(ctx context.Context, upd
 			return err
 		}
 
-		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionLastKlineVolume), Context: update.CallbackQuery.Message}
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionPriceStep), Context: update.CallbackQuery.Message}
 		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
 
 		return nil
@@ -624,26 +842,29 @@ func (h *StrategySettingsHandler) handleLastKlineVolume(ctx context.Context, upd
 		}
 		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
 
-		// 检查输入金额
+		// 检查输入步长
 		d, err := decimal.NewFromString(update.Message.Text)
 		if err != nil || d.LessThan(decimal.Zero) {
-			text := "⚠️ 请输入有效交易量数值"
+			text := "⚠️ 请输入有效价格步长"
 			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
 			return nil
 		}
 
-		if (record.LastKlineVolume == nil && d.IsZero()) || (record.LastKlineVolume != nil && d.Equal(*record.LastKlineVolume)) {
+		if (record.PriceStep == nil && d.IsZero()) || (record.PriceStep != nil && d.Equal(*record.PriceStep)) {
 			return nil
 		}
 
 		// 发送成功提示
 		text := "✅ 配置修改成功"
-		err = h.svcCtx.StrategyModel.UpdateLastKlineVolume(ctx, record.ID, d)
+		err = h.svcCtx.StrategyModel.UpdatePriceStep(ctx, record.ID, d)
 		if err == nil {
-			record.LastKlineVolume = &d
+			record.PriceStep = &d
+			if d.IsZero() {
+				record.PriceStep = nil
+			}
 		} else {
 			text = "❌ 配置修改失败, 请稍后重试"
-			logger.Errorf("[StrategySettingsHandler] 更新配置[LastKlineVolume]失败, %v", err)
+			logger.Errorf("[StrategySettingsHandler] 更新配置[PriceStep]失败, %v", err)
 		}
 		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
 
@@ -662,11 +883,17 @@ func (h *StrategySettingsHandler) handleLastKlineVolume(ctx context.Context, upd
 	return nil
 }
 
-func (h *StrategySettingsHandler) handleFiveKlineVolume(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+func (h *StrategySettingsHandler) handleGridLadder(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	chatId, _ := utils.GetChatId(&update)
+	if record.Status == strategy.StatusActive {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 策略开启后, 只允许修改单笔投入金额", 1)
+		return nil
+	}
+
 	// 步骤1
 	if update.CallbackQuery != nil {
 		chatId := update.CallbackQuery.Message.Chat.ID
-		text := "🌳 填写近期5分钟的最小交易量, 如果交易量小于此值则不会买入, 0 表示不受限制"
+		text := "🌳 填写自定义价格阶梯, 每行一档, 价格从低到高\n\n格式: 价格 止盈% 单笔金额\n💵 例如:\n0.8 5 20\n0.9 4 15\n1.0 3 10\n\n⚠️ 止盈和金额可省略, 默认使用策略配置, 价格必须在价格区间内"
 		c := tgbotapi.NewMessage(chatId, text)
 		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
 
@@ -676,7 +903,7 @@ func (h *StrategySettingsHandler) handleFiveKlineVolume(ctx context.Context, upd
 			return err
 		}
 
-		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionFiveKlineVolume), Context: update.CallbackQuery.Message}
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionGridLadder), Context: update.CallbackQuery.Message}
 		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
 
 		return nil
@@ -691,26 +918,24 @@ func (h *StrategySettingsHandler) handleFiveKlineVolume(ctx context.Context, upd
 		}
 		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
 
-		// 检查输入金额
-		d, err := decimal.NewFromString(update.Message.Text)
-		if err != nil || d.LessThan(decimal.Zero) {
-			text := "⚠️ 请输入有效交易量数值"
-			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
-			return nil
-		}
-
-		if (record.FiveKlineVolume == nil && d.IsZero()) || (record.FiveKlineVolume != nil && d.Equal(*record.FiveKlineVolume)) {
+		// 检查输入阶梯
+		levels, err := gridstrategy.ParseGridLadder(update.Message.Text, record)
+		if err != nil {
+			logger.Debugf("[StrategySettingsHandler] 解析价格阶梯失败, text: %s, %v", update.Message.Text, err)
+			text := "⚠️ 请输入有效价格阶梯, 价格需为正数, 从低到高且在价格区间内"
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 3)
 			return nil
 		}
 
 		// 发送成功提示
 		text := "✅ 配置修改成功"
-		err = h.svcCtx.StrategyModel.UpdateFiveKlineVolume(ctx, record.ID, d)
+		gridLadder := gridstrategy.EncodeGridLadder(levels)
+		err = h.svcCtx.StrategyModel.UpdateGridLadder(ctx, record.ID, gridLadder)
 		if err == nil {
-			record.FiveKlineVolume = &d
+			record.GridLadder = &gridLadder
 		} else {
 			text = "❌ 配置修改失败, 请稍后重试"
-			logger.Errorf("[StrategySettingsHandler] 更新配置[FiveKlineVolume]失败, %v", err)
+			logger.Errorf("[StrategySettingsHandler] 更新配置[GridLadder]失败, %v", err)
 		}
 		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
 
@@ -729,11 +954,11 @@ func (h *StrategySettingsHandler) handleFiveKlineVolume(ctx context.Context, upd
 	return nil
 }
 
-func (h *StrategySettingsHandler) handleUpperBoundExit(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+func (h *StrategySettingsHandler) handleTrailingDwellMinutes(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
 	// 步骤1
 	if update.CallbackQuery != nil {
 		chatId := update.CallbackQuery.Message.Chat.ID
-		text := "🌳 填写离场目标价格, 到达此价格将自动清仓并停止策略"
+		text := "🌳 填写网格追踪等待时间, 价格离开区间超过此时间后重新居中\n\n💵 例如: 30｜代表 30 分钟, 单位是分钟"
 		c := tgbotapi.NewMessage(chatId, text)
 		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
 
@@ -743,7 +968,7 @@ func (h *StrategySettingsHandler) handleUpperBoundExit(ctx context.Context, upda
 			return err
 		}
 
-		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionUpperBoundExit), Context: update.CallbackQuery.Message}
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionTrailingDwellMinutes), Context: update.CallbackQuery.Message}
 		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
 
 		return nil
@@ -758,26 +983,26 @@ func (h *StrategySettingsHandler) handleUpperBoundExit(ctx context.Context, upda
 		}
 		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
 
-		// 检查输入金额
-		d, err := decimal.NewFromString(update.Message.Text)
-		if err != nil || d.LessThan(decimal.Zero) {
-			text := "⚠️ 请输入有效离场目标价格"
+		// 检查输入时间
+		d, err := strconv.Atoi(update.Message.Text)
+		if err != nil || d <= 0 {
+			text := "⚠️ 请输入有效的整数"
 			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
 			return nil
 		}
 
-		if (record.UpperBoundExit == nil && d.IsZero()) || (record.UpperBoundExit != nil && d.Equal(*record.UpperBoundExit)) {
+		if d == record.TrailingDwellMinutes {
 			return nil
 		}
 
 		// 发送成功提示
 		text := "✅ 配置修改成功"
-		err = h.svcCtx.StrategyModel.UpdateUpperBoundExit(ctx, record.ID, d)
+		err = h.svcCtx.StrategyModel.UpdateTrailingDwellMinutes(ctx, record.ID, d)
 		if err == nil {
-			record.UpperBoundExit = &d
+			record.TrailingDwellMinutes = d
 		} else {
 			text = "❌ 配置修改失败, 请稍后重试"
-			logger.Errorf("[StrategySettingsHandler] 更新配置[UpperBoundExit]失败, %v", err)
+			logger.Errorf("[StrategySettingsHandler] 更新配置[TrailingDwellMinutes]失败, %v", err)
 		}
 		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
 
@@ -796,11 +1021,18 @@ func (h *StrategySettingsHandler) handleUpperBoundExit(ctx context.Context, upda
 	return nil
 }
 
-func (h *StrategySettingsHandler) handleStopLossExit(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+func (h *StrategySettingsHandler) handleUpperPriceBound(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	chatId, _ := utils.GetChatId(&update)
+	if record.Status == strategy.StatusActive {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 策略开启后, 只允许修改单笔投入金额", 1)
+		return nil
+	}
+
 	// 步骤1
 	if update.CallbackQuery != nil {
 		chatId := update.CallbackQuery.Message.Chat.ID
-		text := "🌳 填写止损金额阈值, 如果亏损金额达到阈值, 将自动清仓并停止策略"
+		text := fmt.Sprintf("🌳 填写网格最高价格（单位: %s）\n\n💵 例: 100 → 代表100 %s",
+			h.svcCtx.Config.Chain.StablecoinSymbol, h.svcCtx.Config.Chain.StablecoinSymbol)
 		c := tgbotapi.NewMessage(chatId, text)
 		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
 
@@ -810,7 +1042,7 @@ func (h *StrategySettingsHandler) handleStopLossExit(ctx context.Context, update
 			return err
 		}
 
-		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionStopLossExit), Context: update.CallbackQuery.Message}
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionUpperPriceBound), Context: update.CallbackQuery.Message}
 		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
 
 		return nil
@@ -827,47 +1059,55 @@ func (h *StrategySettingsHandler) handleStopLossExit(ctx context.Context, update
 
 		// 检查输入金额
 		d, err := decimal.NewFromString(update.Message.Text)
-		if err != nil || d.LessThan(decimal.Zero) {
-			text := "⚠️ 请输入有效止损金额阈值"
-			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+		if err != nil || d.LessThanOrEqual(decimal.Zero) {
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "⚠️ 请输入有效金额", 1)
+			return nil
+		} else if d.LessThanOrEqual(record.LowerPriceBound) {
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "⚠️ 网格最高价格必须大于最低价格", 1)
 			return nil
 		}
 
-		if (record.StopLossExit == nil && d.IsZero()) || (record.StopLossExit != nil && d.Equal(*record.StopLossExit)) {
+		if d.Equal(record.UpperPriceBound) {
 			return nil
 		}
 
 		// 发送成功提示
 		text := "✅ 配置修改成功"
-		err = h.svcCtx.StrategyModel.UpdateStopLossExit(ctx, record.ID, d)
+		err = h.svcCtx.StrategyModel.UpdateUpperPriceBound(ctx, record.ID, d)
 		if err == nil {
-			record.StopLossExit = &d
+			record.UpperPriceBound = d
 		} else {
 			text = "❌ 配置修改失败, 请稍后重试"
-			logger.Errorf("[StrategySettingsHandler] 更新配置[UpdateStopLossExit]失败, %v", err)
+			logger.Errorf("[StrategySettingsHandler] 更新配置[UpperPriceBound]失败, %v", err)
 		}
 		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
 
 		// 更新用户界面
 		if update.Message.ReplyToMessage == nil {
-			return DisplayStrategSettingsMenu(h.svcCtx, h.svcCtx.BotApi, update, record)
+			return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, update, record)
 		} else {
 			route, ok := h.svcCtx.MessageCache.GetRoute(chatId, update.Message.ReplyToMessage.MessageID)
 			if ok && route.Context != nil {
-				return DisplayStrategSettingsMenu(h.svcCtx, h.svcCtx.BotApi, tgbotapi.Update{Message: route.Context}, record)
+				return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, tgbotapi.Update{Message: route.Context}, record)
 			}
-			return DisplayStrategSettingsMenu(h.svcCtx, h.svcCtx.BotApi, update, record)
+			return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, update, record)
 		}
 	}
 
 	return nil
 }
 
-func (h *StrategySettingsHandler) handleTakeProfitExit(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+func (h *StrategySettingsHandler) handleLowerPriceBound(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	chatId, _ := utils.GetChatId(&update)
+	if record.Status == strategy.StatusActive {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 策略开启后, 只允许修改单笔投入金额", 1)
+		return nil
+	}
+
 	// 步骤1
 	if update.CallbackQuery != nil {
-		chatId := update.CallbackQuery.Message.Chat.ID
-		text := "🌳 填写盈利目标金额, 如果盈利达到盈利目标, 将自动清仓并停止策略"
+		text := fmt.Sprintf("🌳 填写网格最低价格（单位: %s）\n\n💵 例: 100 → 代表100 %s",
+			h.svcCtx.Config.Chain.StablecoinSymbol, h.svcCtx.Config.Chain.StablecoinSymbol)
 		c := tgbotapi.NewMessage(chatId, text)
 		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
 
@@ -877,7 +1117,7 @@ func (h *StrategySettingsHandler) handleTakeProfitExit(ctx context.Context, upda
 			return err
 		}
 
-		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionTakeProfitExit), Context: update.CallbackQuery.Message}
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionLowerPriceBound), Context: update.CallbackQuery.Message}
 		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
 
 		return nil
@@ -885,7 +1125,6 @@ func (h *StrategySettingsHandler) handleTakeProfitExit(ctx context.Context, upda
 
 	// 步骤2
 	if update.Message != nil {
-		chatId := update.Message.Chat.ID
 		deleteMessages := []int{update.Message.MessageID}
 		if update.Message.ReplyToMessage != nil {
 			deleteMessages = append(deleteMessages, update.Message.ReplyToMessage.MessageID)
@@ -894,24 +1133,653 @@ func (h *StrategySettingsHandler) handleTakeProfitExit(ctx context.Context, upda
 
 		// 检查输入金额
 		d, err := decimal.NewFromString(update.Message.Text)
-		if err != nil || d.LessThan(decimal.Zero) {
-			text := "⚠️ 请输入有效盈利目标金额"
-			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+		if err != nil || d.LessThanOrEqual(decimal.Zero) {
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "⚠️ 请输入有效金额", 1)
+			return nil
+		} else if d.GreaterThanOrEqual(record.UpperPriceBound) {
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "⚠️ 网格最低价格必须小于最高价格", 1)
 			return nil
 		}
 
-		if (record.TakeProfitExit == nil && d.IsZero()) || (record.TakeProfitExit != nil && d.Equal(*record.TakeProfitExit)) {
+		if d.Equal(record.LowerPriceBound) {
 			return nil
 		}
 
 		// 发送成功提示
 		text := "✅ 配置修改成功"
-		err = h.svcCtx.StrategyModel.UpdateTakeProfitExit(ctx, record.ID, d)
+		err = h.svcCtx.StrategyModel.UpdateLowerPriceBound(ctx, record.ID, d)
+		if err == nil {
+			record.LowerPriceBound = d
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[StrategySettingsHandler] 更新配置[LowerPriceBound]失败, %v", err)
+		}
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+
+		// 更新用户界面
+		if update.Message.ReplyToMessage == nil {
+			return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, update, record)
+		} else {
+			route, ok := h.svcCtx.MessageCache.GetRoute(chatId, update.Message.ReplyToMessage.MessageID)
+			if ok && route.Context != nil {
+				return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, tgbotapi.Update{Message: route.Context}, record)
+			}
+			return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, update, record)
+		}
+	}
+
+	return nil
+}
+
+func (h *StrategySettingsHandler) handleLastKlineVolume(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	// 步骤1
+	if update.CallbackQuery != nil {
+		chatId := update.CallbackQuery.Message.Chat.ID
+		text := "🌳 填写近期的最小交易量, 如果交易量小于此值则不会买入, 0 表示不受限制"
+		c := tgbotapi.NewMessage(chatId, text)
+		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
+
+		msg, err := h.botApi.Send(c)
+		if err != nil {
+			logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionLastKlineVolume), Context: update.CallbackQuery.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		chatId := update.Message.Chat.ID
+		deleteMessages := []int{update.Message.MessageID}
+		if update.Message.ReplyToMessage != nil {
+			deleteMessages = append(deleteMessages, update.Message.ReplyToMessage.MessageID)
+		}
+		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
+
+		// 检查输入金额
+		d, err := decimal.NewFromString(update.Message.Text)
+		if err != nil || d.LessThan(decimal.Zero) {
+			text := "⚠️ 请输入有效交易量数值"
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+			return nil
+		}
+
+		if (record.LastKlineVolume == nil && d.IsZero()) || (record.LastKlineVolume != nil && d.Equal(*record.LastKlineVolume)) {
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err = h.svcCtx.StrategyModel.UpdateLastKlineVolume(ctx, record.ID, d)
+		if err == nil {
+			record.LastKlineVolume = &d
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[StrategySettingsHandler] 更新配置[LastKlineVolume]失败, %v", err)
+		}
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+
+		// 更新用户界面
+		if update.Message.ReplyToMessage == nil {
+			return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, update, record)
+		} else {
+			route, ok := h.svcCtx.MessageCache.GetRoute(chatId, update.Message.ReplyToMessage.MessageID)
+			if ok && route.Context != nil {
+				return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, tgbotapi.Update{Message: route.Context}, record)
+			}
+			return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, update, record)
+		}
+	}
+
+	return nil
+}
+
+func (h *StrategySettingsHandler) handleFiveKlineVolume(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	// 步骤1
+	if update.CallbackQuery != nil {
+		chatId := update.CallbackQuery.Message.Chat.ID
+		text := "🌳 填写近期5分钟的最小交易量, 如果交易量小于此值则不会买入, 0 表示不受限制"
+		c := tgbotapi.NewMessage(chatId, text)
+		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
+
+		msg, err := h.botApi.Send(c)
+		if err != nil {
+			logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionFiveKlineVolume), Context: update.CallbackQuery.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		chatId := update.Message.Chat.ID
+		deleteMessages := []int{update.Message.MessageID}
+		if update.Message.ReplyToMessage != nil {
+			deleteMessages = append(deleteMessages, update.Message.ReplyToMessage.MessageID)
+		}
+		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
+
+		// 检查输入金额
+		d, err := decimal.NewFromString(update.Message.Text)
+		if err != nil || d.LessThan(decimal.Zero) {
+			text := "⚠️ 请输入有效交易量数值"
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+			return nil
+		}
+
+		if (record.FiveKlineVolume == nil && d.IsZero()) || (record.FiveKlineVolume != nil && d.Equal(*record.FiveKlineVolume)) {
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err = h.svcCtx.StrategyModel.UpdateFiveKlineVolume(ctx, record.ID, d)
+		if err == nil {
+			record.FiveKlineVolume = &d
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[StrategySettingsHandler] 更新配置[FiveKlineVolume]失败, %v", err)
+		}
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+
+		// 更新用户界面
+		if update.Message.ReplyToMessage == nil {
+			return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, update, record)
+		} else {
+			route, ok := h.svcCtx.MessageCache.GetRoute(chatId, update.Message.ReplyToMessage.MessageID)
+			if ok && route.Context != nil {
+				return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, tgbotapi.Update{Message: route.Context}, record)
+			}
+			return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, update, record)
+		}
+	}
+
+	return nil
+}
+
+func (h *StrategySettingsHandler) handleUpperBoundExit(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	// 步骤1
+	if update.CallbackQuery != nil {
+		chatId := update.CallbackQuery.Message.Chat.ID
+		text := "🌳 填写离场目标价格, 到达此价格将自动清仓并停止策略"
+		c := tgbotapi.NewMessage(chatId, text)
+		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
+
+		msg, err := h.botApi.Send(c)
+		if err != nil {
+			logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionUpperBoundExit), Context: update.CallbackQuery.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		chatId := update.Message.Chat.ID
+		deleteMessages := []int{update.Message.MessageID}
+		if update.Message.ReplyToMessage != nil {
+			deleteMessages = append(deleteMessages, update.Message.ReplyToMessage.MessageID)
+		}
+		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
+
+		// 检查输入金额
+		d, err := decimal.NewFromString(update.Message.Text)
+		if err != nil || d.LessThan(decimal.Zero) {
+			text := "⚠️ 请输入有效离场目标价格"
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+			return nil
+		}
+
+		if (record.UpperBoundExit == nil && d.IsZero()) || (record.UpperBoundExit != nil && d.Equal(*record.UpperBoundExit)) {
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err = h.svcCtx.StrategyModel.UpdateUpperBoundExit(ctx, record.ID, d)
+		if err == nil {
+			record.UpperBoundExit = &d
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[StrategySettingsHandler] 更新配置[UpperBoundExit]失败, %v", err)
+		}
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+
+		// 更新用户界面
+		if update.Message.ReplyToMessage == nil {
+			return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, update, record)
+		} else {
+			route, ok := h.svcCtx.MessageCache.GetRoute(chatId, update.Message.ReplyToMessage.MessageID)
+			if ok && route.Context != nil {
+				return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, tgbotapi.Update{Message: route.Context}, record)
+			}
+			return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, update, record)
+		}
+	}
+
+	return nil
+}
+
+func (h *StrategySettingsHandler) handleStopLossExit(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	// 步骤1
+	if update.CallbackQuery != nil {
+		chatId := update.CallbackQuery.Message.Chat.ID
+		text := "🌳 填写止损金额阈值, 如果亏损金额达到阈值, 将自动清仓并停止策略"
+		c := tgbotapi.NewMessage(chatId, text)
+		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
+
+		msg, err := h.botApi.Send(c)
+		if err != nil {
+			logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionStopLossExit), Context: update.CallbackQuery.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		chatId := update.Message.Chat.ID
+		deleteMessages := []int{update.Message.MessageID}
+		if update.Message.ReplyToMessage != nil {
+			deleteMessages = append(deleteMessages, update.Message.ReplyToMessage.MessageID)
+		}
+		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
+
+		// 检查输入金额
+		d, err := decimal.NewFromString(update.Message.Text)
+		if err != nil || d.LessThan(decimal.Zero) {
+			text := "⚠️ 请输入有效止损金额阈值"
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+			return nil
+		}
+
+		if (record.StopLossExit == nil && d.IsZero()) || (record.StopLossExit != nil && d.Equal(*record.StopLossExit)) {
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err = h.svcCtx.StrategyModel.UpdateStopLossExit(ctx, record.ID, d)
+		if err == nil {
+			record.StopLossExit = &d
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[StrategySettingsHandler] 更新配置[UpdateStopLossExit]失败, %v", err)
+		}
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+
+		// 更新用户界面
+		if update.Message.ReplyToMessage == nil {
+			return DisplayStrategSettingsMenu(h.svcCtx, h.svcCtx.BotApi, update, record)
+		} else {
+			route, ok := h.svcCtx.MessageCache.GetRoute(chatId, update.Message.ReplyToMessage.MessageID)
+			if ok && route.Context != nil {
+				return DisplayStrategSettingsMenu(h.svcCtx, h.svcCtx.BotApi, tgbotapi.Update{Message: route.Context}, record)
+			}
+			return DisplayStrategSettingsMenu(h.svcCtx, h.svcCtx.BotApi, update, record)
+		}
+	}
+
+	return nil
+}
+
+func (h *StrategySettingsHandler) handleTakeProfitExit(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	// 步骤1
+	if update.CallbackQuery != nil {
+		chatId := update.CallbackQuery.Message.Chat.ID
+		text := "🌳 填写盈利目标金额, 如果盈利达到盈利目标, 将自动清仓并停止策略"
+		c := tgbotapi.NewMessage(chatId, text)
+		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
+
+		msg, err := h.botApi.Send(c)
+		if err != nil {
+			logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionTakeProfitExit), Context: update.CallbackQuery.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		chatId := update.Message.Chat.ID
+		deleteMessages := []int{update.Message.MessageID}
+		if update.Message.ReplyToMessage != nil {
+			deleteMessages = append(deleteMessages, update.Message.ReplyToMessage.MessageID)
+		}
+		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
+
+		// 检查输入金额
+		d, err := decimal.NewFromString(update.Message.Text)
+		if err != nil || d.LessThan(decimal.Zero) {
+			text := "⚠️ 请输入有效盈利目标金额"
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+			return nil
+		}
+
+		if (record.TakeProfitExit == nil && d.IsZero()) || (record.TakeProfitExit != nil && d.Equal(*record.TakeProfitExit)) {
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err = h.svcCtx.StrategyModel.UpdateTakeProfitExit(ctx, record.ID, d)
+		if err == nil {
+			record.TakeProfitExit = &d
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[StrategySettingsHandler] 更新配置[TakeProfitExit]失败, %v", err)
+		}
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+
+		// 更新用户界面
+		if update.Message.ReplyToMessage == nil {
+			return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, update, record)
+		} else {
+			route, ok := h.svcCtx.MessageCache.GetRoute(chatId, update.Message.ReplyToMessage.MessageID)
+			if ok && route.Context != nil {
+				return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, tgbotapi.Update{Message: route.Context}, record)
+			}
+			return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, update, record)
+		}
+	}
+
+	return nil
+}
+
+func (h *StrategySettingsHandler) handleDropOn(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	if update.CallbackQuery == nil {
+		return nil
+	}
+
+	text := "✅ 配置修改成功"
+	err := h.svcCtx.StrategyModel.UpdateDropOn(ctx, record.ID, !record.DropOn)
+	if err == nil {
+		record.DropOn = !record.DropOn
+	} else {
+		text = "❌ 配置修改失败, 请稍后重试"
+		logger.Errorf("[StrategySettingsHandler] 更新配置[DropOn]失败, %v", err)
+	}
+
+	chatId := update.CallbackQuery.Message.Chat.ID
+	utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+
+	return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, update, record)
+}
+
+func (h *StrategySettingsHandler) handleCandlesToCheck(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	// 步骤1
+	if update.CallbackQuery != nil {
+		chatId := update.CallbackQuery.Message.Chat.ID
+		text := "🌳 填写防瀑布K线根数, 如果达到下跌阈值则自动关闭策略并清仓"
+		c := tgbotapi.NewMessage(chatId, text)
+		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
+
+		msg, err := h.botApi.Send(c)
+		if err != nil {
+			logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionCandlesToCheck), Context: update.CallbackQuery.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		chatId := update.Message.Chat.ID
+		deleteMessages := []int{update.Message.MessageID}
+		if update.Message.ReplyToMessage != nil {
+			deleteMessages = append(deleteMessages, update.Message.ReplyToMessage.MessageID)
+		}
+		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
+
+		// 检查输入金额
+		d, err := strconv.Atoi(update.Message.Text)
+		if err != nil || d < 0 {
+			text := "⚠️ 请输入有效K线根数"
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+			return nil
+		}
+
+		if d == record.CandlesToCheck {
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err = h.svcCtx.StrategyModel.UpdateCandlesToCheck(ctx, record.ID, d)
+		if err == nil {
+			record.CandlesToCheck = d
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[StrategySettingsHandler] 更新配置[CandlesToCheck]失败, %v", err)
+		}
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+
+		// 更新用户界面
+		if update.Message.ReplyToMessage == nil {
+			return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, update, record)
+		} else {
+			route, ok := h.svcCtx.MessageCache.GetRoute(chatId, update.Message.ReplyToMessage.MessageID)
+			if ok && route.Context != nil {
+				return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, tgbotapi.Update{Message: route.Context}, record)
+			}
+			return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, update, record)
+		}
+	}
+
+	return nil
+}
+
+func (h *StrategySettingsHandler) handleDropThreshold(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	// 步骤1
+	if update.CallbackQuery != nil {
+		chatId := update.CallbackQuery.Message.Chat.ID
+		text := "🌳 填写防瀑布下跌阈值%, 如果达到下跌阈值则自动关闭策略并清仓\n\n💵 例如: 10｜代表 10% , 单位是 %"
+		c := tgbotapi.NewMessage(chatId, text)
+		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
+
+		msg, err := h.botApi.Send(c)
+		if err != nil {
+			logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionDropThreshold), Context: update.CallbackQuery.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		chatId := update.Message.Chat.ID
+		deleteMessages := []int{update.Message.MessageID}
+		if update.Message.ReplyToMessage != nil {
+			deleteMessages = append(deleteMessages, update.Message.ReplyToMessage.MessageID)
+		}
+		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
+
+		// 检查输入金额
+		d, err := decimal.NewFromString(update.Message.Text)
+		if err != nil || d.LessThan(decimal.Zero) {
+			text := "⚠️ 请输入有效防瀑布下跌阈值%"
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+			return nil
+		}
+
+		if (record.DropThreshold == nil && d.IsZero()) || (record.DropThreshold != nil && d.Equal(*record.DropThreshold)) {
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err = h.svcCtx.StrategyModel.UpdateDropThreshold(ctx, record.ID, d)
+		if err == nil {
+			record.DropThreshold = &d
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[StrategySettingsHandler] 更新配置[DropThreshold]失败, %v", err)
+		}
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+
+		// 更新用户界面
+		if update.Message.ReplyToMessage == nil {
+			return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, update, record)
+		} else {
+			route, ok := h.svcCtx.MessageCache.GetRoute(chatId, update.Message.ReplyToMessage.MessageID)
+			if ok && route.Context != nil {
+				return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, tgbotapi.Update{Message: route.Context}, record)
+			}
+			return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, update, record)
+		}
+	}
+
+	return nil
+}
+
+func (h *StrategySettingsHandler) handleGlobalTakeProfitRatio(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	// 步骤1
+	if update.CallbackQuery != nil {
+		chatId := update.CallbackQuery.Message.Chat.ID
+		text := "🌳 填写全局止盈%, 如果整体盈利率达到此值则清仓并关闭策略, 0 表示不受限制\n\n💵 例如: 1｜代表 1% , 单位是 %"
+		c := tgbotapi.NewMessage(chatId, text)
+		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
+
+		msg, err := h.botApi.Send(c)
+		if err != nil {
+			logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionGlobalTakeProfitRatio), Context: update.CallbackQuery.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		chatId := update.Message.Chat.ID
+		deleteMessages := []int{update.Message.MessageID}
+		if update.Message.ReplyToMessage != nil {
+			deleteMessages = append(deleteMessages, update.Message.ReplyToMessage.MessageID)
+		}
+		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
+
+		// 检查输入金额
+		d, err := decimal.NewFromString(update.Message.Text)
+		if err != nil || d.LessThan(decimal.Zero) {
+			text := "⚠️ 请输入有效全局止盈%"
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+			return nil
+		}
+
+		d = d.Div(decimal.NewFromInt(100))
+		if (record.GlobalTakeProfitRatio == nil && d.IsZero()) || (record.GlobalTakeProfitRatio != nil && d.Equal(*record.GlobalTakeProfitRatio)) {
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err = h.svcCtx.StrategyModel.UpdateGlobalTakeProfitRatio(ctx, record.ID, d)
+		if err == nil {
+			record.GlobalTakeProfitRatio = &d
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[StrategySettingsHandler] 更新配置[GlobalTakeProfitRatio]失败, %v", err)
+		}
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+
+		// 更新用户界面
+		if update.Message.ReplyToMessage == nil {
+			return DisplayStrategSettingsMenu(h.svcCtx, h.svcCtx.BotApi, update, record)
+		} else {
+			route, ok := h.svcCtx.MessageCache.GetRoute(chatId, update.Message.ReplyToMessage.MessageID)
+			if ok && route.Context != nil {
+				return DisplayStrategSettingsMenu(h.svcCtx, h.svcCtx.BotApi, tgbotapi.Update{Message: route.Context}, record)
+			}
+			return DisplayStrategSettingsMenu(h.svcCtx, h.svcCtx.BotApi, update, record)
+		}
+	}
+
+	return nil
+}
+
+func (h *StrategySettingsHandler) handleTrailingTakeProfit(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	// 步骤1
+	if update.CallbackQuery != nil {
+		chatId := update.CallbackQuery.Message.Chat.ID
+		text := "🌳 填写追踪止盈回撤%, 达到止盈价格后继续持有, 从最高价回撤此比例时卖出, 0 表示关闭追踪止盈\n\n💵 例如: 3｜代表 3% , 单位是 %"
+		c := tgbotapi.NewMessage(chatId, text)
+		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
+
+		msg, err := h.botApi.Send(c)
+		if err != nil {
+			logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionTrailingTakeProfit), Context: update.CallbackQuery.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		chatId := update.Message.Chat.ID
+		deleteMessages := []int{update.Message.MessageID}
+		if update.Message.ReplyToMessage != nil {
+			deleteMessages = append(deleteMessages, update.Message.ReplyToMessage.MessageID)
+		}
+		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
+
+		// 检查输入比例
+		d, err := decimal.NewFromString(update.Message.Text)
+		if err != nil || d.LessThan(decimal.Zero) || d.GreaterThanOrEqual(decimal.NewFromInt(100)) {
+			text := "⚠️ 请输入有效追踪止盈回撤%"
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+			return nil
+		}
+
+		if (record.TrailingTakeProfit == nil && d.IsZero()) || (record.TrailingTakeProfit != nil && d.Equal(*record.TrailingTakeProfit)) {
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err = h.svcCtx.StrategyModel.UpdateTrailingTakeProfit(ctx, record.ID, d)
 		if err == nil {
-			record.TakeProfitExit = &d
+			record.TrailingTakeProfit = &d
+			if d.IsZero() {
+				record.TrailingTakeProfit = nil
+			}
 		} else {
 			text = "❌ 配置修改失败, 请稍后重试"
-			logger.Errorf("[StrategySettingsHandler] 更新配置[TakeProfitExit]失败, %v", err)
+			logger.Errorf("[StrategySettingsHandler] 更新配置[TrailingTakeProfit]失败, %v", err)
 		}
 		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
 
@@ -930,18 +1798,171 @@ func (h *StrategySettingsHandler) handleTakeProfitExit(ctx context.Context, upda
 	return nil
 }
 
-func (h *StrategySettingsHandler) handleDropOn(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+func (h *StrategySettingsHandler) handleTakeProfitPortion(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	// 步骤1
+	if update.CallbackQuery != nil {
+		chatId := update.CallbackQuery.Message.Chat.ID
+		text := "🌳 填写网格止盈时卖出的数量%, 剩余部分留仓, 由止盈金额、离场目标价格等全局规则卖出, 100 表示全部卖出\n\n💵 例如: 80｜代表卖出 80% , 单位是 %"
+		c := tgbotapi.NewMessage(chatId, text)
+		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
+
+		msg, err := h.botApi.Send(c)
+		if err != nil {
+			logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionTakeProfitPortion), Context: update.CallbackQuery.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		chatId := update.Message.Chat.ID
+		deleteMessages := []int{update.Message.MessageID}
+		if update.Message.ReplyToMessage != nil {
+			deleteMessages = append(deleteMessages, update.Message.ReplyToMessage.MessageID)
+		}
+		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
+
+		// 检查输入比例
+		d, err := decimal.NewFromString(update.Message.Text)
+		if err != nil || d.LessThanOrEqual(decimal.Zero) || d.GreaterThan(decimal.NewFromInt(100)) {
+			text := "⚠️ 请输入有效止盈卖出比例%"
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+			return nil
+		}
+
+		// 全部卖出时清除配置
+		if d.Equal(decimal.NewFromInt(100)) {
+			d = decimal.Zero
+		}
+		if (record.TakeProfitPortion == nil && d.IsZero()) || (record.TakeProfitPortion != nil && d.Equal(*record.TakeProfitPortion)) {
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err = h.svcCtx.StrategyModel.UpdateTakeProfitPortion(ctx, record.ID, d)
+		if err == nil {
+			record.TakeProfitPortion = &d
+			if d.IsZero() {
+				record.TakeProfitPortion = nil
+			}
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[StrategySettingsHandler] 更新配置[TakeProfitPortion]失败, %v", err)
+		}
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+
+		// 更新用户界面
+		if update.Message.ReplyToMessage == nil {
+			return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, update, record)
+		} else {
+			route, ok := h.svcCtx.MessageCache.GetRoute(chatId, update.Message.ReplyToMessage.MessageID)
+			if ok && route.Context != nil {
+				return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, tgbotapi.Update{Message: route.Context}, record)
+			}
+			return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, update, record)
+		}
+	}
+
+	return nil
+}
+
+func (h *StrategySettingsHandler) handleRsiThreshold(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	// 步骤1
+	if update.CallbackQuery != nil {
+		chatId := update.CallbackQuery.Message.Chat.ID
+		text := "🌳 填写RSI过滤阈值, RSI(14) 低于此值时才允许买入, 0 表示关闭\n\n💵 例如: 30｜代表 RSI 低于 30 时买入"
+		c := tgbotapi.NewMessage(chatId, text)
+		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
+
+		msg, err := h.botApi.Send(c)
+		if err != nil {
+			logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionRsiThreshold), Context: update.CallbackQuery.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		chatId := update.Message.Chat.ID
+		deleteMessages := []int{update.Message.MessageID}
+		if update.Message.ReplyToMessage != nil {
+			deleteMessages = append(deleteMessages, update.Message.ReplyToMessage.MessageID)
+		}
+		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
+
+		// 检查输入数值
+		d, err := decimal.NewFromString(update.Message.Text)
+		if err != nil || d.LessThan(decimal.Zero) || d.GreaterThan(decimal.NewFromInt(100)) {
+			text := "⚠️ 请输入有效RSI阈值"
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+			return nil
+		}
+
+		if (record.RsiThreshold == nil && d.IsZero()) || (record.RsiThreshold != nil && d.Equal(*record.RsiThreshold)) {
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err = h.svcCtx.StrategyModel.UpdateRsiThreshold(ctx, record.ID, d)
+		if err == nil {
+			record.RsiThreshold = &d
+			if d.IsZero() {
+				record.RsiThreshold = nil
+			}
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[StrategySettingsHandler] 更新配置[RsiThreshold]失败, %v", err)
+		}
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+
+		// 更新用户界面
+		if update.Message.ReplyToMessage == nil {
+			return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, update, record)
+		} else {
+			route, ok := h.svcCtx.MessageCache.GetRoute(chatId, update.Message.ReplyToMessage.MessageID)
+			if ok && route.Context != nil {
+				return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, tgbotapi.Update{Message: route.Context}, record)
+			}
+			return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, update, record)
+		}
+	}
+
+	return nil
+}
+
+func (h *StrategySettingsHandler) handleEmaFilter(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
 	if update.CallbackQuery == nil {
 		return nil
 	}
 
+	// 关闭 -> 价格在EMA上方 -> 价格在EMA下方
+	emaFilter := strategy.EmaFilterAbove
+	switch record.EmaFilter {
+	case strategy.EmaFilterAbove:
+		emaFilter = strategy.EmaFilterBelow
+	case strategy.EmaFilterBelow:
+		emaFilter = strategy.EmaFilterOff
+	}
+
 	text := "✅ 配置修改成功"
-	err := h.svcCtx.StrategyModel.UpdateDropOn(ctx, record.ID, !record.DropOn)
+	err := h.svcCtx.StrategyModel.UpdateEmaFilter(ctx, record.ID, emaFilter)
 	if err == nil {
-		record.DropOn = !record.DropOn
+		record.EmaFilter = emaFilter
 	} else {
 		text = "❌ 配置修改失败, 请稍后重试"
-		logger.Errorf("[StrategySettingsHandler] 更新配置[DropOn]失败, %v", err)
+		logger.Errorf("[StrategySettingsHandler] 更新配置[EmaFilter]失败, %v", err)
 	}
 
 	chatId := update.CallbackQuery.Message.Chat.ID
@@ -950,11 +1971,11 @@ func (h *StrategySettingsHandler) handleDropOn(ctx context.Context, update tgbot
 	return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, update, record)
 }
 
-func (h *StrategySettingsHandler) handleCandlesToCheck(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+func (h *StrategySettingsHandler) handleEmaPeriod(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
 	// 步骤1
 	if update.CallbackQuery != nil {
 		chatId := update.CallbackQuery.Message.Chat.ID
-		text := "🌳 填写防瀑布K线根数, 如果达到下跌阈值则自动关闭策略并清仓"
+		text := "🌳 填写EMA周期, 单位是K线根数, 0 表示默认 20\n\n💵 例如: 50｜代表 EMA(50)"
 		c := tgbotapi.NewMessage(chatId, text)
 		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
 
@@ -964,7 +1985,7 @@ func (h *StrategySettingsHandler) handleCandlesToCheck(ctx context.Context, upda
 			return err
 		}
 
-		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionCandlesToCheck), Context: update.CallbackQuery.Message}
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionEmaPeriod), Context: update.CallbackQuery.Message}
 		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
 
 		return nil
@@ -979,26 +2000,26 @@ func (h *StrategySettingsHandler) handleCandlesToCheck(ctx context.Context, upda
 		}
 		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
 
-		// 检查输入金额
+		// 检查输入数值
 		d, err := strconv.Atoi(update.Message.Text)
-		if err != nil || d < 0 {
-			text := "⚠️ 请输入有效K线根数"
+		if err != nil || d < 0 || d == 1 {
+			text := "⚠️ 请输入有效EMA周期"
 			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
 			return nil
 		}
 
-		if d == record.CandlesToCheck {
+		if d == record.EmaPeriod {
 			return nil
 		}
 
 		// 发送成功提示
 		text := "✅ 配置修改成功"
-		err = h.svcCtx.StrategyModel.UpdateCandlesToCheck(ctx, record.ID, d)
+		err = h.svcCtx.StrategyModel.UpdateEmaPeriod(ctx, record.ID, d)
 		if err == nil {
-			record.CandlesToCheck = d
+			record.EmaPeriod = d
 		} else {
 			text = "❌ 配置修改失败, 请稍后重试"
-			logger.Errorf("[StrategySettingsHandler] 更新配置[CandlesToCheck]失败, %v", err)
+			logger.Errorf("[StrategySettingsHandler] 更新配置[EmaPeriod]失败, %v", err)
 		}
 		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
 
@@ -1017,11 +2038,62 @@ func (h *StrategySettingsHandler) handleCandlesToCheck(ctx context.Context, upda
 	return nil
 }
 
-func (h *StrategySettingsHandler) handleDropThreshold(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+func (h *StrategySettingsHandler) handleEnableMacdFilter(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	if update.CallbackQuery == nil {
+		return nil
+	}
+
+	text := "✅ 配置修改成功"
+	err := h.svcCtx.StrategyModel.UpdateMacdFilter(ctx, record.ID, !record.MacdFilter)
+	if err == nil {
+		record.MacdFilter = !record.MacdFilter
+	} else {
+		text = "❌ 配置修改失败, 请稍后重试"
+		logger.Errorf("[StrategySettingsHandler] 更新配置[MacdFilter]失败, %v", err)
+	}
+
+	chatId := update.CallbackQuery.Message.Chat.ID
+	utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+
+	return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, update, record)
+}
+
+func (h *StrategySettingsHandler) handleEnableAdaptiveSpacing(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	if update.CallbackQuery == nil {
+		return nil
+	}
+
+	chatId := update.CallbackQuery.Message.Chat.ID
+	if record.Status == strategy.StatusActive {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 策略开启后, 只允许修改单笔投入金额", 1)
+		return nil
+	}
+
+	text := "✅ 配置修改成功"
+	err := h.svcCtx.StrategyModel.UpdateAdaptiveSpacing(ctx, record.ID, !record.AdaptiveSpacing)
+	if err == nil {
+		record.AdaptiveSpacing = !record.AdaptiveSpacing
+	} else {
+		text = "❌ 配置修改失败, 请稍后重试"
+		logger.Errorf("[StrategySettingsHandler] 更新配置[AdaptiveSpacing]失败, %v", err)
+	}
+
+	utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+
+	return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, update, record)
+}
+
+func (h *StrategySettingsHandler) handleAdaptiveMinRatio(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	chatId, _ := utils.GetChatId(&update)
+	if record.Status == strategy.StatusActive {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 策略开启后, 只允许修改单笔投入金额", 1)
+		return nil
+	}
+
 	// 步骤1
 	if update.CallbackQuery != nil {
 		chatId := update.CallbackQuery.Message.Chat.ID
-		text := "🌳 填写防瀑布下跌阈值%, 如果达到下跌阈值则自动关闭策略并清仓\n\n💵 例如: 10｜代表 10% , 单位是 %"
+		text := "🌳 填写自适应网格最小间隔%, 根据ATR计算的间隔不会低于此值\n\n💵 例如: 1｜代表 1% , 单位是 %"
 		c := tgbotapi.NewMessage(chatId, text)
 		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
 
@@ -1031,7 +2103,7 @@ func (h *StrategySettingsHandler) handleDropThreshold(ctx context.Context, updat
 			return err
 		}
 
-		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionDropThreshold), Context: update.CallbackQuery.Message}
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionAdaptiveMinRatio), Context: update.CallbackQuery.Message}
 		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
 
 		return nil
@@ -1046,26 +2118,26 @@ func (h *StrategySettingsHandler) handleDropThreshold(ctx context.Context, updat
 		}
 		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
 
-		// 检查输入金额
+		// 检查输入比例
 		d, err := decimal.NewFromString(update.Message.Text)
-		if err != nil || d.LessThan(decimal.Zero) {
-			text := "⚠️ 请输入有效防瀑布下跌阈值%"
+		if err != nil || d.LessThanOrEqual(decimal.Zero) {
+			text := "⚠️ 请输入有效最小间隔"
 			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
 			return nil
 		}
 
-		if (record.DropThreshold == nil && d.IsZero()) || (record.DropThreshold != nil && d.Equal(*record.DropThreshold)) {
+		if record.AdaptiveMinRatio != nil && d.Equal(*record.AdaptiveMinRatio) {
 			return nil
 		}
 
 		// 发送成功提示
 		text := "✅ 配置修改成功"
-		err = h.svcCtx.StrategyModel.UpdateDropThreshold(ctx, record.ID, d)
+		err = h.svcCtx.StrategyModel.UpdateAdaptiveMinRatio(ctx, record.ID, d)
 		if err == nil {
-			record.DropThreshold = &d
+			record.AdaptiveMinRatio = &d
 		} else {
 			text = "❌ 配置修改失败, 请稍后重试"
-			logger.Errorf("[StrategySettingsHandler] 更新配置[DropThreshold]失败, %v", err)
+			logger.Errorf("[StrategySettingsHandler] 更新配置[AdaptiveMinRatio]失败, %v", err)
 		}
 		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
 
@@ -1084,11 +2156,17 @@ func (h *StrategySettingsHandler) handleDropThreshold(ctx context.Context, updat
 	return nil
 }
 
-func (h *StrategySettingsHandler) handleGlobalTakeProfitRatio(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+func (h *StrategySettingsHandler) handleAdaptiveMaxRatio(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	chatId, _ := utils.GetChatId(&update)
+	if record.Status == strategy.StatusActive {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 策略开启后, 只允许修改单笔投入金额", 1)
+		return nil
+	}
+
 	// 步骤1
 	if update.CallbackQuery != nil {
 		chatId := update.CallbackQuery.Message.Chat.ID
-		text := "🌳 填写全局止盈%, 如果整体盈利率达到此值则清仓并关闭策略, 0 表示不受限制\n\n💵 例如: 1｜代表 1% , 单位是 %"
+		text := "🌳 填写自适应网格最大间隔%, 根据ATR计算的间隔不会高于此值\n\n💵 例如: 10｜代表 10% , 单位是 %"
 		c := tgbotapi.NewMessage(chatId, text)
 		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
 
@@ -1098,7 +2176,7 @@ func (h *StrategySettingsHandler) handleGlobalTakeProfitRatio(ctx context.Contex
 			return err
 		}
 
-		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionGlobalTakeProfitRatio), Context: update.CallbackQuery.Message}
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionAdaptiveMaxRatio), Context: update.CallbackQuery.Message}
 		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
 
 		return nil
@@ -1113,41 +2191,77 @@ func (h *StrategySettingsHandler) handleGlobalTakeProfitRatio(ctx context.Contex
 		}
 		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
 
-		// 检查输入金额
+		// 检查输入比例
 		d, err := decimal.NewFromString(update.Message.Text)
-		if err != nil || d.LessThan(decimal.Zero) {
-			text := "⚠️ 请输入有效全局止盈%"
+		if err != nil || d.LessThanOrEqual(decimal.Zero) {
+			text := "⚠️ 请输入有效最大间隔"
 			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
 			return nil
 		}
 
-		d = d.Div(decimal.NewFromInt(100))
-		if (record.GlobalTakeProfitRatio == nil && d.IsZero()) || (record.GlobalTakeProfitRatio != nil && d.Equal(*record.GlobalTakeProfitRatio)) {
+		if record.AdaptiveMaxRatio != nil && d.Equal(*record.AdaptiveMaxRatio) {
 			return nil
 		}
 
 		// 发送成功提示
 		text := "✅ 配置修改成功"
-		err = h.svcCtx.StrategyModel.UpdateGlobalTakeProfitRatio(ctx, record.ID, d)
+		err = h.svcCtx.StrategyModel.UpdateAdaptiveMaxRatio(ctx, record.ID, d)
 		if err == nil {
-			record.GlobalTakeProfitRatio = &d
+			record.AdaptiveMaxRatio = &d
 		} else {
 			text = "❌ 配置修改失败, 请稍后重试"
-			logger.Errorf("[StrategySettingsHandler] 更新配置[GlobalTakeProfitRatio]失败, %v", err)
+			logger.Errorf("[StrategySettingsHandler] 更新配置[AdaptiveMaxRatio]失败, %v", err)
 		}
 		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
 
 		// 更新用户界面
 		if update.Message.ReplyToMessage == nil {
-			return DisplayStrategSettingsMenu(h.svcCtx, h.svcCtx.BotApi, update, record)
+			return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, update, record)
 		} else {
 			route, ok := h.svcCtx.MessageCache.GetRoute(chatId, update.Message.ReplyToMessage.MessageID)
 			if ok && route.Context != nil {
-				return DisplayStrategSettingsMenu(h.svcCtx, h.svcCtx.BotApi, tgbotapi.Update{Message: route.Context}, record)
+				return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, tgbotapi.Update{Message: route.Context}, record)
 			}
-			return DisplayStrategSettingsMenu(h.svcCtx, h.svcCtx.BotApi, update, record)
+			return DisplayStrategSettingsMenu(h.svcCtx, h.botApi, update, record)
 		}
 	}
 
 	return nil
 }
+
+func (h *StrategySettingsHandler) handleSuggestBounds(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	if update.CallbackQuery == nil {
+		return nil
+	}
+
+	chatId := update.CallbackQuery.Message.Chat.ID
+	if record.Status == strategy.StatusActive {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 策略开启后, 只允许修改单笔投入金额", 1)
+		return nil
+	}
+
+	// 计算建议价格区间
+	lowerPriceBound, upperPriceBound, err := SuggestPriceBounds(ctx, h.svcCtx, record.Token)
+	if err != nil {
+		logger.Warnf("[StrategySettingsHandler] 计算建议价格区间失败, token: %s, %v", record.Token, err)
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 计算建议价格区间失败, 请稍后重试", 1)
+		return nil
+	}
+
+	err = utils.Tx(ctx, h.svcCtx.DbClient, func(tx *ent.Tx) error {
+		err := model.NewStrategyModel(tx.Strategy).UpdateLowerPriceBound(ctx, record.ID, lowerPriceBound)
+		if err != nil {
+			return err
+		}
+		return model.NewStrategyModel(tx.Strategy).UpdateUpperPriceBound(ctx, record.ID, upperPriceBound)
+	})
+	if err != nil {
+		logger.Errorf("[StrategySettingsHandler] 更新配置[SuggestBounds]失败, %v", err)
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 配置修改失败, 请稍后重试", 1)
+		return nil
+	}
+	record.LowerPriceBound = lowerPriceBound
+	record.UpperPriceBound = upperPriceBound
+
+	return DisplaySuggestedBoundsMenu(h.svcCtx, h.botApi, update, record)
+}
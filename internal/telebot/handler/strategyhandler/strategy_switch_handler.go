@@ -13,6 +13,7 @@ import (
 	"github.com/fachebot/evm-grid-bot/internal/svc"
 	"github.com/fachebot/evm-grid-bot/internal/telebot/pathrouter"
 	"github.com/fachebot/evm-grid-bot/internal/utils"
+	"github.com/fachebot/evm-grid-bot/internal/utils/evm"
 
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
 	"github.com/shopspring/decimal"
@@ -95,6 +96,12 @@ func (h *StrategySwitchHandler) handle(ctx context.Context, vars map[string]stri
 	}
 
 	// 策略关闭
+	chatId, _ := utils.GetChatId(&update)
+	if !TryLockStrategy(h.svcCtx, h.botApi, chatId, record.GUID) {
+		return nil
+	}
+	defer h.svcCtx.StrategyLocker.Unlock(record.GUID)
+
 	switch StopType(stopType) {
 	case StopTypeStop:
 		return h.handleStopStrategy(ctx, userId, update, record)
@@ -131,9 +138,71 @@ func (h *StrategySwitchHandler) handleStartStrategy(ctx context.Context, userId
 		return nil
 	}
 
+	if record.MartinFactor < 1 {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 开启策略失败, 马丁倍投系数不能小于1", 1)
+		return nil
+	}
+
+	if record.GridType == strategy.GridTypeArithmetic && gridstrategy.CalculatePriceStep(record).LessThanOrEqual(decimal.Zero) {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 开启策略失败, 等差网格需要设置网格数量或价格步长", 1)
+		return nil
+	}
+
+	if record.GridType == strategy.GridTypeCustom {
+		levels := gridstrategy.DecodeGridLadder(record.GridLadder)
+		if err := gridstrategy.ValidateGridLadder(levels, record.LowerPriceBound, record.UpperPriceBound); err != nil {
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 开启策略失败, 自定义价格阶梯无效或超出价格区间", 1)
+			return nil
+		}
+	}
+
+	if record.AdaptiveSpacing {
+		if record.GridType == strategy.GridTypeCustom {
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 开启策略失败, 自定义阶梯不支持自适应间隔", 1)
+			return nil
+		}
+		if record.AdaptiveMinRatio == nil || record.AdaptiveMaxRatio == nil ||
+			record.AdaptiveMinRatio.LessThanOrEqual(decimal.Zero) || record.AdaptiveMaxRatio.LessThan(*record.AdaptiveMinRatio) {
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 开启策略失败, 自适应间隔需要设置有效的最小和最大间隔", 1)
+			return nil
+		}
+	}
+
+	// 计算最坏情况所需资金
+	worstCaseCapital, err := gridstrategy.CalculateWorstCaseCapital(record)
+	if err != nil {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 开启策略失败, 网格参数无效", 1)
+		return nil
+	}
+
+	// 检查钱包余额
+	if record.MartinFactor > 1 && !record.PaperTrading {
+		w, err := h.svcCtx.WalletModel.FindByUserId(ctx, userId)
+		if err != nil {
+			logger.Errorf("[StrategySwitchHandler] 查询用户钱包失败, userId: %d, %v", userId, err)
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 服务器内部错误, 请稍后再试", 1)
+			return nil
+		}
+
+		balance, err := evm.GetTokenBalance(ctx, h.svcCtx.EthClient, h.svcCtx.Config.Chain.StablecoinCA, w.Account)
+		if err != nil {
+			logger.Errorf("[StrategySwitchHandler] 查询钱包余额失败, account: %s, %v", w.Account, err)
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 查询钱包余额失败, 请稍后再试", 1)
+			return nil
+		}
+
+		uiBalance := evm.ParseUnits(balance, h.svcCtx.Config.Chain.StablecoinDecimals)
+		if uiBalance.LessThan(worstCaseCapital) {
+			text := fmt.Sprintf("❌ 开启策略失败, 马丁倍投最坏情况需要 %s %s, 当前余额 %s %s",
+				worstCaseCapital.Truncate(2), h.svcCtx.Config.Chain.StablecoinSymbol, uiBalance.Truncate(2), h.svcCtx.Config.Chain.StablecoinSymbol)
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 3)
+			return nil
+		}
+	}
+
 	utils.SendMessageAndDelayDeletion(h.botApi, chatId, "✅ 正在开启策略, 请稍后...", 1)
 
-	err := utils.Tx(ctx, h.svcCtx.DbClient, func(tx *ent.Tx) error {
+	err = utils.Tx(ctx, h.svcCtx.DbClient, func(tx *ent.Tx) error {
 		_, err := model.NewGridModel(tx.Grid).DeleteByStrategyId(ctx, record.GUID)
 		if err != nil {
 			return err
@@ -154,6 +223,26 @@ func (h *StrategySwitchHandler) handleStartStrategy(ctx context.Context, userId
 			return err
 		}
 
+		err = model.NewStrategyModel(tx.Strategy).ClearOutOfRangeTime(ctx, record.ID)
+		if err != nil {
+			return err
+		}
+
+		err = model.NewStrategyModel(tx.Strategy).UpdateInventorySeeded(ctx, record.ID, false)
+		if err != nil {
+			return err
+		}
+
+		err = model.NewStrategyModel(tx.Strategy).UpdateBuyFilterReason(ctx, record.ID, "")
+		if err != nil {
+			return err
+		}
+
+		err = model.NewStrategyModel(tx.Strategy).ClearAdaptiveUpdateTime(ctx, record.ID)
+		if err != nil {
+			return err
+		}
+
 		return model.NewStrategyModel(tx.Strategy).UpdateStatusByGuid(ctx, record.GUID, strategy.StatusActive)
 	})
 	if err != nil {
@@ -207,6 +296,7 @@ func (h *StrategySwitchHandler) handleStopStrategy(ctx context.Context, userId i
 	record.Status = strategy.StatusInactive
 
 	h.svcCtx.Engine.StopStrategy(record.GUID)
+	h.svcCtx.StrategyCache.Remove(record.GUID)
 	utils.SendMessageAndDelayDeletion(h.botApi, chatId, "✅ 策略已关闭", 1)
 
 	logger.Debugf("[StrategySwitchHandler] 策略已关闭, id: %s", record.GUID)
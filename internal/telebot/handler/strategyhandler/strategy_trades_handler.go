@@ -117,8 +117,14 @@ func (h *StrategyTradesHandler) handle(ctx context.Context, vars map[string]stri
 	for _, item := range orders {
 		var status string
 		switch item.Status {
-		case order.StatusPending:
+		case order.StatusSubmitting:
+			status = "⏳"
+		case order.StatusPending, order.StatusSubmitted:
 			status = "❓"
+		case order.StatusTimeout:
+			status = "⌛"
+		case order.StatusReplaced, order.StatusDropped:
+			status = "🚫"
 		case order.StatusClosed:
 			status = "✅"
 		case order.StatusRejected:
@@ -126,19 +132,28 @@ func (h *StrategyTradesHandler) handle(ctx context.Context, vars map[string]stri
 		}
 		finalPrice := format.Price(item.FinalPrice, 5)
 
+		// 模拟盘订单没有链上交易
+		link := fmt.Sprintf(" [>>](%s)", utils.GetBlockExplorerTxLink(chainId, item.TxHash))
+		if item.Paper {
+			link = " 📝"
+		} else if item.TxHash == "" {
+			// 模拟执行失败, 交易未广播
+			link = fmt.Sprintf("\n└ %s", tgbotapi.EscapeText(tgbotapi.ModeMarkdown, item.Reason))
+		}
+
 		switch item.Type {
 		case order.TypeBuy:
 			if item.GridNumber != nil {
-				items = append(items, fmt.Sprintf("*%s* 🟢 买入`#%d` %sU, 价格 %s %s [>>](%s)",
-					utils.FormaDate(item.CreateTime), *item.GridNumber, item.InAmount.Truncate(2), finalPrice, status, utils.GetBlockExplorerTxLink(chainId, item.TxHash)))
+				items = append(items, fmt.Sprintf("*%s* 🟢 买入`#%d` %sU, 价格 %s %s%s",
+					utils.FormaDate(item.CreateTime), *item.GridNumber, item.InAmount.Truncate(2), finalPrice, status, link))
 			}
 		case order.TypeSell:
 			if item.GridNumber == nil {
-				items = append(items, fmt.Sprintf("*%s* 🔴 清仓 %sU, 价格 %s %s [>>](%s)",
-					utils.FormaDate(item.CreateTime), item.OutAmount.Truncate(2), finalPrice, status, utils.GetBlockExplorerTxLink(chainId, item.TxHash)))
+				items = append(items, fmt.Sprintf("*%s* 🔴 清仓 %sU, 价格 %s %s%s",
+					utils.FormaDate(item.CreateTime), item.OutAmount.Truncate(2), finalPrice, status, link))
 			} else {
-				items = append(items, fmt.Sprintf("*%s* 🔴 卖出`#%d` %sU, 价格 %s %s [>>](%s)",
-					utils.FormaDate(item.CreateTime), *item.GridNumber, item.OutAmount.Truncate(2), finalPrice, status, utils.GetBlockExplorerTxLink(chainId, item.TxHash)))
+				items = append(items, fmt.Sprintf("*%s* 🔴 卖出`#%d` %sU, 价格 %s %s%s",
+					utils.FormaDate(item.CreateTime), *item.GridNumber, item.OutAmount.Truncate(2), finalPrice, status, link))
 			}
 		}
 	}
@@ -3,6 +3,7 @@ package strategyhandler
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"slices"
 	"strconv"
 	"strings"
@@ -15,8 +16,8 @@ import (
 	"github.com/fachebot/evm-grid-bot/internal/ent/strategy"
 	"github.com/fachebot/evm-grid-bot/internal/logger"
 	"github.com/fachebot/evm-grid-bot/internal/model"
+	gridstrategy "github.com/fachebot/evm-grid-bot/internal/strategy"
 	"github.com/fachebot/evm-grid-bot/internal/svc"
-	"github.com/fachebot/evm-grid-bot/internal/swap"
 	"github.com/fachebot/evm-grid-bot/internal/utils"
 	"github.com/fachebot/evm-grid-bot/internal/utils/evm"
 	"github.com/fachebot/evm-grid-bot/internal/utils/format"
@@ -27,6 +28,29 @@ import (
 	"github.com/shopspring/decimal"
 )
 
+const suggestBoundsCandles = 1000
+
+var gridTypeNames = map[strategy.GridType]string{
+	strategy.GridTypeGeometric:  "📐 等比网格",
+	strategy.GridTypeArithmetic: "📏 等差网格",
+	strategy.GridTypeCustom:     "🪜 自定义阶梯",
+}
+
+var emaFilterNames = map[strategy.EmaFilter]string{
+	strategy.EmaFilterOff:   "🔴 EMA过滤关闭",
+	strategy.EmaFilterAbove: "🟢 价格在EMA上方",
+	strategy.EmaFilterBelow: "🟢 价格在EMA下方",
+}
+
+// 获取策略执行锁, 策略正在执行时提示用户稍后重试
+func TryLockStrategy(svcCtx *svc.ServiceContext, botApi *tgbotapi.BotAPI, chatId int64, guid string) bool {
+	if svcCtx.StrategyLocker.TryLock(guid) {
+		return true
+	}
+	utils.SendMessageAndDelayDeletion(botApi, chatId, "⏳ 策略正在执行中, 请稍后再试", 1)
+	return false
+}
+
 func ClosePosition(ctx context.Context, svcCtx *svc.ServiceContext, botApi *tgbotapi.BotAPI, userId, chatId int64, record *ent.Strategy, data []*ent.Grid) {
 	// 计算总仓位
 	uiTotalAmount := decimal.Zero
@@ -53,7 +77,8 @@ func ClosePosition(ctx context.Context, svcCtx *svc.ServiceContext, botApi *tgbo
 	}
 
 	// 获取代币余额
-	tokenBalance, err := evm.GetTokenBalance(ctx, svcCtx.EthClient, record.Token, w.Account)
+	env := gridstrategy.NewEnvironment(svcCtx, record)
+	tokenBalance, err := env.GetTokenBalance(ctx, record.Token, w.Account)
 	if err != nil {
 		logger.Debugf("[ClosePosition] 获取代币余额失败, token: %s, %v", record.Token, err)
 		utils.SendMessageAndDelayDeletion(botApi, chatId, "❌ 清仓失败, 请手动清仓", 1)
@@ -75,8 +100,7 @@ func ClosePosition(ctx context.Context, svcCtx *svc.ServiceContext, botApi *tgbo
 
 	// 获取报价
 	amount := evm.FormatUnits(uiTotalQuantity, tokenmeta.Decimals)
-	swapService := swap.NewSwapService(svcCtx, userId)
-	tx, err := swapService.Quote(ctx, record.Token, svcCtx.Config.Chain.StablecoinCA, amount, true)
+	tx, err := env.Quote(ctx, userId, record.Token, svcCtx.Config.Chain.StablecoinCA, amount, true)
 	if err != nil {
 		logger.Errorf("[ClosePosition] 获取报价失败, in: %s, out: %s, amount: %s, %v",
 			record.Token, svcCtx.Config.Chain.StablecoinSymbol, uiTotalQuantity, err)
@@ -87,18 +111,6 @@ func ClosePosition(ctx context.Context, svcCtx *svc.ServiceContext, botApi *tgbo
 	// 发送交易
 	uiOutAmount := evm.ParseUnits(tx.OutAmount(), svcCtx.Config.Chain.StablecoinDecimals)
 	quotePrice := uiOutAmount.Div(uiTotalQuantity)
-	hash, nonce, err := tx.Swap(ctx)
-	if err != nil {
-		logger.Errorf("[ClosePosition] 清仓代币 - 发送交易失败, user: %d, inToken: %s, inputAmount: %s, outAmount: %s, hash: %s, %v",
-			userId, record.Token, uiTotalQuantity, uiOutAmount, hash, err)
-		utils.SendMessageAndDelayDeletion(botApi, chatId, "❌ 清仓失败, 请手动清仓", 1)
-		return
-	}
-
-	logger.Infof("[ClosePosition] 清仓代币 - 提交交易成功, user: %d, strategy: %s, totalAmount: %s, hash: %s",
-		userId, record.GUID, uiTotalQuantity, hash)
-
-	// 保存订单记录
 	orderArgs := ent.Order{
 		Account:     tx.Signer(),
 		Token:       record.Token,
@@ -110,11 +122,29 @@ func ClosePosition(ctx context.Context, svcCtx *svc.ServiceContext, botApi *tgbo
 		FinalPrice:  quotePrice,
 		InAmount:    uiTotalQuantity,
 		OutAmount:   uiOutAmount,
-		Status:      order.StatusPending,
-		Nonce:       nonce,
-		TxHash:      hash,
+		Paper:       record.PaperTrading,
+	}
+
+	// 清仓不设底价, 重新报价后只更新订单
+	tx.SetPriceGuard(func(outAmount *big.Int) error {
+		uiOutAmount := evm.ParseUnits(outAmount, svcCtx.Config.Chain.StablecoinDecimals)
+		orderArgs.OutAmount = uiOutAmount
+		orderArgs.Price = uiOutAmount.Div(uiTotalQuantity)
+		orderArgs.FinalPrice = orderArgs.Price
+		return nil
+	})
+	err = gridstrategy.SubmitSwap(ctx, svcCtx, tx, &orderArgs)
+	if err != nil {
+		logger.Errorf("[ClosePosition] 清仓代币 - 发送交易失败, user: %d, inToken: %s, inputAmount: %s, outAmount: %s, hash: %s, %v",
+			userId, record.Token, uiTotalQuantity, uiOutAmount, orderArgs.TxHash, err)
+		utils.SendMessageAndDelayDeletion(botApi, chatId, "❌ 清仓失败, 请手动清仓", 1)
+		return
 	}
 
+	logger.Infof("[ClosePosition] 清仓代币 - 提交交易成功, user: %d, strategy: %s, totalAmount: %s, hash: %s",
+		userId, record.GUID, uiTotalQuantity, orderArgs.TxHash)
+
+	// 删除网格并更新订单状态
 	err = utils.Tx(ctx, svcCtx.DbClient, func(tx *ent.Tx) error {
 		for _, item := range data {
 			if item.Status != grid.StatusBought {
@@ -127,8 +157,7 @@ func ClosePosition(ctx context.Context, svcCtx *svc.ServiceContext, botApi *tgbo
 			}
 		}
 
-		_, err = model.NewOrderModel(tx.Order).Save(ctx, orderArgs)
-		return err
+		return model.NewOrderModel(tx.Order).SetOrderPendingStatus(ctx, orderArgs.ID)
 	})
 	if err != nil {
 		logger.Errorf("[ClosePosition] 清仓代币 - 保存订单失败, order: %+v, %v", orderArgs, err)
@@ -142,9 +171,44 @@ func FetchTokenCandles(ctx context.Context, svcCtx *svc.ServiceContext, token st
 	return svcCtx.GmgnClient.FetchTokenCandles(ctx, token, to, period, limit)
 }
 
+// 根据最近K线计算建议价格区间
+func SuggestPriceBounds(ctx context.Context, svcCtx *svc.ServiceContext, token string) (decimal.Decimal, decimal.Decimal, error) {
+	ohlcs, err := FetchTokenCandles(ctx, svcCtx, token, time.Now(), "1m", suggestBoundsCandles)
+	if err != nil {
+		return decimal.Zero, decimal.Zero, err
+	}
+	return gridstrategy.SuggestPriceBounds(ohlcs)
+}
+
+func DisplaySuggestedBoundsMenu(svcCtx *svc.ServiceContext, botApi *tgbotapi.BotAPI, update tgbotapi.Update, record *ent.Strategy) error {
+	chainId := svcCtx.Config.Chain.Id
+	text := fmt.Sprintf("%s 网格机器人 | *%s* 建议价格区间\n\n`%s`\n\n📈 建议区间: *$%s ~ $%s*\n📊 根据最近%d根1分钟K线的高低点分位和ATR计算\n\n`「可直接使用建议区间, 也可以一键修改上下限」`",
+		utils.GetNetworkName(chainId), strings.TrimRight(record.Symbol, "\u0000"), record.Token,
+		record.LowerPriceBound, record.UpperPriceBound, suggestBoundsCandles)
+
+	h := StrategySettingsHandler{}
+	markup := tgbotapi.NewInlineKeyboardMarkup(
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData("✅ 使用建议区间", StrategyDetailsHandler{}.FormatPath(record.GUID)),
+		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				fmt.Sprintf("⬆️ 修改上限 %v", record.UpperPriceBound), h.FormatPath(record.GUID, &SettingsOptionUpperPriceBound)),
+			tgbotapi.NewInlineKeyboardButtonData(
+				fmt.Sprintf("⬇️ 修改下限 %v", record.LowerPriceBound), h.FormatPath(record.GUID, &SettingsOptionLowerPriceBound)),
+		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData("⚙️ 更多设置", h.FormatPath(record.GUID, nil)),
+			tgbotapi.NewInlineKeyboardButtonData("⏪ 返回主页", "/home"),
+		),
+	)
+	_, err := utils.ReplyMessage(botApi, update, text, markup)
+	return err
+}
+
 func GetStrategyDetailsText(ctx context.Context, svcCtx *svc.ServiceContext, record *ent.Strategy) string {
 	// 生成网格列表
-	gridPrices, err := utils.GenerateGrid(record.LowerPriceBound, record.UpperPriceBound, record.TakeProfitRatio.Div(decimal.NewFromInt(100)))
+	gridPrices, err := gridstrategy.GenerateGridList(record)
 	if err != nil {
 		logger.Debugf("[GetStrategyDetailsText] 生成网格列表失败, low: %v, up: %v, takeProfitRatio: %v, %v",
 			record.LowerPriceBound, record.UpperPriceBound, record.TakeProfitRatio, err)
@@ -155,6 +219,9 @@ func GetStrategyDetailsText(ctx context.Context, svcCtx *svc.ServiceContext, rec
 	gridRecords, err := svcCtx.GridModel.FindByStrategyId(ctx, record.GUID)
 	if err == nil {
 		for _, gridRecord := range gridRecords {
+			if gridRecord.Runner {
+				continue
+			}
 			gridMap[gridRecord.GridNumber] = gridRecord
 		}
 	} else {
@@ -222,9 +289,52 @@ func GetStrategyDetailsText(ctx context.Context, svcCtx *svc.ServiceContext, rec
 	text := fmt.Sprintf("%s 网格机器人 | *%s* 策略详情", utils.GetNetworkName(chainId), strings.TrimRight(record.Symbol, "\u0000"))
 	text = text + fmt.Sprintf("\n\n[OKX](%s) | [GMGN](%s) | [DEX Scanner](%s)",
 		utils.GetOkxTokenLink(chainId, record.Token), utils.GetGmgnTokenLink(chainId, record.Token), utils.GetDexscreenerTokenLink(chainId, record.Token))
+	if record.PaperTrading {
+		text = text + "\n\n📝 *模拟盘策略, 不会发送真实交易*"
+	}
 	text = text + fmt.Sprintf("\n\n📈 价格区间: *$%s ~ $%s*\n", record.LowerPriceBound.String(), record.UpperPriceBound.String())
 	text = text + fmt.Sprintf("⚙️ 单格投入: *%s %s*\n", record.InitialOrderSize.String(), svcCtx.Config.Chain.StablecoinSymbol)
-	text = text + fmt.Sprintf("🔄 网格详情: *%d格 (%s%% 止盈)*\n", len(gridPrices), record.TakeProfitRatio.String())
+	if record.MartinFactor > 1 {
+		text = text + fmt.Sprintf("✖️ 马丁倍投: *%vx*\n", record.MartinFactor)
+	}
+	if worstCaseCapital, err := gridstrategy.CalculateWorstCaseCapital(record); err == nil {
+		text = text + fmt.Sprintf("💰 最大资金占用: *%s %s*\n", worstCaseCapital.Truncate(2), svcCtx.Config.Chain.StablecoinSymbol)
+	}
+	switch record.GridType {
+	case strategy.GridTypeArithmetic:
+		text = text + fmt.Sprintf("🔄 网格详情: *%d格 (等差, 步长 $%s)*\n", len(gridPrices), format.Price(gridstrategy.CalculatePriceStep(record), 5))
+	case strategy.GridTypeCustom:
+		text = text + fmt.Sprintf("🔄 网格详情: *%d格 (自定义阶梯)*\n", len(gridPrices))
+	default:
+		text = text + fmt.Sprintf("🔄 网格详情: *%d格 (%s%% 止盈)*\n", len(gridPrices), record.TakeProfitRatio.String())
+	}
+	if record.AdaptiveSpacing {
+		text = text + fmt.Sprintf("📊 自适应间隔: *%s ~ %s*\n", lo.FromPtr(record.AdaptiveMinRatio).String()+"%", lo.FromPtr(record.AdaptiveMaxRatio).String()+"%")
+		if record.AdaptiveUpdateTime != nil && record.AdaptiveVolatility != nil {
+			spacing := record.TakeProfitRatio.String() + "%"
+			if record.GridType == strategy.GridTypeArithmetic {
+				spacing = "$" + format.Price(gridstrategy.CalculatePriceStep(record), 5)
+			}
+			text = text + fmt.Sprintf("📊 最近调整: ATR波动率 %s%%, 间隔 %s (%s)\n",
+				record.AdaptiveVolatility.Truncate(2), spacing, utils.FormaDate(*record.AdaptiveUpdateTime))
+		}
+	}
+	if record.TrailingTakeProfit != nil && record.TrailingTakeProfit.GreaterThan(decimal.Zero) {
+		text = text + fmt.Sprintf("📉 追踪止盈: *回撤 %v%% 卖出*\n", record.TrailingTakeProfit.Truncate(2))
+	}
+	if record.TakeProfitPortion != nil && record.TakeProfitPortion.LessThan(decimal.NewFromInt(100)) {
+		runners := lo.Filter(gridRecords, func(item *ent.Grid, _ int) bool { return item.Runner })
+		runnerQuantity := lo.Reduce(runners, func(agg decimal.Decimal, item *ent.Grid, _ int) decimal.Decimal {
+			return agg.Add(item.Quantity)
+		}, decimal.Zero)
+		text = text + fmt.Sprintf("🌙 部分止盈: *卖出 %v%%, 留仓 %d笔 (%s)*\n", record.TakeProfitPortion.Truncate(2), len(runners), runnerQuantity.Truncate(4))
+	}
+	if filters := getBuyFiltersText(record); filters != "" {
+		text = text + fmt.Sprintf("🧭 买入过滤: *%s*\n", filters)
+	}
+	if record.Status == strategy.StatusActive && record.BuyFilterReason != nil {
+		text = text + fmt.Sprintf("⏸ 暂停买入: %s\n", *record.BuyFilterReason)
+	}
 	text = text + fmt.Sprintf("💵 总利润: %s\n", reallzedProfit.Add(unreallzed).Truncate(2))
 	text = text + fmt.Sprintf("✅ 已实现利润: %s\n", reallzedProfit.Truncate(2))
 	text = text + fmt.Sprintf("❓ 未实现利润: %s\n", unreallzed.Truncate(2))
@@ -236,7 +346,12 @@ func GetStrategyDetailsText(ctx context.Context, svcCtx *svc.ServiceContext, rec
 	if dropText != "" {
 		text = text + dropText
 	}
-	text = text + "\n⚪️ 待买入 │ 🟡 买入中 │ 🟢 已买入 | 🔴 卖出中\n\n"
+	if record.GridMode == strategy.GridModeNeutral {
+		text = text + "⚖️ 中性网格: 使用已有代币在上方挂卖, 回落后买回\n"
+		text = text + "\n⚪️ 待买入 │ 🟡 买入中 │ 🟢 已买入 | 🔵 库存 | 🔴 卖出中\n\n"
+	} else {
+		text = text + "\n⚪️ 待买入 │ 🟡 买入中 │ 🟢 已买入 | 🔴 卖出中\n\n"
+	}
 
 	// 计算分割位置
 	splitPos := 0
@@ -257,7 +372,7 @@ func GetStrategyDetailsText(ctx context.Context, svcCtx *svc.ServiceContext, rec
 			case grid.StatusBuying:
 				status = "🟡"
 			case grid.StatusBought:
-				status = "🟢"
+				status = lo.If(grideRecord.SellPrice != nil, "🔵").Else("🟢")
 			case grid.StatusSelling:
 				status = "🔴"
 			}
@@ -392,8 +507,18 @@ func DisplayStrategyHomeMenu(ctx context.Context, svcCtx *svc.ServiceContext, bo
 		} else if !item.EnableAutoBuy {
 			status = "⏸️"
 		}
-		text := fmt.Sprintf("%s %s | 单笔: %vU | 止盈: %v%%",
-			status, strings.TrimRight(item.Symbol, "\u0000"), item.InitialOrderSize.String(), item.TakeProfitRatio.String())
+		if item.PaperTrading {
+			status = status + "📝"
+		}
+		takeProfit := item.TakeProfitRatio.String() + "%"
+		switch item.GridType {
+		case strategy.GridTypeArithmetic:
+			takeProfit = "$" + format.Price(gridstrategy.CalculatePriceStep(item), 5)
+		case strategy.GridTypeCustom:
+			takeProfit = "自定义"
+		}
+		text := fmt.Sprintf("%s %s | 单笔: %vU | 止盈: %v",
+			status, strings.TrimRight(item.Symbol, "\u0000"), item.InitialOrderSize.String(), takeProfit)
 		strategyButtons = append(strategyButtons, []tgbotapi.InlineKeyboardButton{
 			tgbotapi.NewInlineKeyboardButtonData(text, StrategyDetailsHandler{}.FormatPath(item.GUID)),
 		})
@@ -462,6 +587,9 @@ func DisplayStrategyDetailsMenu(ctx context.Context, svcCtx *svc.ServiceContext,
 			tgbotapi.NewInlineKeyboardButtonData("⚙️ 策略配置", StrategySettingsHandler{}.FormatPath(record.GUID, nil)),
 			tgbotapi.NewInlineKeyboardButtonData("🗑 删除策略", DeleteStrategyHandler{}.FormatPath(record.GUID)),
 		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData("📈 策略回测", StrategyBacktestHandler{}.FormatPath(record.GUID)),
+		),
 		tgbotapi.NewInlineKeyboardRow(
 			tgbotapi.NewInlineKeyboardButtonData("◀️ 返回上级", StrategyHomeHandler{}.FormatPath(1)),
 			tgbotapi.NewInlineKeyboardButtonData("⏪ 返回主页", "/home"),
@@ -518,7 +646,59 @@ func DisplayStrategSettingsMenu(svcCtx *svc.ServiceContext, botApi *tgbotapi.Bot
 		globalTakeProfitRatio = "+" + record.GlobalTakeProfitRatio.Mul(decimal.NewFromInt(100)).Truncate(2).String() + "%"
 	}
 
+	takeProfitPortion := "100%"
+	if record.TakeProfitPortion != nil && record.TakeProfitPortion.GreaterThan(decimal.Zero) {
+		takeProfitPortion = fmt.Sprintf("%v%%", record.TakeProfitPortion.Truncate(2))
+	}
+
+	trailingTakeProfit := "-"
+	if record.TrailingTakeProfit != nil && record.TrailingTakeProfit.GreaterThan(decimal.Zero) {
+		trailingTakeProfit = fmt.Sprintf("%v%%", record.TrailingTakeProfit.Truncate(2))
+	}
+
+	adaptiveMinRatio, adaptiveMaxRatio := "-", "-"
+	if record.AdaptiveMinRatio != nil {
+		adaptiveMinRatio = record.AdaptiveMinRatio.String() + "%"
+	}
+	if record.AdaptiveMaxRatio != nil {
+		adaptiveMaxRatio = record.AdaptiveMaxRatio.String() + "%"
+	}
+
+	rsiThreshold := "-"
+	if record.RsiThreshold != nil && record.RsiThreshold.GreaterThan(decimal.Zero) {
+		rsiThreshold = "<" + record.RsiThreshold.String()
+	}
+
+	trailingDwell := "30分钟"
+	if record.TrailingDwellMinutes > 0 {
+		trailingDwell = fmt.Sprintf("%d分钟", record.TrailingDwellMinutes)
+	}
+
 	h := StrategySettingsHandler{}
+	gridTypeRow := tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData(
+			gridTypeNames[record.GridType], h.FormatPath(record.GUID, &SettingsOptionGridType)),
+	)
+	switch record.GridType {
+	case strategy.GridTypeArithmetic:
+		gridCount, priceStep := "-", "-"
+		if record.GridCount != nil {
+			gridCount = strconv.Itoa(*record.GridCount)
+		}
+		if record.PriceStep != nil {
+			priceStep = record.PriceStep.String()
+		}
+		gridTypeRow = append(gridTypeRow,
+			tgbotapi.NewInlineKeyboardButtonData(fmt.Sprintf("🔢 格数 %s", gridCount), h.FormatPath(record.GUID, &SettingsOptionGridCount)),
+			tgbotapi.NewInlineKeyboardButtonData(fmt.Sprintf("↔️ 步长 %s", priceStep), h.FormatPath(record.GUID, &SettingsOptionPriceStep)),
+		)
+	case strategy.GridTypeCustom:
+		levels := gridstrategy.DecodeGridLadder(record.GridLadder)
+		gridTypeRow = append(gridTypeRow,
+			tgbotapi.NewInlineKeyboardButtonData(fmt.Sprintf("🪜 价格阶梯 %d档", len(levels)), h.FormatPath(record.GUID, &SettingsOptionGridLadder)),
+		)
+	}
+
 	chainId := svcCtx.Config.Chain.Id
 	text := fmt.Sprintf("%s 网格机器人 | *%s* 编辑策略\n\n`%s`\n\n`「调整设置, 优化您的交易体验」`",
 		utils.GetNetworkName(chainId), strings.TrimRight(record.Symbol, "\u0000"), record.Token)
@@ -535,6 +715,12 @@ func DisplayStrategSettingsMenu(svcCtx *svc.ServiceContext, botApi *tgbotapi.Bot
 			tgbotapi.NewInlineKeyboardButtonData(
 				lo.If(record.EnableAutoExit, "🟢 自动清仓打开").Else("🔴 自动清仓关闭"), h.FormatPath(record.GUID, &SettingsOptionEnableAutoClear)),
 		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				lo.If(record.PaperTrading, "📝 模拟盘交易").Else("💵 实盘交易"), h.FormatPath(record.GUID, &SettingsOptionPaperTrading)),
+			tgbotapi.NewInlineKeyboardButtonData(
+				lo.If(record.GridMode == strategy.GridModeNeutral, "⚖️ 中性网格").Else("🛒 买入网格"), h.FormatPath(record.GUID, &SettingsOptionGridMode)),
+		),
 		tgbotapi.NewInlineKeyboardRow(
 			tgbotapi.NewInlineKeyboardButtonData(
 				fmt.Sprintf("止盈金额 %s", takeProfitExit), h.FormatPath(record.GUID, &SettingsOptionTakeProfitExit)),
@@ -554,12 +740,40 @@ func DisplayStrategSettingsMenu(svcCtx *svc.ServiceContext, botApi *tgbotapi.Bot
 			tgbotapi.NewInlineKeyboardButtonData(
 				fmt.Sprintf("🟰 每格 %vU", record.InitialOrderSize), h.FormatPath(record.GUID, &SettingsOptionOrderSize)),
 		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				fmt.Sprintf("📉 追踪止盈回撤 %s", trailingTakeProfit), h.FormatPath(record.GUID, &SettingsOptionTrailingTakeProfit)),
+			tgbotapi.NewInlineKeyboardButtonData(
+				fmt.Sprintf("🌙 止盈卖出 %s", takeProfitPortion), h.FormatPath(record.GUID, &SettingsOptionTakeProfitPortion)),
+		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				fmt.Sprintf("✖️ 马丁倍投 %vx", record.MartinFactor), h.FormatPath(record.GUID, &SettingsOptionMartinFactor)),
+		),
+		gridTypeRow,
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				lo.If(record.AdaptiveSpacing, "🟢 自适应间隔").Else("🔴 自适应间隔"), h.FormatPath(record.GUID, &SettingsOptionAdaptiveSpacing)),
+			tgbotapi.NewInlineKeyboardButtonData(
+				fmt.Sprintf("最小 %s", adaptiveMinRatio), h.FormatPath(record.GUID, &SettingsOptionAdaptiveMinRatio)),
+			tgbotapi.NewInlineKeyboardButtonData(
+				fmt.Sprintf("最大 %s", adaptiveMaxRatio), h.FormatPath(record.GUID, &SettingsOptionAdaptiveMaxRatio)),
+		),
 		tgbotapi.NewInlineKeyboardRow(
 			tgbotapi.NewInlineKeyboardButtonData(
 				fmt.Sprintf("♾️ 网格上限 %s", maxGridLimit), h.FormatPath(record.GUID, &SettingsOptionMaxGridLimit)),
 			tgbotapi.NewInlineKeyboardButtonData(
 				lo.If(record.DynamicStopLoss, "🟢 动态止损打开").Else("🔴 动态止损关闭"), h.FormatPath(record.GUID, &SettingsOptionDynamicStopLoss)),
 		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				lo.If(record.TrailingUp, "🟢 向上追踪打开").Else("🔴 向上追踪关闭"), h.FormatPath(record.GUID, &SettingsOptionTrailingUp)),
+			tgbotapi.NewInlineKeyboardButtonData(
+				lo.If(record.TrailingDown, "🟢 向下追踪打开").Else("🔴 向下追踪关闭"), h.FormatPath(record.GUID, &SettingsOptionTrailingDown)),
+		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(fmt.Sprintf("⏱ 追踪等待: %s", trailingDwell), h.FormatPath(record.GUID, &SettingsOptionTrailingDwellMinutes)),
+		),
 		tgbotapi.NewInlineKeyboardRow(
 			tgbotapi.NewInlineKeyboardButtonData(lo.If(record.DropOn, "🟢 防瀑布打开").Else("🔴 防瀑布关闭"), h.FormatPath(record.GUID, &SettingsOptionDropOn)),
 		),
@@ -567,6 +781,14 @@ func DisplayStrategSettingsMenu(svcCtx *svc.ServiceContext, botApi *tgbotapi.Bot
 			tgbotapi.NewInlineKeyboardButtonData(fmt.Sprintf("K线根数: %s", candlesToCheck), h.FormatPath(record.GUID, &SettingsOptionCandlesToCheck)),
 			tgbotapi.NewInlineKeyboardButtonData(fmt.Sprintf("跌幅阈值: %s", dropThreshold), h.FormatPath(record.GUID, &SettingsOptionDropThreshold)),
 		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(fmt.Sprintf("RSI过滤: %s", rsiThreshold), h.FormatPath(record.GUID, &SettingsOptionRsiThreshold)),
+			tgbotapi.NewInlineKeyboardButtonData(lo.If(record.MacdFilter, "🟢 MACD过滤打开").Else("🔴 MACD过滤关闭"), h.FormatPath(record.GUID, &SettingsOptionMacdFilter)),
+		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(emaFilterNames[record.EmaFilter], h.FormatPath(record.GUID, &SettingsOptionEmaFilter)),
+			tgbotapi.NewInlineKeyboardButtonData(fmt.Sprintf("EMA周期: %d", gridstrategy.GetEmaPeriod(record)), h.FormatPath(record.GUID, &SettingsOptionEmaPeriod)),
+		),
 		tgbotapi.NewInlineKeyboardRow(
 			tgbotapi.NewInlineKeyboardButtonData(
 				fmt.Sprintf("⬆️ 价格上限 %v", record.UpperPriceBound), h.FormatPath(record.GUID, &SettingsOptionUpperPriceBound)),
@@ -575,6 +797,9 @@ func DisplayStrategSettingsMenu(svcCtx *svc.ServiceContext, botApi *tgbotapi.Bot
 			tgbotapi.NewInlineKeyboardButtonData(
 				fmt.Sprintf("⬇️ 价格下限 %v", record.LowerPriceBound), h.FormatPath(record.GUID, &SettingsOptionLowerPriceBound)),
 		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData("🎯 计算建议价格区间", h.FormatPath(record.GUID, &SettingsOptionSuggestBounds)),
+		),
 		tgbotapi.NewInlineKeyboardRow(
 			tgbotapi.NewInlineKeyboardButtonData(
 				fmt.Sprintf("➖ 最近交易量 %v", lastKlineVolume), h.FormatPath(record.GUID, &SettingsOptionLastKlineVolume)),
@@ -591,3 +816,20 @@ func DisplayStrategSettingsMenu(svcCtx *svc.ServiceContext, botApi *tgbotapi.Bot
 	_, err := utils.ReplyMessage(botApi, update, text, markup)
 	return err
 }
+
+func getBuyFiltersText(record *ent.Strategy) string {
+	filters := make([]string, 0)
+	if record.RsiThreshold != nil && record.RsiThreshold.GreaterThan(decimal.Zero) {
+		filters = append(filters, fmt.Sprintf("RSI<%s", record.RsiThreshold))
+	}
+	switch record.EmaFilter {
+	case strategy.EmaFilterAbove:
+		filters = append(filters, fmt.Sprintf("价格>EMA(%d)", gridstrategy.GetEmaPeriod(record)))
+	case strategy.EmaFilterBelow:
+		filters = append(filters, fmt.Sprintf("价格<EMA(%d)", gridstrategy.GetEmaPeriod(record)))
+	}
+	if record.MacdFilter {
+		filters = append(filters, "MACD柱未下降")
+	}
+	return strings.Join(filters, " | ")
+}
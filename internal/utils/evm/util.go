@@ -7,12 +7,16 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"strings"
 
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/shopspring/decimal"
 )
 
@@ -278,3 +282,53 @@ func GetTokenBalanceChanges(ctx context.Context, ethClient *ethclient.Client, re
 
 	return changes, nil
 }
+
+// 在 pending 区块模拟执行交易, 执行失败时 reverted 为 true, 并返回解码后的原因
+func SimulateCall(ctx context.Context, ethClient *ethclient.Client, msg ethereum.CallMsg) (reason string, reverted bool, err error) {
+	_, err = ethClient.PendingCallContract(ctx, msg)
+	if err == nil {
+		return "", false, nil
+	}
+
+	// 只有执行回滚视为模拟失败, 限流、节点同步等错误按普通错误返回
+	var rpcErr rpc.Error
+	if !errors.As(err, &rpcErr) || !isExecutionReverted(rpcErr) {
+		return "", false, err
+	}
+
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		if data, ok := dataErr.ErrorData().(string); ok {
+			if b, e := hexutil.Decode(data); e == nil {
+				if reason, e := abi.UnpackRevert(b); e == nil {
+					return reason, true, nil
+				}
+			}
+		}
+	}
+	return rpcErr.Error(), true, nil
+}
+
+// 执行回滚错误码为 3, 部分节点使用其他错误码但消息包含 execution reverted
+func isExecutionReverted(err rpc.Error) bool {
+	return err.ErrorCode() == 3 || strings.Contains(strings.ToLower(err.Error()), "execution reverted")
+}
+
+// 当前网络建议的小费, 以及最低手续费上限
+func SuggestGasFees(ctx context.Context, ethClient *ethclient.Client) (gasTipCap, minFeeCap *big.Int, err error) {
+	gasTipCap, err = ethClient.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	header, err := ethClient.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	minFeeCap = new(big.Int).Set(gasTipCap)
+	if header.BaseFee != nil {
+		minFeeCap.Add(minFeeCap, new(big.Int).Mul(header.BaseFee, big.NewInt(2)))
+	}
+	return gasTipCap, minFeeCap, nil
+}
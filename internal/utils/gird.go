@@ -7,6 +7,8 @@ import (
 	"github.com/shopspring/decimal"
 )
 
+const maxArithmeticGrids = 1000
+
 func GenerateGrid(lowerPriceBound, upperPriceBound, takeProfitRatio decimal.Decimal) ([]decimal.Decimal, error) {
 	if lowerPriceBound.LessThanOrEqual(decimal.Zero) {
 		return nil, errors.New("lower price bound must be positive")
@@ -28,6 +30,37 @@ func GenerateGrid(lowerPriceBound, upperPriceBound, takeProfitRatio decimal.Deci
 	return result, nil
 }
 
+func GenerateArithmeticGrid(lowerPriceBound, upperPriceBound, priceStep decimal.Decimal) ([]decimal.Decimal, error) {
+	if lowerPriceBound.LessThanOrEqual(decimal.Zero) {
+		return nil, errors.New("lower price bound must be positive")
+	}
+	if upperPriceBound.LessThanOrEqual(lowerPriceBound) {
+		return nil, errors.New("upper price bound must be greater than lower price bound")
+	}
+	if priceStep.LessThanOrEqual(decimal.Zero) {
+		return nil, errors.New("price step must be positive")
+	}
+	if upperPriceBound.Sub(lowerPriceBound).Div(priceStep).GreaterThan(decimal.NewFromInt(maxArithmeticGrids)) {
+		return nil, errors.New("too many grids")
+	}
+
+	grid := lowerPriceBound
+	result := make([]decimal.Decimal, 0)
+
+	for grid.LessThan(upperPriceBound) {
+		result = append(result, grid)
+		grid = grid.Add(priceStep)
+	}
+	return result, nil
+}
+
+func CalculateArithmeticPriceStep(lowerPriceBound, upperPriceBound decimal.Decimal, gridCount int) decimal.Decimal {
+	if gridCount <= 0 {
+		return decimal.Zero
+	}
+	return upperPriceBound.Sub(lowerPriceBound).Div(decimal.NewFromInt(int64(gridCount)))
+}
+
 func CalculateGridPosition(gridList []decimal.Decimal, price decimal.Decimal) (int, bool) {
 	if len(gridList) == 0 {
 		return 0, false
@@ -55,3 +88,24 @@ func CalculateGridPosition(gridList []decimal.Decimal, price decimal.Decimal) (i
 
 	return 0, false
 }
+
+// 计算马丁倍投金额, level 为相对首个成交网格的下跌格数
+func CalculateMartinOrderSize(initialOrderSize decimal.Decimal, martinFactor float64, level int) decimal.Decimal {
+	if level <= 0 || martinFactor <= 1 {
+		return initialOrderSize
+	}
+	return initialOrderSize.Mul(decimal.NewFromFloat(martinFactor).Pow(decimal.NewFromInt(int64(level))))
+}
+
+// 计算最坏情况所需资金, 按 gridCount 格中倍数最高的 maxGrids 格累计
+func CalculateWorstCaseCapital(initialOrderSize decimal.Decimal, martinFactor float64, gridCount, maxGrids int) decimal.Decimal {
+	if maxGrids <= 0 || maxGrids > gridCount {
+		maxGrids = gridCount
+	}
+
+	total := decimal.Zero
+	for level := gridCount - maxGrids; level < gridCount; level++ {
+		total = total.Add(CalculateMartinOrderSize(initialOrderSize, martinFactor, level))
+	}
+	return total
+}
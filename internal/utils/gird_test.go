@@ -0,0 +1,135 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestGenerateArithmeticGrid(t *testing.T) {
+	tests := []struct {
+		name      string
+		lower     string
+		upper     string
+		priceStep string
+		expected  []string
+		err       bool
+	}{
+		{
+			name:      "整除的价格区间",
+			lower:     "1",
+			upper:     "2",
+			priceStep: "0.25",
+			expected:  []string{"1", "1.25", "1.5", "1.75"},
+		},
+		{
+			name:      "不能整除的价格区间",
+			lower:     "1",
+			upper:     "2",
+			priceStep: "0.3",
+			expected:  []string{"1", "1.3", "1.6", "1.9"},
+		},
+		{
+			name:      "间隔大于价格区间",
+			lower:     "1",
+			upper:     "2",
+			priceStep: "5",
+			expected:  []string{"1"},
+		},
+		{
+			name:      "价格下限为零",
+			lower:     "0",
+			upper:     "2",
+			priceStep: "0.1",
+			err:       true,
+		},
+		{
+			name:      "价格上限小于等于下限",
+			lower:     "2",
+			upper:     "2",
+			priceStep: "0.1",
+			err:       true,
+		},
+		{
+			name:      "间隔为零",
+			lower:     "1",
+			upper:     "2",
+			priceStep: "0",
+			err:       true,
+		},
+		{
+			name:      "网格数量超过上限",
+			lower:     "1",
+			upper:     "2",
+			priceStep: "0.0001",
+			err:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := GenerateArithmeticGrid(
+				decimal.RequireFromString(tt.lower), decimal.RequireFromString(tt.upper), decimal.RequireFromString(tt.priceStep))
+			if (err != nil) != tt.err {
+				t.Fatalf("GenerateArithmeticGrid() error = %v, expected error %v", err, tt.err)
+			}
+			if len(result) != len(tt.expected) {
+				t.Fatalf("GenerateArithmeticGrid() = %v, expected %v", result, tt.expected)
+			}
+			for idx, item := range result {
+				if !item.Equal(decimal.RequireFromString(tt.expected[idx])) {
+					t.Errorf("GenerateArithmeticGrid() = %v, expected %v", result, tt.expected)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestCalculateArithmeticPriceStep(t *testing.T) {
+	tests := []struct {
+		name      string
+		lower     string
+		upper     string
+		gridCount int
+		expected  string
+	}{
+		{
+			name:      "正常计算",
+			lower:     "1",
+			upper:     "2",
+			gridCount: 4,
+			expected:  "0.25",
+		},
+		{
+			name:      "网格数量为一",
+			lower:     "1.5",
+			upper:     "3",
+			gridCount: 1,
+			expected:  "1.5",
+		},
+		{
+			name:      "网格数量为零",
+			lower:     "1",
+			upper:     "2",
+			gridCount: 0,
+			expected:  "0",
+		},
+		{
+			name:      "网格数量为负数",
+			lower:     "1",
+			upper:     "2",
+			gridCount: -1,
+			expected:  "0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CalculateArithmeticPriceStep(decimal.RequireFromString(tt.lower), decimal.RequireFromString(tt.upper), tt.gridCount)
+			if !result.Equal(decimal.RequireFromString(tt.expected)) {
+				t.Errorf("CalculateArithmeticPriceStep() = %v, expected %v", result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package utils
+
+import "sync"
+
+type keyedLock struct {
+	mutex sync.Mutex
+	refs  int
+}
+
+// 按键加锁, 不再使用的锁会被自动回收
+type KeyedLocker struct {
+	mutex sync.Mutex
+	locks map[string]*keyedLock
+}
+
+func NewKeyedLocker() *KeyedLocker {
+	return &KeyedLocker{locks: make(map[string]*keyedLock)}
+}
+
+func (l *KeyedLocker) acquire(key string) *keyedLock {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	lock, ok := l.locks[key]
+	if !ok {
+		lock = new(keyedLock)
+		l.locks[key] = lock
+	}
+	lock.refs++
+	return lock
+}
+
+func (l *KeyedLocker) release(key string, lock *keyedLock) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	lock.refs--
+	if lock.refs <= 0 {
+		delete(l.locks, key)
+	}
+}
+
+func (l *KeyedLocker) Lock(key string) {
+	l.acquire(key).mutex.Lock()
+}
+
+func (l *KeyedLocker) TryLock(key string) bool {
+	lock := l.acquire(key)
+	if lock.mutex.TryLock() {
+		return true
+	}
+	l.release(key, lock)
+	return false
+}
+
+func (l *KeyedLocker) Unlock(key string) {
+	l.mutex.Lock()
+	lock, ok := l.locks[key]
+	l.mutex.Unlock()
+	if !ok {
+		return
+	}
+
+	lock.mutex.Unlock()
+	l.release(key, lock)
+}
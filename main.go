@@ -101,7 +101,7 @@ func main() {
 	c.Chain.StablecoinDecimals = tokenMeta.Decimals
 
 	// 运行K线管理器
-	const candles = 329
+	const candles = engine.DefaultCandles
 	const resolution = "1m"
 	var quotationSubscriber job.Job
 	var klineManager engine.KlineManager
@@ -151,6 +151,10 @@ func main() {
 	orderKeeper := job.NewOrderKeeper(svcCtx)
 	orderKeeper.Start()
 
+	// 运行nonce检查
+	nonceReconciler := job.NewNonceReconciler(svcCtx)
+	nonceReconciler.Start()
+
 	// 运行机器人服务
 	botService, err := telebot.NewTeleBot(svcCtx)
 	if err != nil {
@@ -171,6 +175,7 @@ func main() {
 	klineManager.Stop()
 	quotationSubscriber.Stop()
 	orderKeeper.Stop()
+	nonceReconciler.Stop()
 
 	svcCtx.Close()
 	logger.Infof("服务已停止")